@@ -0,0 +1,13 @@
+# Read the prefix and suffix sets of the schema library
+data "standesamt_affixes" "library" {}
+
+data "standesamt_config" "default" {}
+
+locals {
+  # Use the prefixes of the "platform" set defined in schema.affixes.json
+  storage_name = provider::standesamt::name(data.standesamt_config.default, "azurerm_storage_account", { prefix_set = "platform" }, "logs")
+}
+
+output "affix_sets" {
+  value = keys(data.standesamt_affixes.library.affixes)
+}
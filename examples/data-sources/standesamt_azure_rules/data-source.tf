@@ -0,0 +1,9 @@
+# Check that a custom schema library does not allow names Azure rejects
+data "standesamt_azure_rules" "library" {}
+
+check "azure_rules" {
+  assert {
+    condition     = data.standesamt_azure_rules.library.valid
+    error_message = join("\n", [for m in data.standesamt_azure_rules.library.mismatches : "${m.resource_type}: ${m.message}"])
+  }
+}
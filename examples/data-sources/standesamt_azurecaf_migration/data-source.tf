@@ -0,0 +1,29 @@
+# Compare an existing azurecaf_name resource with the naming functions
+data "standesamt_azurecaf_migration" "rg" {
+  resource_type = azurecaf_name.rg.resource_type
+  name          = azurecaf_name.rg.name
+  prefixes      = azurecaf_name.rg.prefixes
+  suffixes      = azurecaf_name.rg.suffixes
+  random_length = azurecaf_name.rg.random_length
+  result        = azurecaf_name.rg.result
+}
+
+data "standesamt_config" "default" {}
+
+locals {
+  config = {
+    configuration = data.standesamt_config.default.configuration
+    locations     = {}
+    schema        = data.standesamt_config.default.schema
+  }
+}
+
+# Build the name with the equivalent settings
+output "name" {
+  value = provider::standesamt::name(local.config, "azurerm_resource_group", data.standesamt_azurecaf_migration.rg.settings, "app")
+}
+
+# Differences to review before the migration
+output "differences" {
+  value = data.standesamt_azurecaf_migration.rg.differences
+}
@@ -27,3 +27,23 @@ locals {
 output "resource_group_name" {
   value = provider::standesamt::name(local.config, "azurerm_resource_group", {}, "example")
 }
+# Keep the state small by only including the resource types that are named
+data "standesamt_config" "filtered" {
+  resource_types = ["azurerm_resource_group", "azurerm_storage_account"]
+}
+# Report non-compliant names as warnings instead of failing, e.g. while migrating existing resources
+data "standesamt_config" "brownfield" {
+  strict = false
+}
+# Deny names that start with a digit or contain reserved words
+data "standesamt_config" "deny" {
+  deny_patterns = ["^[0-9]", "(?i)(microsoft|windows)"]
+}
+# Use a configuration document rendered by provider::standesamt::config_export
+data "standesamt_config" "imported" {
+  config_json = file("${path.module}/naming-config.json")
+}
+# Require a deliberate review whenever the naming inputs change
+resource "terraform_data" "naming_review" {
+  triggers_replace = data.standesamt_config.default.configuration_fingerprint
+}
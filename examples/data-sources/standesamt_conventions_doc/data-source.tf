@@ -0,0 +1,18 @@
+# Markdown tables of the convention for the resource types of the workspace
+data "standesamt_conventions_doc" "this" {
+  resource_types = ["azurerm_resource_group", "azurerm_storage_account", "azurerm_key_vault"]
+}
+
+resource "local_file" "naming" {
+  filename = "${path.root}/docs/naming.md"
+  content  = data.standesamt_conventions_doc.this.content
+}
+
+# The same convention as JSON, e.g. for other tooling
+data "standesamt_conventions_doc" "json" {
+  format = "json"
+}
+
+output "convention" {
+  value = jsondecode(data.standesamt_conventions_doc.json.content)
+}
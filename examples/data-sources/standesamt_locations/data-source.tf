@@ -21,3 +21,8 @@ output "storage_account_name" {
 output "all_locations" {
   value = data.standesamt_locations.default.locations
 }
+# Read the bundled AWS regions next to the locations of the provider, e.g. to
+# compare them during a migration
+data "standesamt_locations" "aws" {
+  source = "aws"
+}
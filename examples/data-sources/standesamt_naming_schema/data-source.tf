@@ -0,0 +1,18 @@
+# Read the naming rules of a single resource type
+data "standesamt_naming_schema" "storage_account" {
+  resource_type = "azurerm_storage_account"
+}
+
+# Use the rules to validate a user supplied name
+variable "storage_account_name" {
+  type = string
+
+  validation {
+    condition     = can(regex(data.standesamt_naming_schema.storage_account.validation_regex, var.storage_account_name))
+    error_message = "The storage account name does not match the naming rules."
+  }
+}
+
+output "storage_account_max_length" {
+  value = data.standesamt_naming_schema.storage_account.max_length
+}
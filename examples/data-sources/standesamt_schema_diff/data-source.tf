@@ -0,0 +1,19 @@
+# Review the changes of the next schema library version before bumping the ref
+data "standesamt_schema_diff" "upgrade" {
+  from = {
+    path = "azure/caf"
+    ref  = "2025.04"
+  }
+  to = {
+    path = "azure/caf"
+    ref  = "2026.01"
+  }
+}
+
+output "breaking_changes" {
+  value = [for c in data.standesamt_schema_diff.upgrade.changes : "${c.resource_type}: ${c.field} ${c.from} -> ${c.to}" if c.breaking]
+}
+
+output "removed_resource_types" {
+  value = data.standesamt_schema_diff.upgrade.removed
+}
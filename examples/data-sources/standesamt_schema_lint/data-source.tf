@@ -0,0 +1,13 @@
+# Check a custom schema library before using it
+data "standesamt_schema_lint" "library" {}
+
+check "schema_library" {
+  assert {
+    condition     = data.standesamt_schema_lint.library.valid
+    error_message = join("\n", [for f in data.standesamt_schema_lint.library.findings : "${f.resource_type}: ${f.message}" if f.severity == "error"])
+  }
+}
+
+output "schema_lint_warnings" {
+  value = [for f in data.standesamt_schema_lint.library.findings : "${f.resource_type}: ${f.message}" if f.severity == "warning"]
+}
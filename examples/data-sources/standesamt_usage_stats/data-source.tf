@@ -0,0 +1,21 @@
+provider "standesamt" {
+  usage_stats = true
+}
+
+data "standesamt_config" "default" {}
+
+resource "standesamt_name" "resource_group" {
+  configurations = data.standesamt_config.default
+  type           = "azurerm_resource_group"
+  name           = "app"
+}
+
+# Counts of the names built per resource type by the name resources. The counts
+# stay in the provider process.
+data "standesamt_usage_stats" "this" {
+  depends_on = [standesamt_name.resource_group]
+}
+
+output "names_per_resource_type" {
+  value = data.standesamt_usage_stats.this.names
+}
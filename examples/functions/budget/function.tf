@@ -0,0 +1,25 @@
+data "standesamt_config" "default" {}
+
+data "standesamt_locations" "default" {}
+
+locals {
+  config = {
+    configuration = data.standesamt_config.default.configuration
+    locations     = data.standesamt_locations.default.locations
+    schema        = data.standesamt_config.default.schema
+  }
+}
+
+variable "base_name" {
+  type = string
+
+  validation {
+    condition     = length(var.base_name) <= provider::standesamt::budget(local.config, "azurerm_storage_account", {})
+    error_message = "The base name is too long for a storage account name."
+  }
+}
+
+# Remaining characters for the name component of a resource group name
+output "resource_group_budget" {
+  value = provider::standesamt::budget(local.config, "azurerm_resource_group", { location = "westeurope" })
+}
@@ -0,0 +1,17 @@
+data "standesamt_config" "default" {}
+
+data "standesamt_locations" "default" {}
+
+locals {
+  config = {
+    configuration = data.standesamt_config.default.configuration
+    locations     = data.standesamt_locations.default.locations
+    schema        = data.standesamt_config.default.schema
+  }
+}
+
+# Share the exact naming configuration with scripts and other tools
+resource "local_file" "naming_config" {
+  filename = "${path.module}/naming-config.json"
+  content  = provider::standesamt::config_export(local.config)
+}
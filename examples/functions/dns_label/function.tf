@@ -0,0 +1,9 @@
+# DNS label of a generated name: "app-service-we-prd"
+output "endpoint_label" {
+  value = provider::standesamt::dns_label("APP_Service.we-PRD", 0)
+}
+
+# Label limited to 11 characters: "app-service"
+output "short_label" {
+  value = provider::standesamt::dns_label("app-service-we-prd", 11)
+}
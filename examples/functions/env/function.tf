@@ -0,0 +1,14 @@
+data "standesamt_config" "default" {}
+
+locals {
+  config = {
+    configuration = data.standesamt_config.default.configuration
+    locations     = {}
+    schema        = data.standesamt_config.default.schema
+  }
+}
+
+# Short token of a long environment name, e.g. "prd" for "production"
+output "environment" {
+  value = provider::standesamt::env(local.config, "production")
+}
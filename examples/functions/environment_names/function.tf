@@ -0,0 +1,16 @@
+data "standesamt_config" "default" {}
+
+data "standesamt_locations" "default" {}
+
+locals {
+  config = {
+    configuration = data.standesamt_config.default.configuration
+    locations     = data.standesamt_locations.default.locations
+    schema        = data.standesamt_config.default.schema
+  }
+}
+
+# Resource group names for all stages, e.g. { dev = "rg-app-weu-dev", prd = "rg-app-weu-prd" }
+output "resource_group_names" {
+  value = provider::standesamt::environment_names(local.config, "azurerm_resource_group", { location = "westeurope" }, "app", ["dev", "tst", "prd"])
+}
@@ -0,0 +1,16 @@
+data "standesamt_config" "default" {}
+
+data "standesamt_locations" "default" {}
+
+locals {
+  config = {
+    configuration = data.standesamt_config.default.configuration
+    locations     = data.standesamt_locations.default.locations
+    schema        = data.standesamt_config.default.schema
+  }
+}
+
+# Location of a short token, e.g. "westeurope" for "we"
+output "location_long" {
+  value = provider::standesamt::location_long(local.config, "we")
+}
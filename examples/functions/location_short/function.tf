@@ -0,0 +1,16 @@
+data "standesamt_config" "default" {}
+
+data "standesamt_locations" "default" {}
+
+locals {
+  config = {
+    configuration = data.standesamt_config.default.configuration
+    locations     = data.standesamt_locations.default.locations
+    schema        = data.standesamt_config.default.schema
+  }
+}
+
+# Short token of a location, e.g. "we" for "westeurope"
+output "location_short" {
+  value = provider::standesamt::location_short(local.config, "westeurope")
+}
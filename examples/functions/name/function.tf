@@ -51,3 +51,43 @@ output "name_full_settings" {
     "example"
   )
 }
+
+# Globally unique names, e.g. for storage accounts and key vaults
+output "name_global_unique" {
+  value = provider::standesamt::name(local.config, "azurerm_storage_account", { preset = "global_unique" }, "example")
+}
+
+# Location token of an on-prem site that is not in the locations map
+output "name_location_short" {
+  value = provider::standesamt::name(local.config, "azurerm_resource_group", { location_short = "fra" }, "example")
+}
+
+# Different hash per module instance while all instances share the random seed
+# of the provider
+output "name_seed_key" {
+  value = provider::standesamt::name(local.config, "azurerm_storage_account", { seed_key = "billing-prd" }, "data")
+}
+
+# Workspace segment, set from TF_WORKSPACE or the workspace provider argument
+output "name_workspace" {
+  value = provider::standesamt::name(local.config, "azurerm_resource_group", { name_precedence = ["abbreviation", "workspace", "name"] }, "example")
+}
+
+# Creation date of a short-lived sandbox. Functions must return the same result
+# during plan and apply, so the date is not read from the clock. time_static
+# keeps the date of the first apply; timestamp() or plantimestamp() would change
+# the name on every plan and replace the resource.
+resource "time_static" "sandbox" {}
+
+output "name_sandbox" {
+  value = provider::standesamt::name(
+    local.config,
+    "azurerm_resource_group",
+    {
+      name_precedence = ["abbreviation", "name", "date"]
+      static_date     = time_static.sandbox.rfc3339
+      date_format     = "yyyymmdd"
+    },
+    "sandbox"
+  )
+}
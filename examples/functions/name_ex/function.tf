@@ -0,0 +1,28 @@
+data "standesamt_config" "default" {}
+
+data "standesamt_locations" "default" {}
+
+locals {
+  config = {
+    configuration = data.standesamt_config.default.configuration
+    locations     = data.standesamt_locations.default.locations
+    schema        = data.standesamt_config.default.schema
+  }
+
+  storage = provider::standesamt::name_ex(local.config, "azurerm_storage_account", { preset = "global_unique" }, "applicationdata")
+}
+
+# The storage account name, e.g. "stapplicationdata1a2b"
+output "storage_account_name" {
+  value = local.storage.name
+}
+
+# Reuse the hash of the storage account name, e.g. for a DNS label
+output "dns_label" {
+  value = "data-${local.storage.hash}"
+}
+
+# True if the name segment was shortened to fit the maximum length
+output "storage_account_truncated" {
+  value = local.storage.truncated
+}
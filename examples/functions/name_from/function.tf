@@ -0,0 +1,35 @@
+data "standesamt_config" "default" {}
+
+data "standesamt_locations" "default" {}
+
+locals {
+  config = {
+    configuration = data.standesamt_config.default.configuration
+    locations     = data.standesamt_locations.default.locations
+    schema        = data.standesamt_config.default.schema
+  }
+
+  # Argument sets built dynamically, e.g. from a variable
+  storage_accounts = {
+    logs  = { name = "logs", settings = { hash_length = 6 } }
+    media = { name = "media" }
+  }
+}
+
+# Same as provider::standesamt::name(local.config, "azurerm_resource_group", {}, "example")
+output "resource_group_name" {
+  value = provider::standesamt::name_from({
+    config = local.config
+    type   = "azurerm_resource_group"
+    name   = "example"
+  })
+}
+
+output "storage_account_names" {
+  value = {
+    for k, v in local.storage_accounts : k => provider::standesamt::name_from(merge(v, {
+      config = local.config
+      type   = "azurerm_storage_account"
+    }))
+  }
+}
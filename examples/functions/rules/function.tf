@@ -0,0 +1,23 @@
+data "standesamt_config" "default" {}
+
+locals {
+  config = {
+    configuration = data.standesamt_config.default.configuration
+    locations     = {}
+    schema        = data.standesamt_config.default.schema
+  }
+}
+
+# Validate a module variable against the rules of the schema library
+variable "storage_account_name" {
+  type = string
+
+  validation {
+    condition     = can(regex(provider::standesamt::rules(local.config, "azurerm_storage_account").regex, var.storage_account_name))
+    error_message = "The name does not match the naming rules of storage accounts."
+  }
+}
+
+output "storage_account_rules" {
+  value = provider::standesamt::rules(local.config, "azurerm_storage_account")
+}
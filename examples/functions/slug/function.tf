@@ -0,0 +1,9 @@
+# Turn a human readable project name into a base name component: "cafe-munchen-platform"
+output "project_slug" {
+  value = provider::standesamt::slug("Café München / Platform", {})
+}
+
+# Slug without separator, limited to 10 characters: "datalakepr"
+output "storage_slug" {
+  value = provider::standesamt::slug("Data Lake (Prod)", { separator = "", max_length = 10 })
+}
@@ -0,0 +1,21 @@
+data "standesamt_config" "default" {}
+
+data "standesamt_locations" "default" {}
+
+locals {
+  config = {
+    configuration = data.standesamt_config.default.configuration
+    locations     = data.standesamt_locations.default.locations
+    schema        = data.standesamt_config.default.schema
+  }
+}
+
+# Tag a resource with the inputs of its name
+resource "azurerm_resource_group" "example" {
+  name     = provider::standesamt::name(local.config, "azurerm_resource_group", {}, "example")
+  location = "westeurope"
+  tags = merge(
+    { owner = "platform" },
+    provider::standesamt::tags(local.config, { key_prefix = "naming:" }),
+  )
+}
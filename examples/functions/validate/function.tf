@@ -41,6 +41,16 @@ output "validation_result_invalid_chars" {
   value = provider::standesamt::validate(local.config, "azurerm_resource_group", {}, "test#invalid")
 }
 
+# Example: Report Azure reserved words such as "login" or "microsoft"
+output "validation_result_reserved_words" {
+  value = provider::standesamt::validate(local.config, "azurerm_resource_group", { reserved_words_check = true }, "prod-login-app").reserved_words_found
+}
+
+# Example: Validate the name of an existing resource as is, e.g. before an import
+output "validation_result_raw" {
+  value = provider::standesamt::validate(local.config, "azurerm_resource_group", {}, "rg-legacy-weu", "raw")
+}
+
 # Example: Using validation result in conditional logic
 locals {
   proposed_name = "example"
@@ -50,7 +60,8 @@ locals {
   is_valid = (
     local.validation.regex.valid &&
     local.validation.length.valid &&
-    (!local.validation.double_hyphens_denied || !local.validation.double_hyphens_found)
+    (!local.validation.double_hyphens_denied || !local.validation.double_hyphens_found) &&
+    length(local.validation.denied_patterns) == 0
   )
 }
 
@@ -70,5 +81,7 @@ output "validation_details" {
     length_max            = local.validation.length.max
     double_hyphens_denied = local.validation.double_hyphens_denied
     double_hyphens_found  = local.validation.double_hyphens_found
+    denied_patterns       = local.validation.denied_patterns
+    reserved_words_found  = local.validation.reserved_words_found
   }
 }
@@ -0,0 +1,21 @@
+data "standesamt_config" "default" {}
+
+data "standesamt_locations" "default" {}
+
+locals {
+  config = {
+    configuration = data.standesamt_config.default.configuration
+    locations     = data.standesamt_locations.default.locations
+    schema        = data.standesamt_config.default.schema
+  }
+}
+
+# Same as provider::standesamt::validate(local.config, "azurerm_storage_account", {}, "stlegacy001", "raw")
+output "existing_storage_account" {
+  value = provider::standesamt::validate_from({
+    config = local.config
+    type   = "azurerm_storage_account"
+    name   = "stlegacy001"
+    mode   = "raw"
+  })
+}
@@ -0,0 +1,41 @@
+terraform {
+  required_providers {
+    standesamt = {
+      source  = "glueckkanja/standesamt"
+      version = "0.1.0"
+    }
+  }
+}
+
+provider "standesamt" {
+  schema_reference = {
+    path = "azure/caf"
+    ref  = "2025.04"
+  }
+}
+
+data "standesamt_config" "default" {}
+
+data "standesamt_locations" "default" {}
+
+locals {
+  config = {
+    configuration = data.standesamt_config.default.configuration
+    locations     = data.standesamt_locations.default.locations
+    schema        = data.standesamt_config.default.schema
+  }
+}
+
+# Example: Validate a resource group name and pass the result as JSON to a
+# policy check, e.g. a script that runs OPA or Conftest on it
+data "external" "policy" {
+  program = ["${path.module}/check-name.sh"]
+  query = {
+    validation = provider::standesamt::validate_json(local.config, "azurerm_resource_group", {}, "example")
+  }
+}
+
+# Example: Validate an existing name as is
+output "validation_json_raw" {
+  value = provider::standesamt::validate_json(local.config, "azurerm_resource_group", {}, "rg-example-we", "raw")
+}
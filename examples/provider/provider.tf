@@ -24,6 +24,116 @@ provider "standesamt" {
     custom_url = "https://example.com/path/to/schema.zip"
   }
 }
+# Provider configuration for a local library next to the module, independent of
+# the working directory of Terraform
+provider "standesamt" {
+  alias = "local"
+  schema_reference = {
+    custom_url = "./naming-library"
+    base_dir   = abspath(path.module)
+  }
+}
+# Provider configuration for a custom library with one naming file per service
+provider "standesamt" {
+  alias = "split"
+  schema_reference = {
+    custom_url     = "git::https://example.com/naming-library.git?ref=v1.0.0"
+    naming_file    = "services/*.naming.json"
+    locations_file = "regions.json"
+  }
+}
+# Provider configuration adding a custom resource type to the schema library
+provider "standesamt" {
+  alias = "inline"
+  inline_schema = [
+    {
+      resource_type    = "azapi_container_app"
+      abbreviation     = "ca"
+      min_length       = 2
+      max_length       = 32
+      validation_regex = "^[a-z][a-z0-9-]{0,30}[a-z0-9]$"
+      configuration = {
+        use_environment     = true
+        use_lower_case      = true
+        use_separator       = true
+        deny_double_hyphens = true
+      }
+    }
+  ]
+}
+# Provider configuration with an internal length limit below the Azure limit
+provider "standesamt" {
+  alias = "limits"
+  schema_overrides = {
+    azurerm_key_vault = {
+      max_length = 20
+    }
+  }
+}
+# Provider configuration with custom site codes as location tokens
+provider "standesamt" {
+  alias = "sites"
+  locations = {
+    dc-frankfurt = "fra"
+    edge-munich  = "muc"
+  }
+  location_merge_strategy = "merge"
+}
+# Provider configuration with environment tokens in addition to the library catalog
+provider "standesamt" {
+  alias = "environments"
+  environments = {
+    production = "prd"
+    staging    = "stg"
+  }
+}
+# Provider configuration with the AWS region short codes bundled with the provider
+provider "standesamt" {
+  alias           = "aws"
+  location_source = "aws"
+}
+# Provider configuration enforcing an organization naming policy
+provider "standesamt" {
+  alias                 = "policy"
+  required_segments     = ["environment", "location"]
+  required_prefix_regex = "^(fin|hr|ops)$"
+}
+# Provider configuration failing on names that change with the library upgrade
+provider "standesamt" {
+  alias = "upgrade"
+  schema_reference = {
+    path = "azure/caf"
+    ref  = "2026.01"
+  }
+  compatibility_ref = {
+    path = "azure/caf"
+    ref  = "2025.04"
+  }
+  compatibility_mode = "error"
+}
+# Provider configuration following the main branch of the schema library. The
+# names may change between plans, allow_mutable_ref silences the warning.
+provider "standesamt" {
+  alias             = "preview"
+  allow_mutable_ref = true
+  schema_reference = {
+    path = "azure/caf"
+    ref  = "main"
+  }
+}
+
+# Provider configuration with its own cache directory, e.g. on a shared build
+# agent. Downloads not used for 7 days are removed from it.
+provider "standesamt" {
+  alias              = "agent"
+  cache_dir          = "/var/cache/standesamt"
+  cache_max_age_days = 7
+  schema_reference = {
+    path = "azure/caf"
+    ref  = "2025.04"
+  }
+}
+
 # Provider configuration using environment variables
 # The following environment variables are supported:
 # - SA_ENVIRONMENT: Sets the environment (e.g., 'prod', 'dev', 'test')
@@ -32,6 +142,8 @@ provider "standesamt" {
 # - SA_RANDOM_SEED: Sets the random seed for unique name generation
 # - SA_HASH_LENGTH: Sets the default hash length
 # - SA_LOWERCASE: Controls lowercase output ('true' or 'false')
+# - SA_STRICT: Controls if invalid names fail ('true') or only warn ('false')
+# - SA_DOWNLOAD_TIMEOUT: Maximum duration of the schema library download, e.g. '30s'
 provider "standesamt" {
   alias = "from_env"
   schema_reference = {
@@ -0,0 +1,18 @@
+# Declare the naming convention of the organization once
+resource "standesamt_convention" "org" {
+  name_precedence      = ["abbreviation", "prefixes", "name", "location", "environment", "hash"]
+  separator            = "-"
+  lowercase            = true
+  allowed_environments = ["dev", "tst", "prd"]
+  allowed_prefixes     = ["app", "ops"]
+
+  # Settings of this configuration, validated against the convention at plan time
+  environment = "prd"
+  prefixes    = ["app"]
+  location    = "westeurope"
+}
+
+# Pass the convention to the naming functions like the standesamt_config data source
+output "resource_group_name" {
+  value = provider::standesamt::name(standesamt_convention.org, "azurerm_resource_group", {}, "billing")
+}
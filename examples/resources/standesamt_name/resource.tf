@@ -0,0 +1,22 @@
+data "standesamt_config" "default" {}
+
+# Name of a storage account, kept in the state and only replaced if it changes
+resource "standesamt_name" "storage" {
+  configurations = data.standesamt_config.default
+  type           = "azurerm_storage_account"
+  name           = "data"
+}
+
+# Adopt an existing name by its identity (Terraform 1.12+)
+import {
+  to = standesamt_name.key_vault
+  identity = {
+    type = "azurerm_key_vault"
+  }
+}
+
+resource "standesamt_name" "key_vault" {
+  configurations = data.standesamt_config.default
+  type           = "azurerm_key_vault"
+  name           = "secrets"
+}
@@ -0,0 +1,22 @@
+# Random suffix for a storage account, generated once and kept in the state
+resource "standesamt_random_suffix" "storage" {
+  resource_type = "azurerm_storage_account"
+  length        = 6
+  charset       = "alphanumeric"
+
+  # A new suffix is generated when a keeper changes
+  keepers = {
+    subscription = "prod"
+  }
+}
+
+data "standesamt_config" "default" {}
+
+output "storage_account_name" {
+  value = provider::standesamt::name(
+    data.standesamt_config.default,
+    "azurerm_storage_account",
+    { name_precedence = ["abbreviation", "name", "suffixes"], suffixes = [standesamt_random_suffix.storage.result] },
+    "data"
+  )
+}
@@ -0,0 +1,10 @@
+data "standesamt_config" "default" {}
+
+# Two storage accounts with the same arguments get different hashes
+resource "standesamt_unique_name" "storage" {
+  count = 2
+
+  configurations = data.standesamt_config.default
+  type           = "azurerm_storage_account"
+  name           = "data"
+}
@@ -0,0 +1,130 @@
+// Copyright glueckkanja AG 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	s "terraform-provider-standesamt/internal/schema"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &AffixesDataSource{}
+
+type affixesDataSourceModel struct {
+	Affixes types.Map `tfsdk:"affixes"`
+}
+
+func affixSetTypeAttributes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"prefixes": types.ListType{ElemType: types.StringType},
+		"suffixes": types.ListType{ElemType: types.StringType},
+	}
+}
+
+// affixesMapValue converts the affix sets of the schema library into a map of
+// objects with the prefixes and suffixes of every set.
+func affixesMapValue(affixes s.AffixesMapSchema) types.Map {
+	elements := make(map[string]attr.Value, len(affixes))
+	for k, v := range affixes {
+		elements[k] = types.ObjectValueMust(affixSetTypeAttributes(), map[string]attr.Value{
+			"prefixes": stringSliceToList(v.Prefixes),
+			"suffixes": stringSliceToList(v.Suffixes),
+		})
+	}
+	return types.MapValueMust(types.ObjectType{AttrTypes: affixSetTypeAttributes()}, elements)
+}
+
+// affixesFromMap is the inverse of affixesMapValue. It returns nil for a null
+// or empty map.
+func affixesFromMap(value types.Map) s.AffixesMapSchema {
+	if len(value.Elements()) == 0 {
+		return nil
+	}
+
+	affixes := make(s.AffixesMapSchema, len(value.Elements()))
+	for k, v := range value.Elements() {
+		obj, ok := v.(types.Object)
+		if !ok {
+			continue
+		}
+		affixes[k] = s.AffixSet{
+			Prefixes: extractStringSlice(obj.Attributes()["prefixes"]),
+			Suffixes: extractStringSlice(obj.Attributes()["suffixes"]),
+		}
+	}
+	return affixes
+}
+
+func NewAffixesDataSource() datasource.DataSource {
+	return &AffixesDataSource{}
+}
+
+// AffixesDataSource defines the data source implementation.
+type AffixesDataSource struct {
+	providerConfig *ProviderConfig
+}
+
+func (d *AffixesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_affixes"
+}
+
+func (d *AffixesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Data source to read the named prefix and suffix sets of the schema library.",
+		MarkdownDescription: "Data source to read the named prefix and suffix sets of the `schema.affixes.json` file of the schema library. A set is referenced by key in the `prefix_set` and `suffix_set` settings of the naming functions, so prefixes and suffixes are maintained in one place instead of every module.",
+		Attributes: map[string]schema.Attribute{
+			"affixes": schema.MapAttribute{
+				Computed:            true,
+				Description:         "The affix sets keyed by name. Each set contains a list of prefixes and a list of suffixes.",
+				MarkdownDescription: "The affix sets keyed by name. Each set contains a list of `prefixes` and a list of `suffixes`.",
+				ElementType: types.ObjectType{
+					AttrTypes: affixSetTypeAttributes(),
+				},
+			},
+		},
+	}
+}
+
+func (d *AffixesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*ProviderConfig)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *ProviderConfig, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.providerConfig = data
+}
+
+func (d *AffixesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var model affixesDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, err := d.providerConfig.Result()
+	if err != nil {
+		resp.Diagnostics.AddError("source_reference", err.Error())
+		return
+	}
+
+	model.Affixes = affixesMapValue(result.Affixes)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
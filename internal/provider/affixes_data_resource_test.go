@@ -0,0 +1,27 @@
+// Copyright glueckkanja AG 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccStandesamtAffixes(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactoriesWithLibrary(testLibrary),
+		ExternalProviders:        map[string]resource.ExternalProvider{},
+		Steps: []resource.TestStep{
+			{
+				Config: `data "standesamt_affixes" "test" {}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.standesamt_affixes.test", "affixes.%", "1"),
+					resource.TestCheckResourceAttr("data.standesamt_affixes.test", "affixes.platform.prefixes.0", "plt"),
+				),
+			},
+		},
+	})
+}
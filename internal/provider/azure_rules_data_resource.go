@@ -0,0 +1,185 @@
+// Copyright glueckkanja AG 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"slices"
+	s "terraform-provider-standesamt/internal/schema"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &AzureRulesDataSource{}
+
+type azureRulesDataSourceModel struct {
+	ResourceTypes types.List `tfsdk:"resource_types"`
+	Rules         types.Map  `tfsdk:"rules"`
+	Mismatches    types.List `tfsdk:"mismatches"`
+	Valid         types.Bool `tfsdk:"valid"`
+}
+
+func azureRuleTypeAttributes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"azure_type": types.StringType,
+		"min_length": types.Int64Type,
+		"max_length": types.Int64Type,
+	}
+}
+
+func azureRuleMismatchTypeAttributes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"resource_type": types.StringType,
+		"azure_type":    types.StringType,
+		"field":         types.StringType,
+		"schema_value":  types.Int64Type,
+		"azure_value":   types.Int64Type,
+		"message":       types.StringType,
+	}
+}
+
+func NewAzureRulesDataSource() datasource.DataSource {
+	return &AzureRulesDataSource{}
+}
+
+// AzureRulesDataSource defines the data source implementation.
+type AzureRulesDataSource struct {
+	providerConfig *ProviderConfig
+}
+
+func (d *AzureRulesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_azure_rules"
+}
+
+func (d *AzureRulesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Data source to compare the length limits of the loaded schema library with the Azure naming rules bundled with the provider.",
+		MarkdownDescription: "Data source to compare the length limits of the loaded schema library with the Azure naming rules bundled with the provider. It reports limits that allow names Azure rejects, e.g. an outdated `maxLength` in a custom library. Stricter limits than Azure are not reported. Resource types without a bundled rule are skipped.",
+		Attributes: map[string]schema.Attribute{
+			"resource_types": schema.ListAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				Description:         "The resource types to compare. Defaults to all resource types of the schema library.",
+				MarkdownDescription: "The resource types to compare. Defaults to all resource types of the schema library.",
+			},
+			"rules": schema.MapAttribute{
+				Computed:            true,
+				Description:         "The bundled Azure rules of the compared resource types, keyed by resource type. Each rule contains the azure_type and the min_length and max_length of names.",
+				MarkdownDescription: "The bundled Azure rules of the compared resource types, keyed by resource type. Each rule contains the `azure_type` and the `min_length` and `max_length` of names.",
+				ElementType: types.ObjectType{
+					AttrTypes: azureRuleTypeAttributes(),
+				},
+			},
+			"mismatches": schema.ListAttribute{
+				Computed:            true,
+				Description:         "The length limits of the schema library that are looser than the Azure rules, sorted by resource type.",
+				MarkdownDescription: "The length limits of the schema library that are looser than the Azure rules, sorted by resource type.",
+				ElementType: types.ObjectType{
+					AttrTypes: azureRuleMismatchTypeAttributes(),
+				},
+			},
+			"valid": schema.BoolAttribute{
+				Computed:            true,
+				Description:         "True if the schema library matches the Azure rules.",
+				MarkdownDescription: "True if the schema library matches the Azure rules.",
+			},
+		},
+	}
+}
+
+func (d *AzureRulesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*ProviderConfig)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *ProviderConfig, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.providerConfig = data
+}
+
+func (d *AzureRulesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var model azureRulesDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, err := d.providerConfig.Result()
+	if err != nil {
+		resp.Diagnostics.AddError("source_reference", err.Error())
+		return
+	}
+
+	schemas := result.NamingSchemas
+	if !model.ResourceTypes.IsNull() {
+		resourceTypes := extractStringSlice(model.ResourceTypes)
+		schemas = slices.DeleteFunc(slices.Clone(schemas), func(schema s.JsonNamingSchema) bool {
+			return !slices.Contains(resourceTypes, schema.ResourceType)
+		})
+	}
+
+	rules := make(map[string]attr.Value)
+	for _, schema := range schemas {
+		rule, ok := s.AzureRules[schema.ResourceType]
+		if !ok {
+			continue
+		}
+		element, diags := types.ObjectValue(azureRuleTypeAttributes(), map[string]attr.Value{
+			"azure_type": types.StringValue(rule.AzureType),
+			"min_length": types.Int64Value(int64(rule.MinLength)),
+			"max_length": types.Int64Value(int64(rule.MaxLength)),
+		})
+		if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+			return
+		}
+		rules[schema.ResourceType] = element
+	}
+
+	mismatches := s.CompareAzureRules(schemas, s.AzureRules)
+	elements := make([]attr.Value, 0, len(mismatches))
+	for _, m := range mismatches {
+		element, diags := types.ObjectValue(azureRuleMismatchTypeAttributes(), map[string]attr.Value{
+			"resource_type": types.StringValue(m.ResourceType),
+			"azure_type":    types.StringValue(m.AzureType),
+			"field":         types.StringValue(m.Field),
+			"schema_value":  types.Int64Value(int64(m.SchemaValue)),
+			"azure_value":   types.Int64Value(int64(m.AzureValue)),
+			"message":       types.StringValue(m.Message),
+		})
+		if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+			return
+		}
+		elements = append(elements, element)
+	}
+
+	rulesMap, diags := types.MapValue(types.ObjectType{AttrTypes: azureRuleTypeAttributes()}, rules)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	mismatchList, diags := types.ListValue(types.ObjectType{AttrTypes: azureRuleMismatchTypeAttributes()}, elements)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	model.Rules = rulesMap
+	model.Mismatches = mismatchList
+	model.Valid = types.BoolValue(len(mismatches) == 0)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
@@ -0,0 +1,29 @@
+// Copyright glueckkanja AG 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccStandesamtAzureRules(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactoriesWithLibrary(testLibrary),
+		ExternalProviders:        map[string]resource.ExternalProvider{},
+		Steps: []resource.TestStep{
+			{
+				Config: `data "standesamt_azure_rules" "test" {}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.standesamt_azure_rules.test", "valid", "true"),
+					resource.TestCheckResourceAttr("data.standesamt_azure_rules.test", "mismatches.#", "0"),
+					resource.TestCheckResourceAttr("data.standesamt_azure_rules.test", "rules.azurerm_resource_group.azure_type", "Microsoft.Resources/resourceGroups"),
+					resource.TestCheckResourceAttr("data.standesamt_azure_rules.test", "rules.azurerm_resource_group.max_length", "90"),
+				),
+			},
+		},
+	})
+}
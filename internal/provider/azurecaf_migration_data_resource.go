@@ -0,0 +1,287 @@
+// Copyright glueckkanja AG 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"slices"
+	"strings"
+	s "terraform-provider-standesamt/internal/schema"
+	"terraform-provider-standesamt/internal/tools"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &AzurecafMigrationDataSource{}
+
+// azurecafSeparator is the default separator of azurecaf_name.
+const azurecafSeparator = "-"
+
+type azurecafMigrationDataSourceModel struct {
+	ResourceType types.String `tfsdk:"resource_type"`
+	Name         types.String `tfsdk:"name"`
+	Prefixes     types.List   `tfsdk:"prefixes"`
+	Suffixes     types.List   `tfsdk:"suffixes"`
+	RandomLength types.Int32  `tfsdk:"random_length"`
+	RandomSeed   types.Int64  `tfsdk:"random_seed"`
+	Separator    types.String `tfsdk:"separator"`
+	UseSlug      types.Bool   `tfsdk:"use_slug"`
+	Result       types.String `tfsdk:"result"`
+	Settings     types.Object `tfsdk:"settings"`
+	ExpectedName types.String `tfsdk:"expected_name"`
+	Differences  types.List   `tfsdk:"differences"`
+	Matches      types.Bool   `tfsdk:"matches"`
+}
+
+// azurecafName holds the arguments of an azurecaf_name resource.
+type azurecafName struct {
+	ResourceType string
+	Name         string
+	Prefixes     []string
+	Suffixes     []string
+	RandomLength int32
+	RandomSeed   int64
+	Separator    string
+	UseSlug      bool
+	Result       string
+}
+
+func azurecafSettingsTypeAttributes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"name_precedence": types.ListType{ElemType: types.StringType},
+		"prefixes":        types.ListType{ElemType: types.StringType},
+		"suffixes":        types.ListType{ElemType: types.StringType},
+		"separator":       types.StringType,
+		"use_separator":   types.BoolType,
+		"hash_length":     types.Int64Type,
+		"random_seed":     types.Int64Type,
+	}
+}
+
+func NewAzurecafMigrationDataSource() datasource.DataSource {
+	return &AzurecafMigrationDataSource{}
+}
+
+// AzurecafMigrationDataSource defines the data source implementation.
+type AzurecafMigrationDataSource struct {
+	providerConfig *ProviderConfig
+}
+
+func (d *AzurecafMigrationDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_azurecaf_migration"
+}
+
+func (d *AzurecafMigrationDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Data source to migrate an azurecaf_name resource of the archived azurecaf provider to the naming functions.",
+		MarkdownDescription: "Data source to migrate an `azurecaf_name` resource of the archived azurecaf provider to the naming functions. Pass the arguments and the `result` of the resource to get the equivalent `settings` of the naming functions, the name they build from the schema library and the differences to the azurecaf name. The random characters of azurecaf cannot be reproduced, so names with a `random_length` keep their name only if it is passed on, e.g. with the `passthrough` convention.",
+		Attributes: map[string]schema.Attribute{
+			"resource_type": schema.StringAttribute{
+				Required:            true,
+				Description:         "The resource_type of the azurecaf_name resource, e.g. 'azurerm_resource_group'.",
+				MarkdownDescription: "The `resource_type` of the `azurecaf_name` resource, e.g. `azurerm_resource_group`.",
+			},
+			"name": schema.StringAttribute{
+				Optional:            true,
+				Description:         "The name of the azurecaf_name resource.",
+				MarkdownDescription: "The `name` of the `azurecaf_name` resource.",
+			},
+			"prefixes": schema.ListAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				Description:         "The prefixes of the azurecaf_name resource.",
+				MarkdownDescription: "The `prefixes` of the `azurecaf_name` resource.",
+			},
+			"suffixes": schema.ListAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				Description:         "The suffixes of the azurecaf_name resource.",
+				MarkdownDescription: "The `suffixes` of the `azurecaf_name` resource.",
+			},
+			"random_length": schema.Int32Attribute{
+				Optional:            true,
+				Description:         "The random_length of the azurecaf_name resource, used as hash length.",
+				MarkdownDescription: "The `random_length` of the `azurecaf_name` resource, used as `hash_length`.",
+				Validators: []validator.Int32{
+					int32validator.Between(0, maxHashLength),
+				},
+			},
+			"random_seed": schema.Int64Attribute{
+				Optional:            true,
+				Description:         "The random_seed of the azurecaf_name resource. The hash of the naming functions differs from the random characters of azurecaf even with the same seed.",
+				MarkdownDescription: "The `random_seed` of the `azurecaf_name` resource. The hash of the naming functions differs from the random characters of azurecaf even with the same seed.",
+			},
+			"separator": schema.StringAttribute{
+				Optional:            true,
+				Description:         "The separator of the azurecaf_name resource. Default '-'",
+				MarkdownDescription: "The `separator` of the `azurecaf_name` resource. Default '-'",
+			},
+			"use_slug": schema.BoolAttribute{
+				Optional:            true,
+				Description:         "The use_slug of the azurecaf_name resource. The abbreviation of the schema library replaces the slug of azurecaf. Default 'true'",
+				MarkdownDescription: "The `use_slug` of the `azurecaf_name` resource. The abbreviation of the schema library replaces the slug of azurecaf. Default 'true'",
+			},
+			"result": schema.StringAttribute{
+				Optional:            true,
+				Description:         "The result of the azurecaf_name resource, i.e. the name to compare with.",
+				MarkdownDescription: "The `result` of the `azurecaf_name` resource, i.e. the name to compare with.",
+			},
+			"settings": schema.ObjectAttribute{
+				Computed:            true,
+				Description:         "The settings of the naming functions equivalent to the azurecaf_name resource. azurecaf joins the prefixes, the slug, the name, the random characters and the suffixes in this order.",
+				MarkdownDescription: "The settings of the naming functions equivalent to the `azurecaf_name` resource. azurecaf joins the prefixes, the slug, the name, the random characters and the suffixes in this order.",
+				AttributeTypes:      azurecafSettingsTypeAttributes(),
+			},
+			"expected_name": schema.StringAttribute{
+				Computed:            true,
+				Description:         "The name the naming functions build with the settings and the provider configuration.",
+				MarkdownDescription: "The name the naming functions build with the `settings` and the provider configuration.",
+			},
+			"differences": schema.ListAttribute{
+				Computed:            true,
+				ElementType:         types.StringType,
+				Description:         "The differences between the result and the expected name. Empty if no result is passed or both names are equal.",
+				MarkdownDescription: "The differences between the `result` and the `expected_name`. Empty if no `result` is passed or both names are equal.",
+			},
+			"matches": schema.BoolAttribute{
+				Computed:            true,
+				Description:         "True if the result is equal to the expected name.",
+				MarkdownDescription: "True if the `result` is equal to the `expected_name`.",
+			},
+		},
+	}
+}
+
+func (d *AzurecafMigrationDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*ProviderConfig)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *ProviderConfig, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.providerConfig = data
+}
+
+func (d *AzurecafMigrationDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var model azurecafMigrationDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	caf := azurecafName{
+		ResourceType: model.ResourceType.ValueString(),
+		Name:         model.Name.ValueString(),
+		Prefixes:     extractStringSlice(model.Prefixes),
+		Suffixes:     extractStringSlice(model.Suffixes),
+		RandomLength: model.RandomLength.ValueInt32(),
+		RandomSeed:   model.RandomSeed.ValueInt64(),
+		Separator:    azurecafSeparator,
+		UseSlug:      model.UseSlug.IsNull() || model.UseSlug.ValueBool(),
+		Result:       model.Result.ValueString(),
+	}
+	if !model.Separator.IsNull() {
+		caf.Separator = model.Separator.ValueString()
+	}
+
+	configurations, typeSchema, err := d.providerConfig.configurationsModel(caf.ResourceType)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("resource_type"), "Invalid resource type", err.Error())
+		return
+	}
+
+	settings := caf.settings()
+	runResp := &function.RunResponse{}
+	builder, _ := buildAndCheckName(ctx, configurations, caf.ResourceType, &settings, types.StringValue(caf.Name), typeSchema, runResp)
+	if runResp.Error != nil {
+		resp.Diagnostics.AddError("Invalid name", runResp.Error.Error())
+		return
+	}
+	resp.Diagnostics.Append(nameWarningDiagnostics(builder.warnings)...)
+	expected := tools.GetBaseString(builder.result.Name)
+
+	// Unset numbers are null, as the naming functions reject a random_seed of 0.
+	hashLength, randomSeed := types.Int64Null(), types.Int64Null()
+	if settings.HashLength > 0 {
+		hashLength = types.Int64Value(int64(settings.HashLength))
+	}
+	if settings.RandomSeed != 0 {
+		randomSeed = types.Int64Value(settings.RandomSeed)
+	}
+	settingsValue, diags := types.ObjectValue(azurecafSettingsTypeAttributes(), map[string]attr.Value{
+		"name_precedence": stringSliceToList(settings.NamePrecedence),
+		"prefixes":        stringSliceToList(settings.Prefixes),
+		"suffixes":        stringSliceToList(settings.Suffixes),
+		"separator":       types.StringValue(settings.Separator),
+		"use_separator":   types.BoolValue(*settings.UseSeparator),
+		"hash_length":     hashLength,
+		"random_seed":     randomSeed,
+	})
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	model.Settings = settingsValue
+	model.ExpectedName = types.StringValue(expected)
+	model.Differences = stringSliceToList(caf.differences(expected, typeSchema.Abbreviation.ValueString()))
+	model.Matches = types.BoolValue(caf.Result != "" && caf.Result == expected)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+// settings returns the settings of the naming functions equivalent to the
+// azurecaf_name resource. The random characters of azurecaf become the hash.
+func (n azurecafName) settings() s.BuildNameSettingsModel {
+	// A hash length of 0 in the settings falls back to the schema library, so
+	// names without random characters leave out the hash instead.
+	precedence := []string{"prefixes", "abbreviation", "name", "hash", "suffixes"}
+	precedence = slices.DeleteFunc(precedence, func(segment string) bool {
+		return segment == "abbreviation" && !n.UseSlug || segment == "hash" && n.RandomLength == 0
+	})
+	useSeparator := n.Separator != ""
+	return s.BuildNameSettingsModel{
+		NamePrecedence: precedence,
+		Prefixes:       n.Prefixes,
+		Suffixes:       n.Suffixes,
+		Separator:      n.Separator,
+		UseSeparator:   &useSeparator,
+		HashLength:     n.RandomLength,
+		RandomSeed:     n.RandomSeed,
+	}
+}
+
+// differences describes why the result of the azurecaf_name resource differs
+// from the expected name, empty if there is no result or both are equal.
+func (n azurecafName) differences(expected, abbreviation string) []string {
+	if n.Result == "" || n.Result == expected {
+		return []string{}
+	}
+
+	differences := []string{fmt.Sprintf("name: azurecaf result '%s' differs from the expected name '%s'", n.Result, expected)}
+	if n.RandomLength > 0 {
+		differences = append(differences, fmt.Sprintf("random_length: the %d random characters of azurecaf cannot be reproduced, the hash of the expected name differs", n.RandomLength))
+	}
+	if n.UseSlug && n.Separator != "" && abbreviation != "" && !slices.Contains(strings.Split(n.Result, n.Separator), abbreviation) {
+		differences = append(differences, fmt.Sprintf("abbreviation: the abbreviation '%s' of the schema library is not part of the azurecaf result", abbreviation))
+	}
+	return differences
+}
@@ -0,0 +1,79 @@
+// Copyright glueckkanja AG 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"terraform-provider-standesamt/internal/tools"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAzurecafName(t *testing.T) {
+	config := &ProviderConfig{SourceRef: testLibrary}
+	config.ProviderData.configProviderDefaults()
+	model, typeSchema, err := config.configurationsModel("azurerm_resource_group")
+	assert.NoError(t, err)
+
+	build := func(caf azurecafName) string {
+		settings := caf.settings()
+		resp := &function.RunResponse{}
+		name := buildCheckedName(context.Background(), model, caf.ResourceType, &settings, types.StringValue(caf.Name), typeSchema, resp)
+		assert.Nil(t, resp.Error)
+		return tools.GetBaseString(name)
+	}
+
+	caf := azurecafName{ResourceType: "azurerm_resource_group", Name: "billing", Prefixes: []string{"app"}, Suffixes: []string{"001"}, Separator: "-", UseSlug: true, Result: "app-rg-billing-001"}
+	assert.Equal(t, []string{"prefixes", "abbreviation", "name", "suffixes"}, caf.settings().NamePrecedence)
+	assert.Equal(t, "app-rg-billing-001", build(caf))
+	assert.Empty(t, caf.differences("app-rg-billing-001", "rg"))
+
+	caf.UseSlug = false
+	caf.Separator = ""
+	assert.Equal(t, "appbilling001", build(caf))
+
+	// The random characters of azurecaf become the hash.
+	caf = azurecafName{ResourceType: "azurerm_resource_group", Name: "billing", RandomLength: 5, Separator: "-", UseSlug: true, Result: "resgrp-billing-xvlbz"}
+	assert.Regexp(t, `^rg-billing-[a-z]{5}$`, build(caf))
+	assert.Equal(t, []string{
+		"name: azurecaf result 'resgrp-billing-xvlbz' differs from the expected name 'rg-billing-abcde'",
+		"random_length: the 5 random characters of azurecaf cannot be reproduced, the hash of the expected name differs",
+		"abbreviation: the abbreviation 'rg' of the schema library is not part of the azurecaf result",
+	}, caf.differences("rg-billing-abcde", "rg"))
+
+	caf.Result = ""
+	assert.Empty(t, caf.differences("rg-billing-abcde", "rg"))
+}
+
+func TestAccStandesamtAzurecafMigration(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactoriesWithLibrary(testLibrary),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "standesamt_azurecaf_migration" "rg" {
+  resource_type = "azurerm_resource_group"
+  name          = "billing"
+  prefixes      = ["app"]
+  suffixes      = ["001"]
+  result        = "app-rg-billing-001"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.standesamt_azurecaf_migration.rg", "expected_name", "app-rg-billing-001"),
+					resource.TestCheckResourceAttr("data.standesamt_azurecaf_migration.rg", "matches", "true"),
+					resource.TestCheckResourceAttr("data.standesamt_azurecaf_migration.rg", "differences.#", "0"),
+					resource.TestCheckResourceAttr("data.standesamt_azurecaf_migration.rg", "settings.name_precedence.#", "4"),
+					resource.TestCheckResourceAttr("data.standesamt_azurecaf_migration.rg", "settings.separator", "-"),
+				),
+			},
+		},
+	})
+}
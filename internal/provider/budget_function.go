@@ -0,0 +1,73 @@
+// Copyright glueckkanja AG 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = &BudgetFunction{}
+
+type BudgetFunction struct{}
+
+func NewBudgetFunction() function.Function {
+	return &BudgetFunction{}
+}
+
+func (f *BudgetFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "budget"
+}
+
+func (f *BudgetFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Calculate the remaining length for the name component",
+		Description: "Return how many characters remain for the free-form name component of a resource name after abbreviation, prefixes, suffixes, location, environment, hash and separators are accounted for.",
+		MarkdownDescription: "Return how many characters remain for the free-form `name` component of a resource name after abbreviation, " +
+			"prefixes, suffixes, location, environment, hash and separators are accounted for. The result is `0` when the " +
+			"name precedence does not contain `name` and negative when the other segments alone exceed the maximum length.",
+		Parameters: []function.Parameter{
+			configurationsParameter(),
+			function.StringParameter{
+				Name:        "name_type",
+				Description: "The resource type to use for the name.",
+			},
+			settingsParameter(),
+		},
+		Return: function.Int64Return{},
+	}
+}
+
+func (f *BudgetFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var (
+		nameType              string
+		configurationsDynamic types.Dynamic
+		settingsDynamic       types.Dynamic
+	)
+
+	if resp.Error = req.Arguments.Get(ctx, &configurationsDynamic, &nameType, &settingsDynamic); resp.Error != nil {
+		return
+	}
+
+	configurations, err := configurationsArgument(ctx, configurationsDynamic)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	model, buildNameSettings, typeSchema, err := parseConfigurations(ctx, configurations, nameType, settingsDynamic, resp)
+	if err != nil || resp.Error != nil {
+		return
+	}
+
+	builder := newNameBuilder(ctx, model, typeSchema, buildNameSettings)
+	budget := builder.nameBudget(resp)
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, budget))
+}
@@ -0,0 +1,98 @@
+// Copyright glueckkanja AG 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+)
+
+func TestBudgetFunction_Null(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactoriesUnique(),
+		Steps: []resource.TestStep{
+			{
+				Config: `output "test" {
+							value = provider::standesamt::budget(null, null, null)
+						}`,
+				ExpectError: regexp.MustCompile(`Invalid value for "configurations" parameter: argument must not be null\.`),
+			},
+		},
+	})
+}
+
+func TestBudgetFunction_DefaultPrecedence(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactoriesUnique(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf("%s %s", default_config_with_no_settings_default_precedence, `output "test" {
+					value = provider::standesamt::budget(local.config, "azurerm_resource_group", local.settings)
+				}`),
+				ConfigStateChecks: []statecheck.StateCheck{
+					// max length 20 minus "rg-" and "-we"
+					statecheck.ExpectKnownOutputValue("test", knownvalue.Int64Exact(14)),
+				},
+			},
+		},
+	})
+}
+
+func TestBudgetFunction_Settings(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactoriesUnique(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf("%s %s", default_config_with_no_settings_default_precedence, `output "test" {
+					value = provider::standesamt::budget(local.config, "azurerm_resource_group", {
+						environment = "tst"
+						hash_length = 4
+					})
+				}`),
+				ConfigStateChecks: []statecheck.StateCheck{
+					// max length 20 minus "rg-", "-we", "-tst" and "-xxxx"
+					statecheck.ExpectKnownOutputValue("test", knownvalue.Int64Exact(5)),
+				},
+			},
+		},
+	})
+}
+
+func TestBudgetFunction_MaxLength(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactoriesUnique(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf("%s %s", default_config_with_no_settings_default_precedence, `output "test" {
+					value = provider::standesamt::budget(local.config, "azurerm_resource_group", {
+						max_length = 10
+					})
+				}`),
+				ConfigStateChecks: []statecheck.StateCheck{
+					// max_length 10 minus "rg-" and "-we"
+					statecheck.ExpectKnownOutputValue("test", knownvalue.Int64Exact(4)),
+				},
+			},
+		},
+	})
+}
+
+func TestBudgetFunction_MissingResourceType(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactoriesUnique(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf("%s %s", default_config_with_no_settings_default_precedence, `output "test" {
+					value = provider::standesamt::budget(local.config, "invalid_resource_type", local.settings)
+				}`),
+				ExpectError: regexp.MustCompile(`(?s)resource type\s+'invalid_resource_type' not found in schema`),
+			},
+		},
+	})
+}
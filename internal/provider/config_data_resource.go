@@ -5,14 +5,22 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"io/fs"
+	"maps"
+	"reflect"
+	"slices"
+	"strings"
 	s "terraform-provider-standesamt/internal/schema"
 )
 
@@ -25,21 +33,35 @@ func NewSchemaDataSource() datasource.DataSource {
 
 // SchemaDataSource defines the data source implementation.
 type SchemaDataSource struct {
-	sourceRef        fs.FS
+	providerConfig   *ProviderConfig
 	providerSettings providerData
 }
 
 type configurationModel struct {
-	Convention  types.String `tfsdk:"convention"`
-	Environment types.String `tfsdk:"environment"`
-	Separator   types.String `tfsdk:"separator"`
-	RandomSeed  types.Int64  `tfsdk:"random_seed"`
-	HashLength  types.Int32  `tfsdk:"hash_length"`
-	Lowercase   types.Bool   `tfsdk:"lowercase"`
-	Uppercase   types.Bool   `tfsdk:"uppercase"`
-	Prefixes    types.List   `tfsdk:"prefixes"`
-	Suffixes    types.List   `tfsdk:"suffixes"`
-	Location    types.String `tfsdk:"location"`
+	Convention   types.String `tfsdk:"convention"`
+	Environment  types.String `tfsdk:"environment"`
+	Separator    types.String `tfsdk:"separator"`
+	RandomSeed   types.Int64  `tfsdk:"random_seed"`
+	HashLength   types.Int32  `tfsdk:"hash_length"`
+	Lowercase    types.Bool   `tfsdk:"lowercase"`
+	Uppercase    types.Bool   `tfsdk:"uppercase"`
+	Prefixes     types.List   `tfsdk:"prefixes"`
+	Suffixes     types.List   `tfsdk:"suffixes"`
+	Location     types.String `tfsdk:"location"`
+	Strict       types.Bool   `tfsdk:"strict"`
+	DenyPatterns types.List   `tfsdk:"deny_patterns"`
+
+	RequiredSegments    types.List   `tfsdk:"required_segments"`
+	RequiredPrefixRegex types.String `tfsdk:"required_prefix_regex"`
+	MinGlobalHashLength types.Int32  `tfsdk:"min_global_hash_length"`
+	Affixes             types.Map    `tfsdk:"affixes"`
+	Environments        types.Map    `tfsdk:"environments"`
+	AllowedEnvironments types.List   `tfsdk:"allowed_environments"`
+	AllowedPrefixes     types.List   `tfsdk:"allowed_prefixes"`
+	Compatibility       types.Map    `tfsdk:"compatibility"`
+	CompatibilityMode   types.String `tfsdk:"compatibility_mode"`
+	Workspace           types.String `tfsdk:"workspace"`
+	Stack               types.String `tfsdk:"stack"`
 }
 
 // SchemaDataSourceModel describes the data source data model.
@@ -56,6 +78,17 @@ type schemaDataSourceModel struct {
 	Schema        types.Map    `tfsdk:"schema"`
 	Configuration types.Object `tfsdk:"configuration"`
 	Location      types.String `tfsdk:"location"`
+	ResourceTypes types.Set    `tfsdk:"resource_types"`
+	IncludeSchema types.Bool   `tfsdk:"include_schema"`
+	Strict        types.Bool   `tfsdk:"strict"`
+	DenyPatterns  types.List   `tfsdk:"deny_patterns"`
+	ConfigJson    types.String `tfsdk:"config_json"`
+
+	RequiredSegments    types.List   `tfsdk:"required_segments"`
+	RequiredPrefixRegex types.String `tfsdk:"required_prefix_regex"`
+	MinGlobalHashLength types.Int32  `tfsdk:"min_global_hash_length"`
+	Fingerprint         types.String `tfsdk:"configuration_fingerprint"`
+	Library             types.Object `tfsdk:"library"`
 }
 
 func (d *SchemaDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -64,16 +97,30 @@ func (d *SchemaDataSource) Metadata(_ context.Context, req datasource.MetadataRe
 
 func configurationTypeAttributes() map[string]attr.Type {
 	return map[string]attr.Type{
-		"convention":  types.StringType,
-		"environment": types.StringType,
-		"separator":   types.StringType,
-		"random_seed": types.Int64Type,
-		"hash_length": types.Int32Type,
-		"lowercase":   types.BoolType,
-		"uppercase":   types.BoolType,
-		"prefixes":    types.ListType{ElemType: types.StringType},
-		"suffixes":    types.ListType{ElemType: types.StringType},
-		"location":    types.StringType, //TODO
+		"convention":    types.StringType,
+		"environment":   types.StringType,
+		"separator":     types.StringType,
+		"random_seed":   types.Int64Type,
+		"hash_length":   types.Int32Type,
+		"lowercase":     types.BoolType,
+		"uppercase":     types.BoolType,
+		"prefixes":      types.ListType{ElemType: types.StringType},
+		"suffixes":      types.ListType{ElemType: types.StringType},
+		"location":      types.StringType, //TODO
+		"strict":        types.BoolType,
+		"deny_patterns": types.ListType{ElemType: types.StringType},
+
+		"required_segments":      types.ListType{ElemType: types.StringType},
+		"required_prefix_regex":  types.StringType,
+		"min_global_hash_length": types.Int32Type,
+		"affixes":                types.MapType{ElemType: types.ObjectType{AttrTypes: affixSetTypeAttributes()}},
+		"environments":           types.MapType{ElemType: types.StringType},
+		"allowed_environments":   types.ListType{ElemType: types.StringType},
+		"allowed_prefixes":       types.ListType{ElemType: types.StringType},
+		"compatibility":          types.MapType{ElemType: types.ObjectType{AttrTypes: s.SchemaTypeAttributes()}},
+		"compatibility_mode":     types.StringType,
+		"workspace":              types.StringType,
+		"stack":                  types.StringType,
 	}
 }
 
@@ -106,11 +153,25 @@ func (d *SchemaDataSource) Schema(_ context.Context, _ datasource.SchemaRequest,
 				Optional:            true,
 				Description:         "A random seed used by the random number generator. This is used to generate a random name for the naming schema. The default value is 1337. Make sure to update this value to avoid collisions for globally unique names. Will override the random seed defined in the provider settings.",
 				MarkdownDescription: "A random seed used by the random number generator. This is used to generate a random name for the naming schema. The default value is 1337. Make sure to update this value to avoid collisions for globally unique names. Will override the random seed defined in the provider settings.",
+				Validators: []validator.Int64{
+					int64validator.NoneOf(0),
+				},
 			},
 			"hash_length": schema.Int32Attribute{
 				Optional:            true,
 				Description:         "Default hash length. Overrides all schema configurations. Overrides the default hash length defined in the provider settings.",
 				MarkdownDescription: "Default hash length. Overrides all schema configurations. Overrides the default hash length defined in the provider settings.",
+				Validators: []validator.Int32{
+					int32validator.Between(0, maxHashLength),
+				},
+			},
+			"min_global_hash_length": schema.Int32Attribute{
+				Optional:            true,
+				Description:         "Minimum hash length of resource types with scope 'global'. Applied when the hash length comes from the naming schema. Overrides the minimum defined in the provider settings.",
+				MarkdownDescription: "Minimum hash length of resource types with scope `global`. Applied when the hash length comes from the naming schema. Overrides the minimum defined in the provider settings.",
+				Validators: []validator.Int32{
+					int32validator.Between(0, maxHashLength),
+				},
 			},
 			"lowercase": schema.BoolAttribute{
 				Optional:            true,
@@ -122,6 +183,33 @@ func (d *SchemaDataSource) Schema(_ context.Context, _ datasource.SchemaRequest,
 				Description:         "Control if the resulting name should be upper case. Overrides all schema configurations. Overrides the default uppercase setting defined in the provider settings.",
 				MarkdownDescription: "Control if the resulting name should be upper case. Overrides all schema configurations. Overrides the default uppercase setting defined in the provider settings.",
 			},
+			"strict": schema.BoolAttribute{
+				Optional:            true,
+				Description:         "Control if names violating the naming schema (length, regex, double hyphens) fail the name function. When false, the name is returned as is with a warning. The name function can only write the warning to the provider log (TF_LOG=WARN); the standesamt_name and standesamt_unique_name resources report it as a warning diagnostic. Overrides the default strict setting defined in the provider settings.",
+				MarkdownDescription: "Control if names violating the naming schema (length, regex, double hyphens) fail the `name` function. When `false`, the name is returned as is with a warning. The `name` function can only write the warning to the provider log (`TF_LOG=WARN`); the `standesamt_name` and `standesamt_unique_name` resources report it as a warning diagnostic. Overrides the default strict setting defined in the provider settings.",
+			},
+			"config_json": schema.StringAttribute{
+				Optional:            true,
+				Description:         "A configuration document rendered by the config_export function. The schema of the document replaces the schema library and its configuration replaces the provider settings. All other arguments of this data source still take precedence.",
+				MarkdownDescription: "A configuration document rendered by the `config_export` function. The schema of the document replaces the schema library and its configuration replaces the provider settings. All other arguments of this data source still take precedence.",
+			},
+			"deny_patterns": schema.ListAttribute{
+				Optional:            true,
+				Description:         "A list of regular expressions the resulting name must not match, e.g. '^[0-9]' or '(?i)microsoft'. Checked in addition to the deny patterns of the naming schema. Overrides the deny patterns defined in the provider settings.",
+				MarkdownDescription: "A list of regular expressions the resulting name must not match, e.g. `^[0-9]` or `(?i)microsoft`. Checked in addition to the deny patterns of the naming schema. Overrides the deny patterns defined in the provider settings.",
+				ElementType:         types.StringType,
+			},
+			"required_segments": schema.ListAttribute{
+				Optional:            true,
+				Description:         "Name precedence segments every generated name must contain, e.g. ['environment', 'location']. The name function fails if a segment is missing. Overrides the required segments defined in the provider settings.",
+				MarkdownDescription: "Name precedence segments every generated name must contain, e.g. `[\"environment\", \"location\"]`. The `name` function fails if a segment is missing. Overrides the required segments defined in the provider settings.",
+				ElementType:         types.StringType,
+			},
+			"required_prefix_regex": schema.StringAttribute{
+				Optional:            true,
+				Description:         "A regular expression at least one prefix of every generated name must match, e.g. an owner prefix like '^(fin|hr|ops)$'. Overrides the required prefix regex defined in the provider settings.",
+				MarkdownDescription: "A regular expression at least one prefix of every generated name must match, e.g. an owner prefix like `^(fin|hr|ops)$`. Overrides the required prefix regex defined in the provider settings.",
+			},
 			"prefixes": schema.ListAttribute{
 				Optional:            true,
 				Description:         "A list of strings used as prefixes for the resulting name. Each prefix will be used in order and separated by the separator. Default '[]'",
@@ -136,12 +224,34 @@ func (d *SchemaDataSource) Schema(_ context.Context, _ datasource.SchemaRequest,
 			},
 			"location": schema.StringAttribute{
 				Optional:            true,
-				Description:         "A location string used to lookup in the locations schema. In the default schema library this is a list of locations for Azure. If you set the location to 'westeurope' the resulting name will be 'we'.",
-				MarkdownDescription: "A location string used to lookup in the locations schema. In the default schema library this is a list of locations for Azure. If you set the location to 'westeurope' the resulting name will be 'we'.",
+				Description:         "A location string used to lookup in the locations schema. In the default schema library this is a list of Azure regions, custom libraries may define locations of any platform. If you set the location to 'westeurope' the resulting name will be 'we'.",
+				MarkdownDescription: "A location string used to lookup in the locations schema. In the default schema library this is a list of Azure regions, custom libraries may define locations of any platform. If you set the location to 'westeurope' the resulting name will be 'we'.",
+			},
+			"resource_types": schema.SetAttribute{
+				Optional:            true,
+				Description:         "Limit the schema map to the given resource types. Use this to keep the state small when only a few resource types are named. Default: all resource types of the schema library.",
+				MarkdownDescription: "Limit the `schema` map to the given resource types. Use this to keep the state small when only a few resource types are named. Default: all resource types of the schema library.",
+				ElementType:         types.StringType,
+			},
+			"include_schema": schema.BoolAttribute{
+				Optional:            true,
+				Description:         "Control if the schema map is populated. Set to 'false' if the schema is not passed to the naming functions, e.g. when only the configuration is used. Default 'true'",
+				MarkdownDescription: "Control if the `schema` map is populated. Set to `false` if the schema is not passed to the naming functions, e.g. when only the configuration is used. Default `true`",
+			},
+			"configuration_fingerprint": schema.StringAttribute{
+				Computed:            true,
+				Description:         "A stable hash of the resolved configuration, the schema reference and the naming schemas. It changes whenever an input of the generated names changes.",
+				MarkdownDescription: "A stable hash of the resolved `configuration`, the schema reference and the naming schemas in `schema`. It changes whenever an input of the generated names changes, e.g. to trigger a review before resources are replaced.",
+			},
+			"library": schema.ObjectAttribute{
+				Computed:            true,
+				Description:         "The name, version, description, authors and changelog URL of the library.json manifest of the schema library. Null if the library has no manifest or config_json is set.",
+				MarkdownDescription: "The `name`, `version`, `description`, `authors` and `changelog` URL of the `library.json` manifest at the root of the schema library, e.g. to print which library version a plan used. Null if the library has no manifest or `config_json` is set.",
+				AttributeTypes:      libraryTypeAttributes(),
 			},
 			"schema": schema.MapAttribute{
 				Description:         "A map of naming schema objects that is generated from the schema library file schema.naming.json. This attribute is used to get passed to the naming function.",
-				MarkdownDescription: "A map of naming schema objects that is generated from the schema library file schema.naming.json. This attribute is used to get passed to the naming function.",
+				MarkdownDescription: "A map of naming schema objects that is generated from the schema library file schema.naming.json. This attribute is used to get passed to the naming function. Use `resource_types` or `include_schema` to reduce its size.",
 				Computed:            true,
 				ElementType: types.ObjectType{
 					AttrTypes: s.SchemaTypeAttributes(),
@@ -172,7 +282,7 @@ func (d *SchemaDataSource) Configure(_ context.Context, req datasource.Configure
 		return
 	}
 
-	d.sourceRef = data.SourceRef
+	d.providerConfig = data
 	d.providerSettings = data.ProviderData
 }
 
@@ -188,65 +298,171 @@ func (d *SchemaDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 		return
 	}
 
-	result := s.Result{}
-	process := s.NewProcessorClient(d.sourceRef)
-	if err := process.Process(&result); err != nil {
+	// The provider_meta defaults of the module have the lowest precedence.
+	providerMeta, diags := getProviderMeta(ctx, req.ProviderMeta)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	namingSchemaMap, err := d.providerConfig.NamingSchemaMap()
+	if err != nil {
 		resp.Diagnostics.AddError("source_reference", err.Error())
 		return
 	}
+	result, err := d.providerConfig.Result()
+	if err != nil {
+		resp.Diagnostics.AddError("source_reference", err.Error())
+		return
+	}
+
+	// A document rendered by config_export replaces the schema library and takes
+	// precedence over the provider settings. Arguments of the data source still win.
+	providerSettings := d.providerSettings
+	schemaReference := ""
+	if sourceRef, diags := providerSettings.getSourceRef(ctx); !diags.HasError() {
+		schemaReference = sourceRef.String()
+	}
+	defaultPrefixes := types.ListValueMust(types.StringType, []attr.Value{})
+	defaultSuffixes := types.ListValueMust(types.StringType, []attr.Value{})
+	defaultLocation := types.StringNull()
+	compatibilitySchemaMap, err := d.providerConfig.CompatibilityNamingSchemaMap(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("compatibility_ref", err.Error())
+		return
+	}
+	configuration.Affixes = affixesMapValue(result.Affixes)
+	configuration.Environments, configuration.AllowedEnvironments = environmentsValues(result.Environments)
+	configuration.AllowedPrefixes = types.ListValueMust(types.StringType, []attr.Value{})
+	data.Library = libraryValue(result.Library)
+	if !data.ConfigJson.IsNull() && !data.ConfigJson.IsUnknown() {
+		data.Library = types.ObjectNull(libraryTypeAttributes())
+		document, err := parseConfigExportDocument(data.ConfigJson.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("config_json"), "Invalid configuration document", err.Error())
+			return
+		}
+		namingSchemaMap = document.namingSchemaMap()
+		schemaReference = "config_json"
+		providerSettings = document.Configuration.applyTo(providerSettings)
+		defaultPrefixes = stringSliceToList(document.Configuration.Prefixes)
+		defaultSuffixes = stringSliceToList(document.Configuration.Suffixes)
+		defaultLocation = types.StringPointerValue(document.Configuration.Location)
+		configuration.Affixes = affixesMapValue(document.Configuration.Affixes)
+		compatibilitySchemaMap = s.NewNamingSchemaMap(slices.Collect(maps.Values(document.Configuration.Compatibility)))
+		configuration.Environments, configuration.AllowedEnvironments = environmentsValues(s.EnvironmentsSchema{
+			Environments: document.Configuration.Environments,
+			Allowed:      document.Configuration.AllowedEnvironments,
+		})
+		configuration.AllowedPrefixes = stringSliceToList(document.Configuration.AllowedPrefixes)
+	}
 
 	configuration.Convention = data.Convention
 	if configuration.Convention.IsNull() {
-		if d.providerSettings.Convention.IsNull() {
+		if providerSettings.Convention.IsNull() {
 			configuration.Convention = types.StringValue("default")
 		} else {
-			configuration.Convention = d.providerSettings.Convention
+			configuration.Convention = providerSettings.Convention
 		}
 	}
 
 	configuration.Separator = data.Separator
 	if configuration.Separator.IsNull() {
-		configuration.Separator = d.providerSettings.Separator
+		configuration.Separator = providerSettings.Separator
 	}
 
 	configuration.Prefixes = data.Prefixes
 	if configuration.Prefixes.IsNull() || len(configuration.Prefixes.Elements()) == 0 {
-		configuration.Prefixes = types.ListValueMust(types.StringType, []attr.Value{})
+		configuration.Prefixes = defaultPrefixes
+	}
+	if len(configuration.Prefixes.Elements()) == 0 && !providerMeta.Prefixes.IsNull() {
+		configuration.Prefixes = providerMeta.Prefixes
 	}
 
 	configuration.Suffixes = data.Suffixes
 	if configuration.Suffixes.IsNull() || len(configuration.Suffixes.Elements()) == 0 {
-		configuration.Suffixes = types.ListValueMust(types.StringType, []attr.Value{})
+		configuration.Suffixes = defaultSuffixes
+	}
+	if len(configuration.Suffixes.Elements()) == 0 && !providerMeta.Suffixes.IsNull() {
+		configuration.Suffixes = providerMeta.Suffixes
 	}
 
 	configuration.RandomSeed = data.RandomSeed
 	if configuration.RandomSeed.IsNull() {
-		configuration.RandomSeed = d.providerSettings.RandomSeed
+		configuration.RandomSeed = providerSettings.RandomSeed
 	}
 
 	configuration.HashLength = data.HashLength
 	if configuration.HashLength.IsNull() {
-		configuration.HashLength = d.providerSettings.HashLength
+		configuration.HashLength = providerSettings.HashLength
 	}
 
 	configuration.Lowercase = data.Lowercase
 	if configuration.Lowercase.IsNull() {
-		configuration.Lowercase = d.providerSettings.Lowercase
+		configuration.Lowercase = providerSettings.Lowercase
 	}
 
 	configuration.Uppercase = data.Uppercase
 	if configuration.Uppercase.IsNull() {
-		configuration.Uppercase = d.providerSettings.Uppercase
+		configuration.Uppercase = providerSettings.Uppercase
 	}
 
-	configuration.Environment = data.Environment
-	if configuration.Environment.IsNull() {
-		configuration.Environment = d.providerSettings.Environment
+	configuration.DenyPatterns = data.DenyPatterns
+	if configuration.DenyPatterns.IsNull() {
+		configuration.DenyPatterns = providerSettings.DenyPatterns
 	}
 
+	configuration.Strict = data.Strict
+	if configuration.Strict.IsNull() {
+		configuration.Strict = providerSettings.Strict
+	}
+
+	configuration.RequiredSegments = data.RequiredSegments
+	if configuration.RequiredSegments.IsNull() {
+		configuration.RequiredSegments = providerSettings.RequiredSegments
+	}
+
+	configuration.RequiredPrefixRegex = data.RequiredPrefixRegex
+	if configuration.RequiredPrefixRegex.IsNull() {
+		configuration.RequiredPrefixRegex = providerSettings.RequiredPrefixRegex
+	}
+
+	configuration.MinGlobalHashLength = data.MinGlobalHashLength
+	if configuration.MinGlobalHashLength.IsNull() {
+		configuration.MinGlobalHashLength = providerSettings.MinGlobalHashLength
+	}
+
+	configuration.Environment = resolveEnvironment(data.Environment, providerSettings.Environment, providerMeta.Environment)
+
 	configuration.Location = data.Location
+	if configuration.Location.IsNull() {
+		configuration.Location = defaultLocation
+	}
+
+	configuration.CompatibilityMode = providerSettings.CompatibilityMode
+	configuration.Workspace = providerSettings.Workspace
+	configuration.Stack = providerSettings.Stack
 
-	resultingNamingSchemaMap, _ := types.MapValueFrom(ctx, types.ObjectType{AttrTypes: s.SchemaTypeAttributes()}, s.NewNamingSchemaMap(result.NamingSchemas))
+	namingSchemaMap, diags = filterNamingSchemaMap(ctx, namingSchemaMap, data.ResourceTypes, data.IncludeSchema)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	if conflicts := hashLengthConflicts(namingSchemaMap, configuration.HashLength.ValueInt32(), configuration.Separator.ValueString()); len(conflicts) > 0 {
+		resp.Diagnostics.AddAttributeWarning(path.Root("hash_length"), "Hash length exceeds resource type limits", fmt.Sprintf(
+			"hash_length %d leaves no room for a valid name of the following resource types, their names fail with a length violation unless a smaller hash_length is passed in the settings: %s",
+			configuration.HashLength.ValueInt32(), strings.Join(conflicts, ", ")))
+	}
+
+	// The compatibility schemas are only embedded for the resource types whose
+	// naming schema differs, so the state and every configurations argument do
+	// not carry a second copy of the library.
+	compatibilitySchemaMap = filterCompatibilitySchemaMap(compatibilitySchemaMap, namingSchemaMap)
+	configuration.Compatibility, diags = types.MapValueFrom(ctx, types.ObjectType{AttrTypes: s.SchemaTypeAttributes()}, compatibilitySchemaMap)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	resultingNamingSchemaMap, _ := types.MapValueFrom(ctx, types.ObjectType{AttrTypes: s.SchemaTypeAttributes()}, namingSchemaMap)
 
 	data.Schema = resultingNamingSchemaMap
 	var configObj, diagnostic = types.ObjectValueFrom(ctx, configurationTypeAttributes(), configuration)
@@ -256,6 +472,147 @@ func (d *SchemaDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 	}
 	data.Configuration = configObj
 
+	fingerprint, err := configurationFingerprint(configuration, schemaReference, namingSchemaMap)
+	if err != nil {
+		resp.Diagnostics.AddError("configuration_fingerprint", err.Error())
+		return
+	}
+	data.Fingerprint = types.StringValue(fingerprint)
+
 	// Save data into state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
+
+func libraryTypeAttributes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"name":        types.StringType,
+		"version":     types.StringType,
+		"description": types.StringType,
+		"authors":     types.ListType{ElemType: types.StringType},
+		"changelog":   types.StringType,
+	}
+}
+
+// libraryValue returns the library attribute for the manifest of a schema
+// library, null if the library has no manifest.
+func libraryValue(manifest *s.LibraryManifest) types.Object {
+	if manifest == nil {
+		return types.ObjectNull(libraryTypeAttributes())
+	}
+	return types.ObjectValueMust(libraryTypeAttributes(), map[string]attr.Value{
+		"name":        types.StringValue(manifest.Name),
+		"version":     types.StringValue(manifest.Version),
+		"description": types.StringValue(manifest.Description),
+		"authors":     stringSliceToList(manifest.Authors),
+		"changelog":   types.StringValue(manifest.Changelog),
+	})
+}
+
+// configurationFingerprint returns a stable hash of the resolved configuration, the
+// schema reference and the naming schemas.
+func configurationFingerprint(configuration configurationModel, schemaReference string, namingSchemaMap s.NamingSchemaMap) (string, error) {
+	schemas := make(map[string]s.JsonNamingSchema, len(namingSchemaMap))
+	for k, v := range namingSchemaMap {
+		schemas[k] = v.ToJsonNamingSchema()
+	}
+
+	data, err := json.Marshal(struct {
+		Configuration   configExportConfiguration     `json:"configuration"`
+		SchemaReference string                        `json:"schema_reference"`
+		Schema          map[string]s.JsonNamingSchema `json:"schema"`
+	}{
+		Configuration:   newConfigExportConfiguration(configuration),
+		SchemaReference: schemaReference,
+		Schema:          schemas,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to compute configuration fingerprint: %w", err)
+	}
+
+	return hashStr(string(data)), nil
+}
+
+// filterCompatibilitySchemaMap reduces the compatibility schemas to the resource
+// types of the naming schema map, as other names are never built. Resource types
+// with the same naming schema in both libraries are left out as well, their
+// names cannot differ.
+func filterCompatibilitySchemaMap(compatibility, namingSchemaMap s.NamingSchemaMap) s.NamingSchemaMap {
+	filtered := make(s.NamingSchemaMap, len(namingSchemaMap))
+	for k, typeSchema := range namingSchemaMap {
+		if v, ok := compatibility[k]; ok && !reflect.DeepEqual(v.ToJsonNamingSchema(), typeSchema.ToJsonNamingSchema()) {
+			filtered[k] = v
+		}
+	}
+	return filtered
+}
+
+// hashLengthConflicts returns the resource types, with their maximum length, for
+// which the abbreviation and a hash of hashLength alone exceed the maximum length.
+// The result is sorted by resource type.
+func hashLengthConflicts(namingSchemaMap s.NamingSchemaMap, hashLength int32, separator string) []string {
+	conflicts := make([]string, 0)
+	for _, resourceType := range slices.Sorted(maps.Keys(namingSchemaMap)) {
+		typeSchema := namingSchemaMap[resourceType]
+		typeSeparator := ""
+		if typeSchema.Configuration.UseSeparator.ValueBool() {
+			typeSeparator = separator
+			if v := typeSchema.Configuration.Separator.ValueString(); v != "" {
+				typeSeparator = v
+			}
+		}
+		maxLength := typeSchema.MaxLength.ValueInt64()
+		if maxLength > 0 && minHashedNameLength(&typeSchema, hashLength, typeSeparator) > maxLength {
+			conflicts = append(conflicts, fmt.Sprintf("%s (maximum %d)", resourceType, maxLength))
+		}
+	}
+	return conflicts
+}
+
+// filterNamingSchemaMap reduces the naming schema map to the requested resource types.
+// An unset filter keeps all resource types, include_schema = false drops all of them.
+func filterNamingSchemaMap(ctx context.Context, namingSchemaMap s.NamingSchemaMap, resourceTypes types.Set, includeSchema types.Bool) (s.NamingSchemaMap, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if !includeSchema.IsNull() && !includeSchema.ValueBool() {
+		return s.NamingSchemaMap{}, diags
+	}
+
+	if resourceTypes.IsNull() || resourceTypes.IsUnknown() {
+		return namingSchemaMap, diags
+	}
+
+	var resourceTypeNames []string
+	if diags.Append(resourceTypes.ElementsAs(ctx, &resourceTypeNames, false)...); diags.HasError() {
+		return nil, diags
+	}
+
+	filtered := make(s.NamingSchemaMap, len(resourceTypeNames))
+	for _, t := range resourceTypeNames {
+		namingSchema, ok := namingSchemaMap[t]
+		if !ok {
+			diags.AddAttributeError(
+				path.Root("resource_types"),
+				"Unknown resource type",
+				fmt.Sprintf("resource type '%s' not found in schema library", t),
+			)
+			continue
+		}
+		filtered[t] = namingSchema
+	}
+
+	return filtered, diags
+}
+
+// resolveEnvironment returns the environment of the data source, else the one
+// of the provider, else the one of provider_meta. The provider defaults its
+// environment to an empty string, so an empty provider environment counts as
+// unset.
+func resolveEnvironment(data, provider, providerMeta types.String) types.String {
+	if !data.IsNull() {
+		return data
+	}
+	if provider.ValueString() == "" && !providerMeta.IsNull() {
+		return providerMeta
+	}
+	return provider
+}
@@ -4,9 +4,15 @@
 package provider
 
 import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
 	//"github.com/hashicorp/terraform-plugin-testing/knownvalue"
 	//"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"regexp"
+	s "terraform-provider-standesamt/internal/schema"
 	"testing"
 )
 
@@ -49,6 +55,7 @@ func TestAccStandesamtNoAttributes(t *testing.T) {
 					resource.TestCheckResourceAttr("data.standesamt_config.test", "configuration.separator", "-"),
 					resource.TestCheckResourceAttr("data.standesamt_config.test", "configuration.random_seed", "1337"),
 					resource.TestCheckResourceAttr("data.standesamt_config.test", "configuration.hash_length", "0"),
+					resource.TestCheckResourceAttrSet("data.standesamt_config.test", "configuration_fingerprint"),
 					resource.TestCheckResourceAttr("data.standesamt_config.test", "configuration.lowercase", "false"),
 					resource.TestCheckResourceAttr("data.standesamt_config.test", "configuration.prefixes.#", "0"),
 					resource.TestCheckResourceAttr("data.standesamt_config.test", "configuration.suffixes.#", "0"),
@@ -91,6 +98,207 @@ func TestAccStandesamtFullAttributes(t *testing.T) {
 	})
 }
 
+func TestAccStandesamtResourceTypesFilter(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactoriesUnique(),
+		ExternalProviders:        map[string]resource.ExternalProvider{},
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "standesamt_config" "test" {
+	resource_types = ["azurerm_resource_group"]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.standesamt_config.test", "schema.%", "1"),
+					resource.TestCheckResourceAttr("data.standesamt_config.test", "schema.azurerm_resource_group.abbreviation", "rg"),
+				),
+			},
+			{
+				Config: `
+data "standesamt_config" "test" {
+	include_schema = false
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.standesamt_config.test", "schema.%", "0"),
+				),
+			},
+			{
+				Config: `
+data "standesamt_config" "test" {
+	resource_types = ["invalid_resource_type"]
+}
+`,
+				ExpectError: regexp.MustCompile(`resource type 'invalid_resource_type' not found in schema library`),
+			},
+		},
+	})
+}
+
+func TestAccStandesamtProviderMeta(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactoriesUnique(),
+		ExternalProviders:        map[string]resource.ExternalProvider{},
+		Steps: []resource.TestStep{
+			{
+				Config: `
+terraform {
+	provider_meta "standesamt" {
+		prefixes    = ["mod"]
+		environment = "dev"
+	}
+}
+
+data "standesamt_config" "test" {
+	suffixes = ["001"]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.standesamt_config.test", "configuration.prefixes.0", "mod"),
+					resource.TestCheckResourceAttr("data.standesamt_config.test", "configuration.suffixes.0", "001"),
+					resource.TestCheckResourceAttr("data.standesamt_config.test", "configuration.environment", "dev"),
+				),
+			},
+			{
+				Config: `
+terraform {
+	provider_meta "standesamt" {
+		prefixes = ["mod"]
+	}
+}
+
+data "standesamt_config" "test" {
+	prefixes = ["app"]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.standesamt_config.test", "configuration.prefixes.#", "1"),
+					resource.TestCheckResourceAttr("data.standesamt_config.test", "configuration.prefixes.0", "app"),
+				),
+			},
+		},
+	})
+}
+
+func TestResolveEnvironment(t *testing.T) {
+	// The provider default is an empty string, provider_meta fills it in.
+	assert.Equal(t, "dev", resolveEnvironment(types.StringNull(), types.StringValue(""), types.StringValue("dev")).ValueString())
+	assert.Equal(t, "prd", resolveEnvironment(types.StringNull(), types.StringValue("prd"), types.StringValue("dev")).ValueString())
+	assert.Equal(t, "tst", resolveEnvironment(types.StringValue("tst"), types.StringValue("prd"), types.StringValue("dev")).ValueString())
+	assert.Equal(t, "", resolveEnvironment(types.StringNull(), types.StringValue(""), types.StringNull()).ValueString())
+}
+
+func TestFilterNamingSchemaMap(t *testing.T) {
+	namingSchemaMap := s.NewNamingSchemaMap([]s.JsonNamingSchema{
+		{ResourceType: "azurerm_resource_group", Abbreviation: "rg"},
+		{ResourceType: "azurerm_storage_account", Abbreviation: "st"},
+	})
+	filter := types.SetValueMust(types.StringType, []attr.Value{types.StringValue("azurerm_storage_account")})
+
+	all, diags := filterNamingSchemaMap(context.Background(), namingSchemaMap, types.SetNull(types.StringType), types.BoolNull())
+	assert.False(t, diags.HasError())
+	assert.Len(t, all, 2)
+
+	filtered, diags := filterNamingSchemaMap(context.Background(), namingSchemaMap, filter, types.BoolValue(true))
+	assert.False(t, diags.HasError())
+	assert.Len(t, filtered, 1)
+	assert.Equal(t, "st", filtered["azurerm_storage_account"].Abbreviation.ValueString())
+
+	none, diags := filterNamingSchemaMap(context.Background(), namingSchemaMap, filter, types.BoolValue(false))
+	assert.False(t, diags.HasError())
+	assert.Empty(t, none)
+
+	unknown := types.SetValueMust(types.StringType, []attr.Value{types.StringValue("invalid_resource_type")})
+	_, diags = filterNamingSchemaMap(context.Background(), namingSchemaMap, unknown, types.BoolNull())
+	assert.True(t, diags.HasError())
+}
+
+func TestFilterCompatibilitySchemaMap(t *testing.T) {
+	namingSchemaMap := s.NewNamingSchemaMap([]s.JsonNamingSchema{
+		{ResourceType: "azurerm_resource_group", Abbreviation: "rg"},
+		{ResourceType: "azurerm_storage_account", Abbreviation: "st"},
+	})
+	compatibility := s.NewNamingSchemaMap([]s.JsonNamingSchema{
+		{ResourceType: "azurerm_resource_group", Abbreviation: "rg"},
+		{ResourceType: "azurerm_storage_account", Abbreviation: "sa"},
+		{ResourceType: "azurerm_key_vault", Abbreviation: "kv"},
+	})
+
+	// Only the changed resource types of the naming schema map are embedded.
+	filtered := filterCompatibilitySchemaMap(compatibility, namingSchemaMap)
+	assert.Len(t, filtered, 1)
+	assert.Equal(t, "sa", filtered["azurerm_storage_account"].Abbreviation.ValueString())
+}
+
+func TestHashLengthConflicts(t *testing.T) {
+	namingSchemaMap := s.NewNamingSchemaMap([]s.JsonNamingSchema{
+		{ResourceType: "azurerm_resource_group", Abbreviation: "rg", MaxLength: 90, Configuration: s.JsonConfigurationSchema{UseSeparator: true}},
+		{ResourceType: "azurerm_storage_account", Abbreviation: "st", MaxLength: 24},
+		{ResourceType: "azurerm_short", Abbreviation: "sh", MaxLength: 10, Configuration: s.JsonConfigurationSchema{UseSeparator: true, Separator: "--"}},
+		{ResourceType: "azurerm_no_hash", Abbreviation: "nh", MaxLength: 5, Configuration: s.JsonConfigurationSchema{NamePrecedence: []string{"abbreviation", "name"}}},
+	})
+
+	assert.Empty(t, hashLengthConflicts(namingSchemaMap, 0, "-"))
+	assert.Empty(t, hashLengthConflicts(namingSchemaMap, 6, "-"))
+	assert.Equal(t, []string{"azurerm_short (maximum 10)"}, hashLengthConflicts(namingSchemaMap, 7, "-"))
+	assert.Equal(t, []string{"azurerm_short (maximum 10)", "azurerm_storage_account (maximum 24)"}, hashLengthConflicts(namingSchemaMap, 23, "-"))
+}
+
+func TestConfigurationFingerprint(t *testing.T) {
+	namingSchemaMap := s.NewNamingSchemaMap([]s.JsonNamingSchema{
+		{ResourceType: "azurerm_resource_group", Abbreviation: "rg"},
+		{ResourceType: "azurerm_storage_account", Abbreviation: "st"},
+	})
+	configuration := configurationModel{
+		Convention: types.StringValue("default"),
+		Separator:  types.StringValue("-"),
+	}
+
+	first, err := configurationFingerprint(configuration, "azure/caf@2026.01", namingSchemaMap)
+	assert.NoError(t, err)
+	second, err := configurationFingerprint(configuration, "azure/caf@2026.01", namingSchemaMap)
+	assert.NoError(t, err)
+	assert.Equal(t, first, second)
+
+	configuration.Separator = types.StringValue("_")
+	separatorChanged, err := configurationFingerprint(configuration, "azure/caf@2026.01", namingSchemaMap)
+	assert.NoError(t, err)
+	assert.NotEqual(t, first, separatorChanged)
+
+	refChanged, err := configurationFingerprint(configuration, "azure/caf@2026.02", namingSchemaMap)
+	assert.NoError(t, err)
+	assert.NotEqual(t, separatorChanged, refChanged)
+}
+
+func TestLibraryValue(t *testing.T) {
+	assert.True(t, libraryValue(nil).IsNull())
+
+	library := libraryValue(&s.LibraryManifest{Name: "acme-naming", Version: "1.4.0", Authors: []string{"Platform Team"}})
+	assert.Equal(t, types.StringValue("1.4.0"), library.Attributes()["version"])
+	assert.Equal(t, types.StringValue(""), library.Attributes()["changelog"])
+	assert.Len(t, library.Attributes()["authors"].(types.List).Elements(), 1)
+}
+
+func TestAccStandesamtLibraryManifest(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactoriesWithLibrary(testLibrary),
+		Steps: []resource.TestStep{
+			{
+				Config: `data "standesamt_config" "test" {}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.standesamt_config.test", "library.name", "test"),
+					resource.TestCheckResourceAttr("data.standesamt_config.test", "library.version", "1.0.0"),
+					resource.TestCheckResourceAttr("data.standesamt_config.test", "library.authors.0", "glueckkanja"),
+				),
+			},
+		},
+	})
+}
+
 func testAccConfigurationDataSourceConfigNoAttributes() string {
 	return `
 data "standesamt_config" "test" {}
@@ -0,0 +1,330 @@
+// Copyright glueckkanja AG 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	s "terraform-provider-standesamt/internal/schema"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// configExportVersion is the version of the document rendered by config_export and
+// consumed by the config_json argument of the standesamt_config data source.
+const configExportVersion = 1
+
+// configExportDocument is the canonical JSON representation of a configurations
+// object. Schema entries use the schema library format.
+type configExportDocument struct {
+	Version       int                           `json:"version"`
+	Configuration configExportConfiguration     `json:"configuration"`
+	Locations     map[string]string             `json:"locations"`
+	Schema        map[string]s.JsonNamingSchema `json:"schema"`
+}
+
+// configExportConfiguration mirrors configurationModel. Absent values are nil so an
+// imported document only overrides the settings it contains.
+type configExportConfiguration struct {
+	Convention   *string  `json:"convention,omitempty"`
+	Environment  *string  `json:"environment,omitempty"`
+	Separator    *string  `json:"separator,omitempty"`
+	RandomSeed   *int64   `json:"random_seed,omitempty"`
+	HashLength   *int32   `json:"hash_length,omitempty"`
+	Lowercase    *bool    `json:"lowercase,omitempty"`
+	Uppercase    *bool    `json:"uppercase,omitempty"`
+	Strict       *bool    `json:"strict,omitempty"`
+	Prefixes     []string `json:"prefixes"`
+	Suffixes     []string `json:"suffixes"`
+	DenyPatterns []string `json:"deny_patterns"`
+	Location     *string  `json:"location,omitempty"`
+
+	RequiredSegments    []string `json:"required_segments,omitempty"`
+	RequiredPrefixRegex *string  `json:"required_prefix_regex,omitempty"`
+	MinGlobalHashLength *int32   `json:"min_global_hash_length,omitempty"`
+
+	Affixes             s.AffixesMapSchema `json:"affixes,omitempty"`
+	Environments        map[string]string  `json:"environments,omitempty"`
+	AllowedEnvironments []string           `json:"allowed_environments,omitempty"`
+	AllowedPrefixes     []string           `json:"allowed_prefixes,omitempty"`
+
+	Compatibility     map[string]s.JsonNamingSchema `json:"compatibility,omitempty"`
+	CompatibilityMode *string                       `json:"compatibility_mode,omitempty"`
+
+	Workspace *string `json:"workspace,omitempty"`
+	Stack     *string `json:"stack,omitempty"`
+}
+
+var _ function.Function = &ConfigExportFunction{}
+
+type ConfigExportFunction struct{}
+
+func NewConfigExportFunction() function.Function {
+	return &ConfigExportFunction{}
+}
+
+func (f *ConfigExportFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "config_export"
+}
+
+func (f *ConfigExportFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Render the naming configuration as canonical JSON",
+		Description: "Render the resolved configuration, locations and schema of a configurations object as canonical JSON that can be shared with other tools.",
+		MarkdownDescription: "Render the resolved configuration, locations and schema of a configurations object as canonical JSON " +
+			"that can be shared with scripts and other IaC tools. Object keys are sorted and schema entries use the schema " +
+			"library format. The document can be read back with the `config_json` argument of the `standesamt_config` data source.",
+		Parameters: []function.Parameter{
+			configurationsParameter(),
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *ConfigExportFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var configurationsDynamic types.Dynamic
+
+	if resp.Error = req.Arguments.Get(ctx, &configurationsDynamic); resp.Error != nil {
+		return
+	}
+
+	configurations, err := configurationsArgument(ctx, configurationsDynamic)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	if !isWhollyKnown(ctx, configurations) {
+		// The result is left unknown until the configuration is known.
+		return
+	}
+
+	model := configurationsModel{}
+	diags := configurations.As(ctx, &model, basetypes.ObjectAsOptions{})
+	if resp.Error = function.FuncErrorFromDiags(ctx, diags); resp.Error != nil {
+		return
+	}
+
+	document, err := newConfigExportDocument(ctx, &model)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	rendered, err := json.Marshal(document)
+	if err != nil {
+		resp.Error = function.NewFuncError(fmt.Sprintf("failed to render configuration: %s", err.Error()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, string(rendered)))
+}
+
+// newConfigExportDocument converts a configurations object into its export document.
+func newConfigExportDocument(ctx context.Context, model *configurationsModel) (*configExportDocument, error) {
+	document := &configExportDocument{
+		Version:       configExportVersion,
+		Configuration: newConfigExportConfiguration(model.Configuration),
+		Locations:     make(map[string]string, len(model.Locations)),
+		Schema:        make(map[string]s.JsonNamingSchema, len(model.Schema)),
+	}
+
+	for k, v := range model.Locations {
+		document.Locations[k] = v.ValueString()
+	}
+
+	for k, o := range model.Schema {
+		var typeSchema s.NamingSchema
+		if diags := o.As(ctx, &typeSchema, basetypes.ObjectAsOptions{}); diags.HasError() {
+			return nil, fmt.Errorf("failed to parse schema for type '%s'", k)
+		}
+		document.Schema[k] = typeSchema.ToJsonNamingSchema()
+	}
+
+	return document, nil
+}
+
+// newConfigExportConfiguration converts a configuration into its export representation.
+func newConfigExportConfiguration(c configurationModel) configExportConfiguration {
+	return configExportConfiguration{
+		Convention:   c.Convention.ValueStringPointer(),
+		Environment:  c.Environment.ValueStringPointer(),
+		Separator:    c.Separator.ValueStringPointer(),
+		RandomSeed:   c.RandomSeed.ValueInt64Pointer(),
+		HashLength:   c.HashLength.ValueInt32Pointer(),
+		Lowercase:    c.Lowercase.ValueBoolPointer(),
+		Uppercase:    c.Uppercase.ValueBoolPointer(),
+		Strict:       c.Strict.ValueBoolPointer(),
+		Prefixes:     append([]string{}, extractStringSlice(c.Prefixes)...),
+		Suffixes:     append([]string{}, extractStringSlice(c.Suffixes)...),
+		DenyPatterns: append([]string{}, extractStringSlice(c.DenyPatterns)...),
+		Location:     c.Location.ValueStringPointer(),
+
+		RequiredSegments:    extractStringSlice(c.RequiredSegments),
+		RequiredPrefixRegex: c.RequiredPrefixRegex.ValueStringPointer(),
+		MinGlobalHashLength: c.MinGlobalHashLength.ValueInt32Pointer(),
+
+		Affixes:             affixesFromMap(c.Affixes),
+		Environments:        extractStringMap(c.Environments),
+		AllowedEnvironments: extractStringSlice(c.AllowedEnvironments),
+		AllowedPrefixes:     extractStringSlice(c.AllowedPrefixes),
+
+		Compatibility:     compatibilityFromMap(c.Compatibility),
+		CompatibilityMode: c.CompatibilityMode.ValueStringPointer(),
+
+		Workspace: c.Workspace.ValueStringPointer(),
+		Stack:     c.Stack.ValueStringPointer(),
+	}
+}
+
+// parseConfigExportDocument reads a document rendered by config_export.
+func parseConfigExportDocument(data string) (*configExportDocument, error) {
+	var document configExportDocument
+	if err := json.Unmarshal([]byte(data), &document); err != nil {
+		return nil, fmt.Errorf("failed to parse configuration document: %w", err)
+	}
+
+	if document.Version != configExportVersion {
+		return nil, fmt.Errorf("unsupported configuration document version %d, expected %d", document.Version, configExportVersion)
+	}
+
+	for k, namingSchema := range document.Schema {
+		if err := s.ValidateNamePrecedence(namingSchema.Configuration.NamePrecedence); err != nil {
+			return nil, fmt.Errorf("resource type '%s': %w", k, err)
+		}
+	}
+
+	return &document, nil
+}
+
+// namingSchemaMap returns the schema of the document as naming schema map.
+func (d *configExportDocument) namingSchemaMap() s.NamingSchemaMap {
+	keys := make([]string, 0, len(d.Schema))
+	for k := range d.Schema {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	schemas := make([]s.JsonNamingSchema, 0, len(keys))
+	for _, k := range keys {
+		namingSchema := d.Schema[k]
+		if namingSchema.ResourceType == "" {
+			namingSchema.ResourceType = k
+		}
+		schemas = append(schemas, namingSchema)
+	}
+
+	return s.NewNamingSchemaMap(schemas)
+}
+
+// applyTo returns settings with every value that is set in the document replaced.
+func (c configExportConfiguration) applyTo(settings providerData) providerData {
+	if c.Convention != nil {
+		settings.Convention = types.StringPointerValue(c.Convention)
+	}
+	if c.Environment != nil {
+		settings.Environment = types.StringPointerValue(c.Environment)
+	}
+	if c.Separator != nil {
+		settings.Separator = types.StringPointerValue(c.Separator)
+	}
+	if c.RandomSeed != nil {
+		settings.RandomSeed = types.Int64PointerValue(c.RandomSeed)
+	}
+	if c.HashLength != nil {
+		settings.HashLength = types.Int32PointerValue(c.HashLength)
+	}
+	if c.Lowercase != nil {
+		settings.Lowercase = types.BoolPointerValue(c.Lowercase)
+	}
+	if c.Uppercase != nil {
+		settings.Uppercase = types.BoolPointerValue(c.Uppercase)
+	}
+	if c.Strict != nil {
+		settings.Strict = types.BoolPointerValue(c.Strict)
+	}
+	if c.DenyPatterns != nil {
+		settings.DenyPatterns = stringSliceToList(c.DenyPatterns)
+	}
+	if c.RequiredSegments != nil {
+		settings.RequiredSegments = stringSliceToList(c.RequiredSegments)
+	}
+	if c.RequiredPrefixRegex != nil {
+		settings.RequiredPrefixRegex = types.StringPointerValue(c.RequiredPrefixRegex)
+	}
+	if c.MinGlobalHashLength != nil {
+		settings.MinGlobalHashLength = types.Int32PointerValue(c.MinGlobalHashLength)
+	}
+	if c.CompatibilityMode != nil {
+		settings.CompatibilityMode = types.StringPointerValue(c.CompatibilityMode)
+	}
+	if c.Workspace != nil {
+		settings.Workspace = types.StringPointerValue(c.Workspace)
+	}
+	if c.Stack != nil {
+		settings.Stack = types.StringPointerValue(c.Stack)
+	}
+	return settings
+}
+
+// compatibilityFromMap converts the compatibility schemas of a configuration into
+// their JSON representation. It returns nil for a null or empty map.
+func compatibilityFromMap(value types.Map) map[string]s.JsonNamingSchema {
+	if len(value.Elements()) == 0 {
+		return nil
+	}
+
+	schemas := make(map[string]s.JsonNamingSchema, len(value.Elements()))
+	for k, v := range value.Elements() {
+		obj, ok := v.(types.Object)
+		if !ok {
+			continue
+		}
+		var namingSchema s.NamingSchema
+		if diags := obj.As(context.Background(), &namingSchema, basetypes.ObjectAsOptions{}); diags.HasError() {
+			continue
+		}
+		schemas[k] = namingSchema.ToJsonNamingSchema()
+	}
+	return schemas
+}
+
+// environmentsValues converts the environment catalog of the schema library into
+// the environments map and the allowed_environments list of a configuration.
+func environmentsValues(environments s.EnvironmentsSchema) (types.Map, types.List) {
+	elements := make(map[string]attr.Value, len(environments.Environments))
+	for k, v := range environments.Environments {
+		elements[k] = types.StringValue(v)
+	}
+	return types.MapValueMust(types.StringType, elements), stringSliceToList(environments.Allowed)
+}
+
+// extractStringMap converts a map of strings into a Go map. It returns nil for a
+// null or empty map.
+func extractStringMap(value types.Map) map[string]string {
+	if len(value.Elements()) == 0 {
+		return nil
+	}
+
+	result := make(map[string]string, len(value.Elements()))
+	for k, v := range value.Elements() {
+		if str, ok := v.(types.String); ok && !str.IsNull() && !str.IsUnknown() {
+			result[k] = str.ValueString()
+		}
+	}
+	return result
+}
+
+func stringSliceToList(values []string) types.List {
+	elements := make([]attr.Value, 0, len(values))
+	for _, v := range values {
+		elements = append(elements, types.StringValue(v))
+	}
+	return types.ListValueMust(types.StringType, elements)
+}
@@ -0,0 +1,152 @@
+// Copyright glueckkanja AG 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"testing"
+
+	s "terraform-provider-standesamt/internal/schema"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConfigExportFunction_Render(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactoriesUnique(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf("%s %s", default_config_with_no_settings_default_precedence, `output "test" {
+					value = jsondecode(provider::standesamt::config_export(local.config)).schema.azurerm_resource_group.abbreviation
+				}`),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("test", knownvalue.StringExact("rg")),
+				},
+			},
+		},
+	})
+}
+
+func TestConfigExportDocument_RoundTrip(t *testing.T) {
+	ctx := context.Background()
+	namingSchemaMap := s.NewNamingSchemaMap([]s.JsonNamingSchema{
+		{
+			ResourceType:    "azurerm_resource_group",
+			Abbreviation:    "rg",
+			MinLength:       1,
+			MaxLength:       90,
+			ValidationRegex: "^[a-z-]+$",
+			Configuration: s.JsonConfigurationSchema{
+				UseEnvironment: true,
+				UseSeparator:   true,
+				DenyPatterns:   []string{"^[0-9]"},
+			},
+		},
+	})
+	schemaObj, diags := types.ObjectValueFrom(ctx, s.SchemaTypeAttributes(), namingSchemaMap["azurerm_resource_group"])
+	require.False(t, diags.HasError())
+
+	model := &configurationsModel{
+		Configuration: configurationModel{
+			Convention:   types.StringValue("default"),
+			Environment:  types.StringValue("tst"),
+			Separator:    types.StringValue("-"),
+			RandomSeed:   types.Int64Value(1337),
+			HashLength:   types.Int32Value(4),
+			Lowercase:    types.BoolValue(true),
+			Uppercase:    types.BoolValue(false),
+			Strict:       types.BoolValue(true),
+			Prefixes:     types.ListValueMust(types.StringType, []attr.Value{types.StringValue("app")}),
+			Suffixes:     types.ListValueMust(types.StringType, []attr.Value{}),
+			DenyPatterns: types.ListValueMust(types.StringType, []attr.Value{}),
+			Location:     types.StringNull(),
+		},
+		Locations: map[string]types.String{"westeurope": types.StringValue("we")},
+		Schema:    map[string]types.Object{"azurerm_resource_group": schemaObj},
+	}
+
+	document, err := newConfigExportDocument(ctx, model)
+	require.NoError(t, err)
+
+	rendered, err := json.Marshal(document)
+	require.NoError(t, err)
+	assert.Contains(t, string(rendered), `"configuration":{"convention":"default","environment":"tst","separator":"-","random_seed":1337,"hash_length":4,"lowercase":true,"uppercase":false,"strict":true,"prefixes":["app"],"suffixes":[],"deny_patterns":[]}`)
+	assert.Contains(t, string(rendered), `"locations":{"westeurope":"we"}`)
+
+	parsed, err := parseConfigExportDocument(string(rendered))
+	require.NoError(t, err)
+	assert.Equal(t, namingSchemaMap, parsed.namingSchemaMap())
+
+	settings := parsed.Configuration.applyTo(providerData{Environment: types.StringValue("prd")})
+	assert.Equal(t, types.StringValue("tst"), settings.Environment)
+	assert.Equal(t, types.Int32Value(4), settings.HashLength)
+}
+
+func TestParseConfigExportDocument_Errors(t *testing.T) {
+	_, err := parseConfigExportDocument(`{"version": 2}`)
+	assert.ErrorContains(t, err, "unsupported configuration document version 2")
+
+	_, err = parseConfigExportDocument(`not json`)
+	assert.ErrorContains(t, err, "failed to parse configuration document")
+}
+
+func TestAccStandesamtConfigJson(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactoriesUnique(),
+		Steps: []resource.TestStep{
+			{
+				Config: `data "standesamt_config" "test" {
+					config_json = jsonencode({
+						version = 1
+						configuration = {
+							environment = "tst"
+							separator   = "_"
+							prefixes    = ["app"]
+						}
+						schema = {
+							custom_type = {
+								abbreviation    = "ct"
+								minLength       = 1
+								maxLength       = 10
+								validationRegex = "^[a-z_]+$"
+								configuration   = { useSeparator = true }
+							}
+						}
+					})
+					separator = "-"
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.standesamt_config.test", "configuration.environment", "tst"),
+					resource.TestCheckResourceAttr("data.standesamt_config.test", "configuration.separator", "-"),
+					resource.TestCheckResourceAttr("data.standesamt_config.test", "configuration.prefixes.0", "app"),
+					resource.TestCheckResourceAttr("data.standesamt_config.test", "schema.%", "1"),
+					resource.TestCheckResourceAttr("data.standesamt_config.test", "schema.custom_type.abbreviation", "ct"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccStandesamtConfigJson_Invalid(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactoriesUnique(),
+		Steps: []resource.TestStep{
+			{
+				Config: `data "standesamt_config" "test" {
+					config_json = "{}"
+				}`,
+				ExpectError: regexp.MustCompile(`unsupported configuration document version 0`),
+			},
+		},
+	})
+}
@@ -0,0 +1,445 @@
+// Copyright glueckkanja AG 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"slices"
+	s "terraform-provider-standesamt/internal/schema"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                   = &ConventionResource{}
+	_ resource.ResourceWithConfigure      = &ConventionResource{}
+	_ resource.ResourceWithValidateConfig = &ConventionResource{}
+	_ resource.ResourceWithModifyPlan     = &ConventionResource{}
+)
+
+// conventionSchemaReference is used in place of the schema reference for the id
+// of the convention, which depends on the library through the schema map only.
+const conventionSchemaReference = "standesamt_convention"
+
+type conventionResourceModel struct {
+	Id                  types.String `tfsdk:"id"`
+	NamePrecedence      types.List   `tfsdk:"name_precedence"`
+	Separator           types.String `tfsdk:"separator"`
+	Lowercase           types.Bool   `tfsdk:"lowercase"`
+	Uppercase           types.Bool   `tfsdk:"uppercase"`
+	AllowedEnvironments types.Set    `tfsdk:"allowed_environments"`
+	AllowedPrefixes     types.Set    `tfsdk:"allowed_prefixes"`
+	Environment         types.String `tfsdk:"environment"`
+	Prefixes            types.List   `tfsdk:"prefixes"`
+	Suffixes            types.List   `tfsdk:"suffixes"`
+	Location            types.String `tfsdk:"location"`
+	ResourceTypes       types.Set    `tfsdk:"resource_types"`
+	Configuration       types.Object `tfsdk:"configuration"`
+	Locations           types.Map    `tfsdk:"locations"`
+	Schema              types.Map    `tfsdk:"schema"`
+}
+
+func NewConventionResource() resource.Resource {
+	return &ConventionResource{}
+}
+
+// ConventionResource defines the resource implementation.
+type ConventionResource struct {
+	providerConfig *ProviderConfig
+}
+
+func (r *ConventionResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_convention"
+}
+
+func (r *ConventionResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Resource to declare the naming convention of an organization in one place.",
+		MarkdownDescription: "Resource to declare the naming convention of an organization in one place, e.g. the name precedence, separator, casing and the allowed environments and prefixes. The convention is validated at plan time and exposes a normalized configuration that can be passed to the naming functions like the `standesamt_config` data source.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				Description:         "A stable hash of the resolved configuration and naming schemas of the convention.",
+				MarkdownDescription: "A stable hash of the resolved `configuration` and naming schemas of the convention.",
+			},
+			"name_precedence": schema.ListAttribute{
+				Optional:            true,
+				Description:         "The order of the name segments for all resource types, e.g. ['abbreviation', 'name', 'environment']. Replaces the name precedence of the schema library.",
+				MarkdownDescription: "The order of the name segments for all resource types, e.g. `[\"abbreviation\", \"name\", \"environment\"]`. Replaces the name precedence of the schema library.",
+				ElementType:         types.StringType,
+			},
+			"separator": schema.StringAttribute{
+				Optional:            true,
+				Description:         "The separator of the convention. Replaces the separator of the provider settings and of the schema library for all resource types that use a separator.",
+				MarkdownDescription: "The separator of the convention. Replaces the separator of the provider settings and of the schema library for all resource types that use a separator.",
+			},
+			"lowercase": schema.BoolAttribute{
+				Optional:            true,
+				Description:         "Control if names of the convention are lower case. Conflicts with uppercase.",
+				MarkdownDescription: "Control if names of the convention are lower case. Conflicts with `uppercase`.",
+			},
+			"uppercase": schema.BoolAttribute{
+				Optional:            true,
+				Description:         "Control if names of the convention are upper case. Conflicts with lowercase.",
+				MarkdownDescription: "Control if names of the convention are upper case. Conflicts with `lowercase`.",
+			},
+			"allowed_environments": schema.SetAttribute{
+				Optional:            true,
+				Description:         "The environments allowed by the convention, e.g. ['dev', 'tst', 'prd']. Narrows the allowed environments of the schema library; names built from the configuration must use one of them. Default: all environments.",
+				MarkdownDescription: "The environments allowed by the convention, e.g. `[\"dev\", \"tst\", \"prd\"]`. Narrows the allowed environments of the schema library; names built from the `configuration` must use one of them. Default: all environments.",
+				ElementType:         types.StringType,
+			},
+			"allowed_prefixes": schema.SetAttribute{
+				Optional:            true,
+				Description:         "The prefixes allowed by the convention. Names built from the configuration must only use these prefixes. Default: all prefixes.",
+				MarkdownDescription: "The prefixes allowed by the convention. Names built from the `configuration` must only use these prefixes. Default: all prefixes.",
+				ElementType:         types.StringType,
+			},
+			"environment": schema.StringAttribute{
+				Optional:            true,
+				Description:         "The environment of the configuration. Must be one of allowed_environments. Will override the environment defined in the provider settings.",
+				MarkdownDescription: "The environment of the `configuration`. Must be one of `allowed_environments`. Will override the environment defined in the provider settings.",
+			},
+			"prefixes": schema.ListAttribute{
+				Optional:            true,
+				Description:         "The prefixes of the configuration. Each prefix must be one of allowed_prefixes. Default '[]'",
+				MarkdownDescription: "The prefixes of the `configuration`. Each prefix must be one of `allowed_prefixes`. Default '[]'",
+				ElementType:         types.StringType,
+			},
+			"suffixes": schema.ListAttribute{
+				Optional:            true,
+				Description:         "The suffixes of the configuration. Default '[]'",
+				MarkdownDescription: "The suffixes of the `configuration`. Default '[]'",
+				ElementType:         types.StringType,
+			},
+			"location": schema.StringAttribute{
+				Optional:            true,
+				Description:         "A location string used to lookup in the locations schema, e.g. 'westeurope'.",
+				MarkdownDescription: "A location string used to lookup in the locations schema, e.g. `westeurope`.",
+			},
+			"resource_types": schema.SetAttribute{
+				Optional:            true,
+				Description:         "Limit the schema map to the given resource types. Default: all resource types of the schema library.",
+				MarkdownDescription: "Limit the `schema` map to the given resource types. Default: all resource types of the schema library.",
+				ElementType:         types.StringType,
+			},
+			"configuration": schema.ObjectAttribute{
+				Computed:            true,
+				Description:         "The normalized configuration of the convention. This is used to pass the configuration to the naming function.",
+				MarkdownDescription: "The normalized configuration of the convention. This is used to pass the configuration to the naming function.",
+				AttributeTypes:      configurationTypeAttributes(),
+			},
+			"locations": schema.MapAttribute{
+				Computed:            true,
+				Description:         "The locations of the schema library.",
+				MarkdownDescription: "The locations of the schema library.",
+				ElementType:         types.StringType,
+			},
+			"schema": schema.MapAttribute{
+				Computed:            true,
+				Description:         "The naming schemas of the schema library with the name precedence and separator of the convention applied.",
+				MarkdownDescription: "The naming schemas of the schema library with the `name_precedence` and `separator` of the convention applied.",
+				ElementType: types.ObjectType{
+					AttrTypes: s.SchemaTypeAttributes(),
+				},
+			},
+		},
+	}
+}
+
+func (r *ConventionResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*ProviderConfig)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ProviderConfig, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.providerConfig = data
+}
+
+func (r *ConventionResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data conventionResourceModel
+
+	if resp.Diagnostics.Append(req.Config.Get(ctx, &data)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(data.validate(ctx)...)
+}
+
+// validate checks the settings of the convention against the declared rules.
+// Unknown values are skipped, they are validated once they are known.
+func (m conventionResourceModel) validate(ctx context.Context) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if !m.NamePrecedence.IsUnknown() {
+		tokens := make([]string, 0, len(m.NamePrecedence.Elements()))
+		for _, token := range extractStringSlice(m.NamePrecedence) {
+			if !slices.Contains(s.NamePrecedenceTokens, token) {
+				diags.AddAttributeError(path.Root("name_precedence"), "Invalid name precedence",
+					fmt.Sprintf("unknown name precedence token '%s', expected one of: %v", token, s.NamePrecedenceTokens))
+			} else if slices.Contains(tokens, token) {
+				diags.AddAttributeError(path.Root("name_precedence"), "Invalid name precedence",
+					fmt.Sprintf("name precedence token '%s' is listed more than once", token))
+			}
+			tokens = append(tokens, token)
+		}
+	}
+
+	if m.Lowercase.ValueBool() && m.Uppercase.ValueBool() {
+		diags.AddAttributeError(path.Root("uppercase"), "Invalid casing",
+			"lowercase and uppercase cannot both be true")
+	}
+
+	if !m.AllowedEnvironments.IsNull() && !m.AllowedEnvironments.IsUnknown() && !m.Environment.IsNull() && !m.Environment.IsUnknown() {
+		var allowed []string
+		if d := m.AllowedEnvironments.ElementsAs(ctx, &allowed, false); d.HasError() {
+			return append(diags, d...)
+		}
+		if !slices.Contains(allowed, m.Environment.ValueString()) {
+			diags.AddAttributeError(path.Root("environment"), "Environment not allowed by convention",
+				fmt.Sprintf("environment '%s' is not one of the allowed environments: %v", m.Environment.ValueString(), allowed))
+		}
+	}
+
+	if !m.AllowedPrefixes.IsNull() && !m.AllowedPrefixes.IsUnknown() && !m.Prefixes.IsUnknown() {
+		var allowed []string
+		if d := m.AllowedPrefixes.ElementsAs(ctx, &allowed, false); d.HasError() {
+			return append(diags, d...)
+		}
+		for _, prefix := range extractStringSlice(m.Prefixes) {
+			if !slices.Contains(allowed, prefix) {
+				diags.AddAttributeError(path.Root("prefixes"), "Prefix not allowed by convention",
+					fmt.Sprintf("prefix '%s' is not one of the allowed prefixes: %v", prefix, allowed))
+			}
+		}
+	}
+
+	return diags
+}
+
+// ModifyPlan resolves the computed attributes at plan time so names built from the
+// convention are known in the plan.
+func (r *ConventionResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to resolve on destroy or before the provider is configured.
+	if req.Plan.Raw.IsNull() || r.providerConfig == nil {
+		return
+	}
+
+	var data conventionResourceModel
+	if resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.inputsKnown(ctx) {
+		return
+	}
+
+	if resp.Diagnostics.Append(data.resolve(ctx, r.providerConfig)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &data)...)
+}
+
+func (r *ConventionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data conventionResourceModel
+
+	if resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	if resp.Diagnostics.Append(data.resolve(ctx, r.providerConfig)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ConventionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data conventionResourceModel
+
+	if resp.Diagnostics.Append(req.State.Get(ctx, &data)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The convention only lives in the state. Changes of the schema library are
+	// detected by ModifyPlan.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ConventionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data conventionResourceModel
+
+	if resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	if resp.Diagnostics.Append(data.resolve(ctx, r.providerConfig)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ConventionResource) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+	// The convention only lives in the state, nothing to delete.
+}
+
+// inputsKnown reports whether all arguments of the convention are known.
+func (m conventionResourceModel) inputsKnown(ctx context.Context) bool {
+	for _, v := range []attr.Value{
+		m.NamePrecedence, m.Separator, m.Lowercase, m.Uppercase, m.AllowedEnvironments,
+		m.AllowedPrefixes, m.Environment, m.Prefixes, m.Suffixes, m.Location, m.ResourceTypes,
+	} {
+		if !isWhollyKnown(ctx, v) {
+			return false
+		}
+	}
+	return true
+}
+
+// setToStrings returns the sorted elements of a set of strings.
+func setToStrings(set types.Set) []string {
+	values := make([]string, 0, len(set.Elements()))
+	for _, e := range set.Elements() {
+		if v, ok := e.(types.String); ok {
+			values = append(values, v.ValueString())
+		}
+	}
+	slices.Sort(values)
+	return values
+}
+
+// narrowAllowed returns the values of the convention that the schema library
+// allows as well. An empty library list allows all values.
+func narrowAllowed(library, convention []string) []string {
+	if len(library) == 0 {
+		return convention
+	}
+	allowed := make([]string, 0, len(convention))
+	for _, v := range convention {
+		if slices.Contains(library, v) {
+			allowed = append(allowed, v)
+		}
+	}
+	return allowed
+}
+
+// resolve sets the computed attributes from the provider settings, the schema
+// library and the arguments of the convention. The allowed environments and
+// prefixes are part of the configuration, so the naming functions reject names
+// that deviate from the convention.
+func (m *conventionResourceModel) resolve(ctx context.Context, config *ProviderConfig) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	namingSchemaMap, err := config.NamingSchemaMap()
+	if err != nil {
+		diags.AddError("source_reference", err.Error())
+		return diags
+	}
+	result, err := config.Result()
+	if err != nil {
+		diags.AddError("source_reference", err.Error())
+		return diags
+	}
+
+	configuration := config.ProviderData.configuration()
+	configuration.Affixes = affixesMapValue(result.Affixes)
+	configuration.Environments, configuration.AllowedEnvironments = environmentsValues(result.Environments)
+	configuration.AllowedPrefixes = types.ListValueMust(types.StringType, []attr.Value{})
+	if libraryEnvironments := extractStringSlice(configuration.AllowedEnvironments); len(m.AllowedEnvironments.Elements()) > 0 {
+		allowed := narrowAllowed(libraryEnvironments, setToStrings(m.AllowedEnvironments))
+		if len(allowed) == 0 {
+			diags.AddAttributeError(path.Root("allowed_environments"), "Environments not allowed by schema library",
+				fmt.Sprintf("none of the allowed_environments is one of the allowed environments of the schema library: %v", libraryEnvironments))
+			return diags
+		}
+		configuration.AllowedEnvironments = stringSliceToList(allowed)
+	}
+	if !m.AllowedPrefixes.IsNull() {
+		configuration.AllowedPrefixes = stringSliceToList(setToStrings(m.AllowedPrefixes))
+	}
+	if !m.Separator.IsNull() {
+		configuration.Separator = m.Separator
+	}
+	if !m.Lowercase.IsNull() {
+		configuration.Lowercase = m.Lowercase
+	}
+	if !m.Uppercase.IsNull() {
+		configuration.Uppercase = m.Uppercase
+	}
+	if !m.Environment.IsNull() {
+		configuration.Environment = m.Environment
+	}
+	if !m.Prefixes.IsNull() {
+		configuration.Prefixes = m.Prefixes
+	}
+	if !m.Suffixes.IsNull() {
+		configuration.Suffixes = m.Suffixes
+	}
+	if !m.Location.IsNull() {
+		configuration.Location = m.Location
+	}
+
+	namingSchemaMap, d := filterNamingSchemaMap(ctx, namingSchemaMap, m.ResourceTypes, types.BoolNull())
+	if diags.Append(d...); diags.HasError() {
+		return diags
+	}
+
+	conventionSchemaMap := make(s.NamingSchemaMap, len(namingSchemaMap))
+	for k, namingSchema := range namingSchemaMap {
+		if !m.NamePrecedence.IsNull() && len(m.NamePrecedence.Elements()) > 0 {
+			namingSchema.Configuration.NamePrecedence = m.NamePrecedence
+		}
+		if !m.Separator.IsNull() {
+			namingSchema.Configuration.Separator = m.Separator
+		}
+		conventionSchemaMap[k] = namingSchema
+	}
+
+	locations := make(map[string]attr.Value, len(result.Locations))
+	for k, v := range result.Locations {
+		locations[k] = types.StringValue(v)
+	}
+
+	id, err := configurationFingerprint(configuration, conventionSchemaReference, conventionSchemaMap)
+	if err != nil {
+		diags.AddError("id", err.Error())
+		return diags
+	}
+
+	var configurationObj types.Object
+	configurationObj, d = types.ObjectValueFrom(ctx, configurationTypeAttributes(), configuration)
+	if diags.Append(d...); diags.HasError() {
+		return diags
+	}
+
+	var schemaMap types.Map
+	schemaMap, d = types.MapValueFrom(ctx, types.ObjectType{AttrTypes: s.SchemaTypeAttributes()}, conventionSchemaMap)
+	if diags.Append(d...); diags.HasError() {
+		return diags
+	}
+
+	m.Id = types.StringValue(id)
+	m.Configuration = configurationObj
+	m.Locations = types.MapValueMust(types.StringType, locations)
+	m.Schema = schemaMap
+
+	return diags
+}
@@ -0,0 +1,171 @@
+// Copyright glueckkanja AG 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"regexp"
+	s "terraform-provider-standesamt/internal/schema"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func testConventionModel() conventionResourceModel {
+	return conventionResourceModel{
+		NamePrecedence:      types.ListNull(types.StringType),
+		Separator:           types.StringNull(),
+		Lowercase:           types.BoolNull(),
+		Uppercase:           types.BoolNull(),
+		AllowedEnvironments: types.SetNull(types.StringType),
+		AllowedPrefixes:     types.SetNull(types.StringType),
+		Environment:         types.StringNull(),
+		Prefixes:            types.ListNull(types.StringType),
+		Suffixes:            types.ListNull(types.StringType),
+		Location:            types.StringNull(),
+		ResourceTypes:       types.SetNull(types.StringType),
+	}
+}
+
+func TestConventionValidate(t *testing.T) {
+	m := testConventionModel()
+	m.AllowedEnvironments = types.SetValueMust(types.StringType, []attr.Value{types.StringValue("dev"), types.StringValue("prd")})
+	m.AllowedPrefixes = types.SetValueMust(types.StringType, []attr.Value{types.StringValue("app")})
+	m.Environment = types.StringValue("prd")
+	m.Prefixes = types.ListValueMust(types.StringType, []attr.Value{types.StringValue("app")})
+	m.NamePrecedence = types.ListValueMust(types.StringType, []attr.Value{types.StringValue("abbreviation"), types.StringValue("name")})
+	assert.False(t, m.validate(t.Context()).HasError())
+
+	m.Environment = types.StringValue("tst")
+	m.Prefixes = types.ListValueMust(types.StringType, []attr.Value{types.StringValue("app"), types.StringValue("ops")})
+	m.NamePrecedence = types.ListValueMust(types.StringType, []attr.Value{types.StringValue("region")})
+	m.Lowercase = types.BoolValue(true)
+	m.Uppercase = types.BoolValue(true)
+	diags := m.validate(t.Context())
+	assert.Equal(t, 4, diags.ErrorsCount())
+
+	m = testConventionModel()
+	m.AllowedEnvironments = types.SetValueMust(types.StringType, []attr.Value{types.StringValue("dev")})
+	m.Environment = types.StringUnknown()
+	assert.False(t, m.validate(t.Context()).HasError())
+}
+
+func TestConventionResolve(t *testing.T) {
+	data := providerData{}
+	data.configProviderDefaults()
+	config := &ProviderConfig{SourceRef: testSchemaLibraryFS(), ProviderData: data}
+
+	m := testConventionModel()
+	m.Separator = types.StringValue("_")
+	m.Lowercase = types.BoolValue(true)
+	m.Environment = types.StringValue("prd")
+	m.NamePrecedence = types.ListValueMust(types.StringType, []attr.Value{types.StringValue("abbreviation"), types.StringValue("name")})
+
+	diags := m.resolve(t.Context(), config)
+	assert.False(t, diags.HasError())
+	assert.NotEmpty(t, m.Id.ValueString())
+	assert.Equal(t, "we", m.Locations.Elements()["westeurope"].(types.String).ValueString())
+
+	var configuration configurationModel
+	assert.False(t, m.Configuration.As(t.Context(), &configuration, basetypes.ObjectAsOptions{}).HasError())
+	assert.Equal(t, "_", configuration.Separator.ValueString())
+	assert.Equal(t, "prd", configuration.Environment.ValueString())
+	assert.True(t, configuration.Lowercase.ValueBool())
+
+	var namingSchema s.NamingSchema
+	assert.False(t, m.Schema.Elements()["azurerm_resource_group"].(types.Object).As(t.Context(), &namingSchema, basetypes.ObjectAsOptions{}).HasError())
+	assert.Equal(t, []string{"abbreviation", "name"}, extractStringSlice(namingSchema.Configuration.NamePrecedence))
+	assert.Equal(t, "_", namingSchema.Configuration.Separator.ValueString())
+
+	id := m.Id.ValueString()
+	m.Environment = types.StringValue("dev")
+	assert.False(t, m.resolve(t.Context(), config).HasError())
+	assert.NotEqual(t, id, m.Id.ValueString())
+}
+
+func TestConventionResolve_AllowedValues(t *testing.T) {
+	data := providerData{}
+	data.configProviderDefaults()
+	config := &ProviderConfig{SourceRef: testSchemaLibraryFS(), ProviderData: data}
+
+	m := testConventionModel()
+	m.AllowedEnvironments = types.SetValueMust(types.StringType, []attr.Value{types.StringValue("prd"), types.StringValue("dev")})
+	m.AllowedPrefixes = types.SetValueMust(types.StringType, []attr.Value{types.StringValue("app")})
+	assert.False(t, m.resolve(t.Context(), config).HasError())
+
+	model := &configurationsModel{}
+	assert.False(t, m.Configuration.As(t.Context(), &model.Configuration, basetypes.ObjectAsOptions{}).HasError())
+	assert.Equal(t, []string{"dev", "prd"}, extractStringSlice(model.Configuration.AllowedEnvironments))
+	assert.Equal(t, []string{"app"}, extractStringSlice(model.Configuration.AllowedPrefixes))
+
+	var typeSchema s.NamingSchema
+	assert.False(t, m.Schema.Elements()["azurerm_resource_group"].(types.Object).As(t.Context(), &typeSchema, basetypes.ObjectAsOptions{}).HasError())
+
+	// Names built from the configuration must follow the convention as well.
+	build := func(settings *s.BuildNameSettingsModel) *function.FuncError {
+		schema := typeSchema
+		resp := &function.RunResponse{}
+		buildCheckedName(t.Context(), model, "azurerm_resource_group", settings, types.StringValue("app"), &schema, resp)
+		return resp.Error
+	}
+	assert.Nil(t, build(&s.BuildNameSettingsModel{Environment: "prd", Prefixes: []string{"app"}}))
+	assert.ErrorContains(t, build(&s.BuildNameSettingsModel{Environment: "anything"}), "environment 'anything' is not one of the allowed environments of the configuration: dev, prd")
+	assert.ErrorContains(t, build(&s.BuildNameSettingsModel{Prefixes: []string{"x"}}), "prefix 'x' is not one of the allowed prefixes of the configuration: app")
+}
+
+func TestNarrowAllowed(t *testing.T) {
+	assert.Equal(t, []string{"dev", "prd"}, narrowAllowed(nil, []string{"dev", "prd"}))
+	assert.Equal(t, []string{"prd"}, narrowAllowed([]string{"prd", "tst"}, []string{"dev", "prd"}))
+	assert.Empty(t, narrowAllowed([]string{"tst"}, []string{"dev", "prd"}))
+}
+
+func TestAccStandesamtConvention(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactoriesUnique(),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "standesamt_convention" "org" {
+  separator            = "-"
+  lowercase            = true
+  name_precedence      = ["abbreviation", "name", "environment"]
+  allowed_environments = ["dev", "prd"]
+  environment          = "prd"
+  resource_types       = ["azurerm_resource_group"]
+}
+
+output "name" {
+  value = provider::standesamt::name(standesamt_convention.org, "azurerm_resource_group", {}, "App")
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("standesamt_convention.org", "id"),
+					resource.TestCheckResourceAttr("standesamt_convention.org", "configuration.environment", "prd"),
+					resource.TestCheckOutput("name", "rg-app-prd"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccStandesamtConventionEnvironmentNotAllowed(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactoriesUnique(),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "standesamt_convention" "org" {
+  allowed_environments = ["dev", "prd"]
+  environment          = "tst"
+}
+`,
+				ExpectError: regexp.MustCompile(`Environment not allowed by convention`),
+			},
+		},
+	})
+}
@@ -0,0 +1,263 @@
+// Copyright glueckkanja AG 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-validators/boolvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"maps"
+	"slices"
+	"strings"
+	s "terraform-provider-standesamt/internal/schema"
+)
+
+// Formats of the standesamt_conventions_doc data source.
+const (
+	conventionsDocFormatMarkdown = "markdown"
+	conventionsDocFormatJSON     = "json"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ConventionsDocDataSource{}
+
+type conventionsDocDataSourceModel struct {
+	ResourceTypes  types.List   `tfsdk:"resource_types"`
+	FromUsageStats types.Bool   `tfsdk:"from_usage_stats"`
+	Format         types.String `tfsdk:"format"`
+	Content        types.String `tfsdk:"content"`
+}
+
+// conventionsDoc is the effective naming convention of the provider
+// configuration, rendered by the standesamt_conventions_doc data source.
+type conventionsDoc struct {
+	Convention    string                       `json:"convention"`
+	Environment   string                       `json:"environment"`
+	Separator     string                       `json:"separator"`
+	HashLength    int32                        `json:"hash_length"`
+	Lowercase     bool                         `json:"lowercase"`
+	Uppercase     bool                         `json:"uppercase"`
+	ResourceTypes []conventionsDocResourceType `json:"resource_types"`
+}
+
+type conventionsDocResourceType struct {
+	ResourceType   string   `json:"resource_type"`
+	Abbreviation   string   `json:"abbreviation"`
+	NamePrecedence []string `json:"name_precedence"`
+	Separator      string   `json:"separator"`
+	MinLength      int64    `json:"min_length"`
+	MaxLength      int64    `json:"max_length"`
+	Scope          string   `json:"scope"`
+}
+
+func NewConventionsDocDataSource() datasource.DataSource {
+	return &ConventionsDocDataSource{}
+}
+
+// ConventionsDocDataSource defines the data source implementation.
+type ConventionsDocDataSource struct {
+	providerConfig *ProviderConfig
+}
+
+func (d *ConventionsDocDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_conventions_doc"
+}
+
+func (d *ConventionsDocDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Data source to render the effective naming convention of the provider configuration as Markdown or JSON, e.g. to write it to the documentation of a repository.",
+		MarkdownDescription: "Data source to render the effective naming convention of the provider configuration as Markdown or JSON, e.g. to write it to the documentation of a repository with `local_file`. It lists the provider settings and per resource type the abbreviation, name precedence, separator and length limits the `name` function uses without settings.",
+		Attributes: map[string]schema.Attribute{
+			"resource_types": schema.ListAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				Description:         "The resource types to document. Defaults to all resource types of the schema library.",
+				MarkdownDescription: "The resource types to document. Defaults to all resource types of the schema library.",
+			},
+			"from_usage_stats": schema.BoolAttribute{
+				Optional:            true,
+				Description:         "Document the resource types the standesamt_name and standesamt_unique_name resources built names for in this run instead of resource_types. Requires usage_stats on the provider. The content depends on the names planned before the data source is read, e.g. with depends_on. Default 'false'",
+				MarkdownDescription: "Document the resource types the `standesamt_name` and `standesamt_unique_name` resources built names for in this run instead of `resource_types`. Requires `usage_stats` on the provider. The content depends on the names planned before the data source is read, e.g. with `depends_on`. Default 'false'",
+				Validators: []validator.Bool{
+					boolvalidator.ConflictsWith(path.MatchRoot("resource_types")),
+				},
+			},
+			"format": schema.StringAttribute{
+				Optional:            true,
+				Description:         "The format of content, 'markdown' or 'json'. Default 'markdown'",
+				MarkdownDescription: "The format of `content`, `markdown` or `json`. Default 'markdown'",
+				Validators: []validator.String{
+					stringvalidator.OneOf(conventionsDocFormatMarkdown, conventionsDocFormatJSON),
+				},
+			},
+			"content": schema.StringAttribute{
+				Computed:            true,
+				Description:         "The rendered naming convention.",
+				MarkdownDescription: "The rendered naming convention.",
+			},
+		},
+	}
+}
+
+func (d *ConventionsDocDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*ProviderConfig)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *ProviderConfig, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.providerConfig = data
+}
+
+func (d *ConventionsDocDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var model conventionsDocDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resourceTypes := extractStringSlice(model.ResourceTypes)
+	if model.FromUsageStats.ValueBool() {
+		enabled, counts := d.providerConfig.usageStats.snapshot()
+		if !enabled {
+			resp.Diagnostics.AddAttributeError(path.Root("from_usage_stats"), "Usage stats not enabled", "from_usage_stats requires usage_stats = true in the provider configuration")
+			return
+		}
+		if len(counts) == 0 {
+			resp.Diagnostics.AddAttributeError(path.Root("from_usage_stats"), "No names built", "the name resources have not built any names yet, add them to depends_on or set resource_types")
+			return
+		}
+		resourceTypes = slices.Sorted(maps.Keys(counts))
+	}
+
+	doc, err := d.providerConfig.conventionsDoc(ctx, resourceTypes)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("resource_types"), "Failed to document naming convention", err.Error())
+		return
+	}
+
+	if model.Format.IsNull() {
+		model.Format = types.StringValue(conventionsDocFormatMarkdown)
+	}
+
+	content := doc.markdown()
+	if model.Format.ValueString() == conventionsDocFormatJSON {
+		rendered, err := json.Marshal(doc)
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to render naming convention", err.Error())
+			return
+		}
+		content = string(rendered)
+	}
+	model.Content = types.StringValue(content)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+// conventionsDoc returns the effective naming convention of the provider
+// configuration for resourceTypes, all resource types of the schema library if
+// empty. The separator and name precedence of a resource type are resolved by
+// the name builder, so they are the ones the name function uses without
+// settings.
+func (c *ProviderConfig) conventionsDoc(ctx context.Context, resourceTypes []string) (*conventionsDoc, error) {
+	if len(resourceTypes) == 0 {
+		namingSchemaMap, err := c.NamingSchemaMap()
+		if err != nil {
+			return nil, err
+		}
+		resourceTypes = slices.Sorted(maps.Keys(namingSchemaMap))
+	}
+
+	doc := &conventionsDoc{
+		Convention:    c.ProviderData.Convention.ValueString(),
+		Environment:   c.ProviderData.Environment.ValueString(),
+		Separator:     c.ProviderData.Separator.ValueString(),
+		HashLength:    c.ProviderData.HashLength.ValueInt32(),
+		Lowercase:     c.ProviderData.Lowercase.ValueBool(),
+		Uppercase:     c.ProviderData.Uppercase.ValueBool(),
+		ResourceTypes: make([]conventionsDocResourceType, 0, len(resourceTypes)),
+	}
+
+	for _, resourceType := range resourceTypes {
+		model, typeSchema, err := c.configurationsModel(resourceType)
+		if err != nil {
+			return nil, err
+		}
+
+		builder := newNameBuilder(ctx, model, typeSchema, &s.BuildNameSettingsModel{})
+		builder.resolveSeparator()
+		resp := &function.RunResponse{}
+		builder.resolveNamePrecedence(resp)
+		if resp.Error != nil {
+			return nil, fmt.Errorf("resource type '%s': %s", resourceType, resp.Error.Error())
+		}
+
+		doc.ResourceTypes = append(doc.ResourceTypes, conventionsDocResourceType{
+			ResourceType:   resourceType,
+			Abbreviation:   typeSchema.Abbreviation.ValueString(),
+			NamePrecedence: extractStringSlice(builder.result.NamePrecedence),
+			Separator:      builder.result.Separator.ValueString(),
+			MinLength:      typeSchema.MinLength.ValueInt64(),
+			MaxLength:      typeSchema.MaxLength.ValueInt64(),
+			Scope:          typeSchema.Scope.ValueString(),
+		})
+	}
+
+	return doc, nil
+}
+
+// markdown renders the naming convention as Markdown tables.
+func (doc *conventionsDoc) markdown() string {
+	casing := "as is"
+	switch {
+	case doc.Lowercase:
+		casing = "lowercase"
+	case doc.Uppercase:
+		casing = "uppercase"
+	}
+
+	var b strings.Builder
+	b.WriteString("# Naming convention\n\n")
+	b.WriteString("| Setting | Value |\n|---|---|\n")
+	fmt.Fprintf(&b, "| Convention | %s |\n", markdownCode(doc.Convention))
+	fmt.Fprintf(&b, "| Environment | %s |\n", markdownCode(doc.Environment))
+	fmt.Fprintf(&b, "| Separator | %s |\n", markdownCode(doc.Separator))
+	fmt.Fprintf(&b, "| Hash length | %d |\n", doc.HashLength)
+	fmt.Fprintf(&b, "| Casing | %s |\n", casing)
+
+	b.WriteString("\n## Resource types\n\n")
+	b.WriteString("| Resource type | Abbreviation | Name precedence | Separator | Length | Scope |\n|---|---|---|---|---|---|\n")
+	for _, rt := range doc.ResourceTypes {
+		fmt.Fprintf(&b, "| %s | %s | %s | %s | %d-%d | %s |\n",
+			markdownCode(rt.ResourceType), markdownCode(rt.Abbreviation), strings.Join(rt.NamePrecedence, ", "),
+			markdownCode(rt.Separator), rt.MinLength, rt.MaxLength, rt.Scope)
+	}
+	return b.String()
+}
+
+// markdownCode formats a value as inline code, a dash if it is empty.
+func markdownCode(value string) string {
+	if value == "" {
+		return "-"
+	}
+	return "`" + strings.ReplaceAll(value, "|", "\\|") + "`"
+}
@@ -0,0 +1,79 @@
+// Copyright glueckkanja AG 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConventionsDoc(t *testing.T) {
+	config := &ProviderConfig{SourceRef: testLibrary}
+	config.ProviderData.configProviderDefaults()
+
+	doc, err := config.conventionsDoc(context.Background(), nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "-", doc.Separator)
+	if assert.Len(t, doc.ResourceTypes, 1) {
+		assert.Equal(t, conventionsDocResourceType{
+			ResourceType:   "azurerm_resource_group",
+			Abbreviation:   "rg",
+			NamePrecedence: []string{"abbreviation", "prefixes", "name", "location", "environment", "hash", "suffixes"},
+			Separator:      "-",
+			MinLength:      1,
+			MaxLength:      90,
+		}, doc.ResourceTypes[0])
+	}
+
+	markdown := doc.markdown()
+	assert.Contains(t, markdown, "| Separator | `-` |\n")
+	assert.Contains(t, markdown, "| `azurerm_resource_group` | `rg` | abbreviation, prefixes, name, location, environment, hash, suffixes | `-` | 1-90 |  |\n")
+
+	_, err = config.conventionsDoc(context.Background(), []string{"azurerm_unknown"})
+	assert.ErrorContains(t, err, "resource type 'azurerm_unknown' not found in schema library")
+}
+
+func TestAccStandesamtConventionsDoc(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactoriesWithLibrary(testLibrary),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "standesamt_conventions_doc" "markdown" {}
+
+data "standesamt_conventions_doc" "json" {
+  resource_types = ["azurerm_resource_group"]
+  format         = "json"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("data.standesamt_conventions_doc.markdown", "content", regexp.MustCompile("(?m)^\\| `azurerm_resource_group` \\| `rg` \\|")),
+					resource.TestMatchResourceAttr("data.standesamt_conventions_doc.json", "content", regexp.MustCompile(`"abbreviation":"rg"`)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccStandesamtConventionsDocUsageStatsDisabled(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactoriesWithLibrary(testLibrary),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "standesamt_conventions_doc" "used" {
+  from_usage_stats = true
+}
+`,
+				ExpectError: regexp.MustCompile(`from_usage_stats requires usage_stats = true`),
+			},
+		},
+	})
+}
@@ -0,0 +1,284 @@
+// Copyright glueckkanja AG 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net"
+	"net/http"
+	"strconv"
+	s "terraform-provider-standesamt/internal/schema"
+	"terraform-provider-standesamt/internal/tools"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// debugServerEnv enables the debug server on the given port of the loopback
+// interface. It is intentionally not exposed in the provider schema.
+const debugServerEnv = "SA_DEBUG_SERVER"
+
+// debugServerMaxBodyBytes limits the request body of the debug server, a
+// request only carries a resource type, a name and settings.
+const debugServerMaxBodyBytes = 1 << 20
+
+// debugServerRequest is the request body of the /name and /validate endpoints.
+// The settings take the keys of the settings argument of the naming functions.
+type debugServerRequest struct {
+	ResourceType string          `json:"resource_type"`
+	Name         string          `json:"name"`
+	Settings     json.RawMessage `json:"settings"`
+}
+
+type debugServerNameResponse struct {
+	Name string `json:"name"`
+}
+
+type debugServerErrorResponse struct {
+	Error string `json:"error"`
+}
+
+// startDebugServer serves name generation for scripts and pre-commit hooks on
+// 127.0.0.1:port for the lifetime of the provider process. It uses the same
+// builder as the naming functions with the provider settings as configuration.
+func startDebugServer(config *ProviderConfig, port string) error {
+	if p, err := strconv.Atoi(port); err != nil || p < 1 || p > 65535 {
+		return fmt.Errorf("invalid value for %s: %s, must be a port between 1 and 65535", debugServerEnv, port)
+	}
+
+	listener, err := net.Listen("tcp", net.JoinHostPort("127.0.0.1", port))
+	if err != nil {
+		return fmt.Errorf("failed to start debug server: %w", err)
+	}
+
+	server := &http.Server{
+		Handler:           newDebugServerHandler(config),
+		ReadHeaderTimeout: 5 * time.Second,
+	}
+	go func() {
+		_ = server.Serve(listener)
+	}()
+
+	return nil
+}
+
+func newDebugServerHandler(config *ProviderConfig) http.Handler {
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("POST /name", func(w http.ResponseWriter, r *http.Request) {
+		req, model, typeSchema, settings, err := config.parseDebugServerRequest(w, r)
+		if err != nil {
+			writeDebugServerJSON(w, http.StatusBadRequest, debugServerErrorResponse{Error: err.Error()})
+			return
+		}
+
+		resp := &function.RunResponse{}
+		name := buildCheckedName(r.Context(), model, req.ResourceType, settings, types.StringValue(req.Name), typeSchema, resp)
+		if resp.Error != nil {
+			writeDebugServerJSON(w, http.StatusUnprocessableEntity, debugServerErrorResponse{Error: resp.Error.Error()})
+			return
+		}
+
+		writeDebugServerJSON(w, http.StatusOK, debugServerNameResponse{Name: tools.GetBaseString(name)})
+	})
+
+	mux.HandleFunc("POST /validate", func(w http.ResponseWriter, r *http.Request) {
+		req, model, typeSchema, settings, err := config.parseDebugServerRequest(w, r)
+		if err != nil {
+			writeDebugServerJSON(w, http.StatusBadRequest, debugServerErrorResponse{Error: err.Error()})
+			return
+		}
+
+		resp := &function.RunResponse{}
+		builder := newNameBuilder(r.Context(), model, typeSchema, settings)
+		validation := builder.validate(types.StringValue(req.Name), req.ResourceType, validateModeBuilt, resp)
+		if resp.Error != nil {
+			writeDebugServerJSON(w, http.StatusUnprocessableEntity, debugServerErrorResponse{Error: resp.Error.Error()})
+			return
+		}
+
+		writeDebugServerJSON(w, http.StatusOK, newValidationDocument(validation, req.ResourceType, typeSchema.Scope.ValueString()))
+	})
+
+	return mux
+}
+
+// parseDebugServerRequest decodes the request body and resolves the configuration
+// and naming schema from the provider settings and the schema library. The
+// settings are parsed like the settings argument of the naming functions.
+func (c *ProviderConfig) parseDebugServerRequest(w http.ResponseWriter, r *http.Request) (*debugServerRequest, *configurationsModel, *s.NamingSchema, *s.BuildNameSettingsModel, error) {
+	var req debugServerRequest
+	decoder := json.NewDecoder(http.MaxBytesReader(w, r.Body, debugServerMaxBodyBytes))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&req); err != nil {
+		return nil, nil, nil, nil, fmt.Errorf("failed to parse request: %w", err)
+	}
+
+	model, typeSchema, err := c.configurationsModel(req.ResourceType)
+	if err != nil {
+		return nil, nil, nil, nil, err
+	}
+
+	settingsDynamic, err := settingsFromJSON(req.Settings)
+	if err != nil {
+		return nil, nil, nil, nil, err
+	}
+	settings, err := parseNameSettings(settingsDynamic, typeSchema)
+	if err != nil {
+		return nil, nil, nil, nil, err
+	}
+
+	return &req, model, typeSchema, settings, nil
+}
+
+// settingsFromJSON converts the JSON settings of a request into the value of
+// the settings argument, with the types Terraform passes for HCL literals:
+// strings, numbers, bools, tuples and objects. Null settings are left out.
+func settingsFromJSON(data json.RawMessage) (types.Dynamic, error) {
+	if len(data) == 0 {
+		return types.DynamicNull(), nil
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	var raw any
+	if err := decoder.Decode(&raw); err != nil {
+		return types.Dynamic{}, fmt.Errorf("failed to parse settings: %w", err)
+	}
+	if raw == nil {
+		return types.DynamicNull(), nil
+	}
+	if _, ok := raw.(map[string]any); !ok {
+		return types.Dynamic{}, fmt.Errorf("settings must be an object")
+	}
+
+	value, err := jsonAttrValue(raw)
+	if err != nil {
+		return types.Dynamic{}, fmt.Errorf("settings: %w", err)
+	}
+	return types.DynamicValue(value), nil
+}
+
+// jsonAttrValue converts a decoded JSON value into its framework value.
+func jsonAttrValue(raw any) (attr.Value, error) {
+	switch v := raw.(type) {
+	case nil:
+		return types.DynamicNull(), nil
+	case string:
+		return types.StringValue(v), nil
+	case bool:
+		return types.BoolValue(v), nil
+	case json.Number:
+		f, _, err := big.ParseFloat(v.String(), 10, 512, big.ToNearestEven)
+		if err != nil {
+			return nil, fmt.Errorf("invalid number %s", v)
+		}
+		return types.NumberValue(f), nil
+	case []any:
+		elementTypes := make([]attr.Type, 0, len(v))
+		elements := make([]attr.Value, 0, len(v))
+		for _, e := range v {
+			element, err := jsonAttrValue(e)
+			if err != nil {
+				return nil, err
+			}
+			elementTypes = append(elementTypes, element.Type(context.Background()))
+			elements = append(elements, element)
+		}
+		return types.TupleValueMust(elementTypes, elements), nil
+	case map[string]any:
+		attrTypes := make(map[string]attr.Type, len(v))
+		attrs := make(map[string]attr.Value, len(v))
+		for k, e := range v {
+			if e == nil {
+				continue
+			}
+			value, err := jsonAttrValue(e)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", k, err)
+			}
+			attrTypes[k] = value.Type(context.Background())
+			attrs[k] = value
+		}
+		return types.ObjectValueMust(attrTypes, attrs), nil
+	}
+	return nil, fmt.Errorf("unsupported JSON value %v", raw)
+}
+
+// configurationsModel resolves the configuration of the naming functions from
+// the provider settings and the schema library, as the standesamt_config and
+// standesamt_locations data sources pass it without arguments, and the naming
+// schema of resourceType.
+func (c *ProviderConfig) configurationsModel(resourceType string) (*configurationsModel, *s.NamingSchema, error) {
+	namingSchemaMap, err := c.NamingSchemaMap()
+	if err != nil {
+		return nil, nil, err
+	}
+	result, err := c.Result()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	typeSchema, ok := namingSchemaMap[resourceType]
+	if !ok {
+		return nil, nil, fmt.Errorf("resource type '%s' not found in schema library", resourceType)
+	}
+
+	locations := make(map[string]types.String, len(result.Locations))
+	for k, v := range result.Locations {
+		locations[k] = types.StringValue(v)
+	}
+
+	model := &configurationsModel{
+		Configuration: c.ProviderData.configuration(),
+		Locations:     locations,
+	}
+	model.Configuration.Affixes = affixesMapValue(result.Affixes)
+	model.Configuration.Environments, model.Configuration.AllowedEnvironments = environmentsValues(result.Environments)
+
+	return model, &typeSchema, nil
+}
+
+// configuration returns the provider settings as naming configuration, as the
+// standesamt_config data source does without arguments.
+func (d providerData) configuration() configurationModel {
+	return configurationModel{
+		Convention:   d.Convention,
+		Environment:  d.Environment,
+		Separator:    d.Separator,
+		RandomSeed:   d.RandomSeed,
+		HashLength:   d.HashLength,
+		Lowercase:    d.Lowercase,
+		Uppercase:    d.Uppercase,
+		Strict:       d.Strict,
+		DenyPatterns: d.DenyPatterns,
+		Prefixes:     types.ListValueMust(types.StringType, []attr.Value{}),
+		Suffixes:     types.ListValueMust(types.StringType, []attr.Value{}),
+		Location:     types.StringNull(),
+
+		RequiredSegments:    d.RequiredSegments,
+		RequiredPrefixRegex: d.RequiredPrefixRegex,
+		MinGlobalHashLength: d.MinGlobalHashLength,
+		Affixes:             affixesMapValue(nil),
+		Environments:        types.MapValueMust(types.StringType, map[string]attr.Value{}),
+		AllowedEnvironments: types.ListValueMust(types.StringType, []attr.Value{}),
+		AllowedPrefixes:     types.ListValueMust(types.StringType, []attr.Value{}),
+		Compatibility:       types.MapValueMust(types.ObjectType{AttrTypes: s.SchemaTypeAttributes()}, map[string]attr.Value{}),
+		CompatibilityMode:   d.CompatibilityMode,
+		Workspace:           d.Workspace,
+		Stack:               d.Stack,
+	}
+}
+
+func writeDebugServerJSON(w http.ResponseWriter, status int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
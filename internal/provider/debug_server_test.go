@@ -0,0 +1,146 @@
+// Copyright glueckkanja AG 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestDebugServer() *httptest.Server {
+	var data providerData
+	data.configProviderDefaults()
+	return httptest.NewServer(newDebugServerHandler(&ProviderConfig{
+		SourceRef:    testSchemaLibraryFS(),
+		ProviderData: data,
+	}))
+}
+
+func TestDebugServer_Name(t *testing.T) {
+	server := newTestDebugServer()
+	defer server.Close()
+
+	resp, err := http.Post(server.URL+"/name", "application/json", strings.NewReader(
+		`{"resource_type": "azurerm_resource_group", "name": "app", "settings": {"location": "westeurope", "environment": "tst"}}`))
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	var body debugServerNameResponse
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "rg-app-we-tst", body.Name)
+}
+
+func TestDebugServer_NamePreset(t *testing.T) {
+	server := newTestDebugServer()
+	defer server.Close()
+
+	resp, err := http.Post(server.URL+"/name", "application/json", strings.NewReader(
+		`{"resource_type": "azurerm_resource_group", "name": "app", "settings": {"location": "westeurope", "environment": "tst", "preset": "global_unique", "hash_length": 2}}`))
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	var body debugServerNameResponse
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Regexp(t, "^rgappwetst[a-z0-9]{2}$", body.Name)
+}
+
+func TestDebugServer_InvalidSettings(t *testing.T) {
+	server := newTestDebugServer()
+	defer server.Close()
+
+	for settings, message := range map[string]string{
+		`{"preset": "nonexistent"}`:                    "unknown preset 'nonexistent'",
+		`{"hash_mode": "bogus"}`:                       "invalid hash_mode 'bogus'",
+		`{"hash_length": 500}`:                         "setting 'hash_length' must be a whole number between 0 and 64",
+		`{"name_precedence": ["abreviation", "name"]}`: "name_precedence",
+		`{"post_process": ["bogus"]}`:                  "bogus",
+		`{"max_length": 5, "min_length": 10}`:          "min_length 10 is greater than max_length 5",
+		`{"unknown_setting": true}`:                    "unknown_setting",
+		`["location"]`:                                 "settings must be an object",
+	} {
+		for _, endpoint := range []string{"/name", "/validate"} {
+			resp, err := http.Post(server.URL+endpoint, "application/json", strings.NewReader(
+				`{"resource_type": "azurerm_resource_group", "name": "app", "settings": `+settings+`}`))
+			require.NoError(t, err)
+
+			var body debugServerErrorResponse
+			require.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+			resp.Body.Close()
+			assert.Equal(t, http.StatusBadRequest, resp.StatusCode, settings)
+			assert.Contains(t, body.Error, message, settings)
+		}
+	}
+}
+
+func TestDebugServer_NameInvalid(t *testing.T) {
+	server := newTestDebugServer()
+	defer server.Close()
+
+	resp, err := http.Post(server.URL+"/name", "application/json", strings.NewReader(
+		`{"resource_type": "azurerm_resource_group", "name": "app#1"}`))
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	var body debugServerErrorResponse
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+	assert.Equal(t, http.StatusUnprocessableEntity, resp.StatusCode)
+	assert.Contains(t, body.Error, "Name does not match regex")
+}
+
+func TestDebugServer_Validate(t *testing.T) {
+	server := newTestDebugServer()
+	defer server.Close()
+
+	resp, err := http.Post(server.URL+"/validate", "application/json", strings.NewReader(
+		`{"resource_type": "azurerm_resource_group", "name": "login", "settings": {"reserved_words_check": true}}`))
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	var body validationDocument
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "rg-login", body.Name)
+	assert.True(t, body.Regex.Valid)
+	assert.Equal(t, []string{"LOGIN"}, body.ReservedWordsFound)
+	assert.Equal(t, []string{}, body.DeniedPatterns)
+}
+
+func TestDebugServer_BodyTooLarge(t *testing.T) {
+	server := newTestDebugServer()
+	defer server.Close()
+
+	body := `{"resource_type": "azurerm_resource_group", "name": "` + strings.Repeat("a", debugServerMaxBodyBytes) + `"}`
+	resp, err := http.Post(server.URL+"/name", "application/json", strings.NewReader(body))
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	var errBody debugServerErrorResponse
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&errBody))
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	assert.Contains(t, errBody.Error, "request body too large")
+}
+
+func TestDebugServer_UnknownResourceType(t *testing.T) {
+	server := newTestDebugServer()
+	defer server.Close()
+
+	resp, err := http.Post(server.URL+"/name", "application/json", strings.NewReader(`{"resource_type": "unknown"}`))
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+}
+
+func TestStartDebugServer_InvalidPort(t *testing.T) {
+	err := startDebugServer(&ProviderConfig{}, "abc")
+	assert.ErrorContains(t, err, "invalid value for SA_DEBUG_SERVER")
+}
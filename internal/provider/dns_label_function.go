@@ -0,0 +1,69 @@
+// Copyright glueckkanja AG 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"terraform-provider-standesamt/internal/tools"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = &DNSLabelFunction{}
+
+type DNSLabelFunction struct{}
+
+func NewDNSLabelFunction() function.Function {
+	return &DNSLabelFunction{}
+}
+
+func (f *DNSLabelFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "dns_label"
+}
+
+func (f *DNSLabelFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Derive a DNS-safe label from a resource name",
+		Description: "Derive a DNS label according to RFC 1035 from a resource name, e.g. for a custom domain or an endpoint name.",
+		MarkdownDescription: "Derive a DNS label according to RFC 1035 from a resource name, e.g. for a custom domain or an endpoint name. " +
+			"The label is lower case, every run of characters other than ASCII letters and digits becomes a single `-`, " +
+			"leading characters that are not letters are removed and the label is truncated to `max_length` without a trailing `-`. " +
+			"Names without any letter return an error.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "name",
+				Description: "The name to derive the label from, e.g. the result of the name function.",
+			},
+			function.Int64Parameter{
+				Name:                "max_length",
+				Description:         "The maximum length of the label, at most 63. 0 uses the maximum of 63.",
+				MarkdownDescription: "The maximum length of the label, at most `63`. `0` uses the maximum of `63`.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *DNSLabelFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var (
+		name      string
+		maxLength int64
+	)
+
+	if resp.Error = req.Arguments.Get(ctx, &name, &maxLength); resp.Error != nil {
+		return
+	}
+
+	label, err := tools.DNSLabel(name, int(maxLength))
+	if err != nil {
+		argument := int64(0)
+		if maxLength < 0 || maxLength > tools.DNSLabelMaxLength {
+			argument = 1
+		}
+		resp.Error = function.NewArgumentFuncError(argument, err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, label))
+}
@@ -0,0 +1,57 @@
+// Copyright glueckkanja AG 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+)
+
+func TestDNSLabelFunction_Basic(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactoriesUnique(),
+		Steps: []resource.TestStep{
+			{
+				Config: `output "test" {
+					value = provider::standesamt::dns_label("APP_Service.we-PRD-", 0)
+				}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("test", knownvalue.StringExact("app-service-we-prd")),
+				},
+			},
+			{
+				Config: `output "test" {
+					value = provider::standesamt::dns_label("1-app-service-we-prd", 11)
+				}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("test", knownvalue.StringExact("app-service")),
+				},
+			},
+		},
+	})
+}
+
+func TestDNSLabelFunction_Invalid(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactoriesUnique(),
+		Steps: []resource.TestStep{
+			{
+				Config: `output "test" {
+					value = provider::standesamt::dns_label("app", 64)
+				}`,
+				ExpectError: regexp.MustCompile(`max_length must be between 0 and 63`),
+			},
+			{
+				Config: `output "test" {
+					value = provider::standesamt::dns_label("0123", 0)
+				}`,
+				ExpectError: regexp.MustCompile(`contains no letter`),
+			},
+		},
+	})
+}
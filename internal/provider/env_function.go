@@ -0,0 +1,78 @@
+// Copyright glueckkanja AG 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = &EnvFunction{}
+
+type EnvFunction struct{}
+
+func NewEnvFunction() function.Function {
+	return &EnvFunction{}
+}
+
+func (f *EnvFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "env"
+}
+
+func (f *EnvFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Return the short token of an environment",
+		Description: "Return the short token of a long environment name, e.g. prd for production, from the environment catalog of the configuration.",
+		MarkdownDescription: "Return the short token of a long environment name, e.g. `prd` for `production`, like the naming functions " +
+			"replace the `environment`. The tokens are taken from the `environments` of the configuration, i.e. the " +
+			"`schema.environments.json` catalog of the schema library merged with the `environments` of the provider. " +
+			"Environments that are not in the catalog are returned as is. The function fails if the catalog has `allowed` " +
+			"environments and the token is not one of them.",
+		Parameters: []function.Parameter{
+			configurationsParameter(),
+			function.StringParameter{
+				Name:        "environment",
+				Description: "The environment, e.g. \"production\" or \"prd\".",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *EnvFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var (
+		configurationsDynamic types.Dynamic
+		environment           string
+	)
+
+	if resp.Error = req.Arguments.Get(ctx, &configurationsDynamic, &environment); resp.Error != nil {
+		return
+	}
+
+	configurations, err := configurationsArgument(ctx, configurationsDynamic)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	if !isWhollyKnown(ctx, configurations) {
+		// The result is left unknown until the configuration is known.
+		return
+	}
+
+	model, diags := configurationsFromObject(ctx, configurations)
+	if resp.Error = function.FuncErrorFromDiags(ctx, diags); resp.Error != nil {
+		return
+	}
+
+	token, err := environmentToken(&model.Configuration, environment)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(1, err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, token))
+}
@@ -0,0 +1,79 @@
+// Copyright glueckkanja AG 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEnvironmentToken(t *testing.T) {
+	configuration := &configurationModel{
+		Environments: types.MapValueMust(types.StringType, map[string]attr.Value{
+			"production":  types.StringValue("prd"),
+			"development": types.StringValue("dev"),
+		}),
+		AllowedEnvironments: types.ListNull(types.StringType),
+	}
+
+	token, err := environmentToken(configuration, "production")
+	assert.NoError(t, err)
+	assert.Equal(t, "prd", token)
+
+	// Environments that are not in the catalog are returned as is.
+	token, err = environmentToken(configuration, "sandbox")
+	assert.NoError(t, err)
+	assert.Equal(t, "sandbox", token)
+
+	configuration.AllowedEnvironments = types.ListValueMust(types.StringType, []attr.Value{types.StringValue("dev"), types.StringValue("prd")})
+	token, err = environmentToken(configuration, "development")
+	assert.NoError(t, err)
+	assert.Equal(t, "dev", token)
+
+	_, err = environmentToken(configuration, "sandbox")
+	assert.EqualError(t, err, "environment 'sandbox' is not one of the allowed environments of the configuration: dev, prd")
+}
+
+func TestEnvFunction_Passthrough(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactoriesUnique(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf("%s %s", default_config_with_no_settings_default_precedence, `output "test" {
+					value = provider::standesamt::env(local.config, "dev")
+				}`),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("test", knownvalue.StringExact("dev")),
+				},
+			},
+		},
+	})
+}
+
+func TestEnvFunction_NotAllowed(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactoriesUnique(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf("%s %s", default_config_with_no_settings_default_precedence, `output "test" {
+					value = provider::standesamt::env(merge(local.config, {
+						configuration = merge(local.config.configuration, {
+							environments         = { production = "prd" }
+							allowed_environments = ["prd"]
+						})
+					}), "sandbox")
+				}`),
+				ExpectError: regexp.MustCompile(`environment 'sandbox' is not one of the allowed environments`),
+			},
+		},
+	})
+}
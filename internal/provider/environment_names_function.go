@@ -0,0 +1,101 @@
+// Copyright glueckkanja AG 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = &EnvironmentNamesFunction{}
+
+type EnvironmentNamesFunction struct{}
+
+func NewEnvironmentNamesFunction() function.Function {
+	return &EnvironmentNamesFunction{}
+}
+
+func (f *EnvironmentNamesFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "environment_names"
+}
+
+func (f *EnvironmentNamesFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Provide valid resource names for multiple environments",
+		Description: "Build a resource name for every environment in the list and return a map of environment to name.",
+		MarkdownDescription: "Build a resource name for every environment in the list and return a map of environment to name. " +
+			"Each name is built and validated like the `name` function with the `environment` setting set to the map key.",
+		Parameters: []function.Parameter{
+			configurationsParameter(),
+			function.StringParameter{
+				Name:        "name_type",
+				Description: "The resource type to use for the name.",
+			},
+			settingsParameter(),
+			function.StringParameter{
+				Name:               "name",
+				Description:        "Name to parse",
+				AllowUnknownValues: true,
+			},
+			function.ListParameter{
+				Name:        "environments",
+				Description: "The environments to build names for, e.g. [\"dev\", \"tst\", \"prd\"].",
+				ElementType: types.StringType,
+			},
+		},
+		Return: function.MapReturn{
+			ElementType: types.StringType,
+		},
+	}
+}
+
+func (f *EnvironmentNamesFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var (
+		name                  types.String
+		nameType              string
+		configurationsDynamic types.Dynamic
+		settingsDynamic       types.Dynamic
+		environments          []string
+	)
+
+	if resp.Error = req.Arguments.Get(ctx, &configurationsDynamic, &nameType, &settingsDynamic, &name, &environments); resp.Error != nil {
+		return
+	}
+
+	configurations, err := configurationsArgument(ctx, configurationsDynamic)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	if name.IsUnknown() {
+		// The result is left unknown until the name is known.
+		return
+	}
+
+	model, buildNameSettings, typeSchema, err := parseConfigurations(ctx, configurations, nameType, settingsDynamic, resp)
+	if err != nil || resp.Error != nil {
+		return
+	}
+
+	names := make(map[string]types.String, len(environments))
+	for _, environment := range environments {
+		if environment == "" {
+			resp.Error = function.NewArgumentFuncError(4, "environments must not contain empty strings")
+			return
+		}
+
+		settings := *buildNameSettings
+		settings.Environment = environment
+
+		names[environment] = buildCheckedName(ctx, model, nameType, &settings, name, typeSchema, resp)
+		if resp.Error != nil {
+			return
+		}
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, names))
+}
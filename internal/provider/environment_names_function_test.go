@@ -0,0 +1,77 @@
+// Copyright glueckkanja AG 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+)
+
+func TestEnvironmentNamesFunction_Names(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactoriesUnique(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf("%s %s", default_config_with_no_settings_default_precedence, `output "test" {
+					value = provider::standesamt::environment_names(local.config, "azurerm_resource_group", local.settings, "app", ["dev", "prd"])
+				}`),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("test", knownvalue.MapExact(map[string]knownvalue.Check{
+						"dev": knownvalue.StringExact("rg-app-we-dev"),
+						"prd": knownvalue.StringExact("rg-app-we-prd"),
+					})),
+				},
+			},
+		},
+	})
+}
+
+func TestEnvironmentNamesFunction_MaxLength(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactoriesUnique(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf("%s %s", default_config_with_no_settings_default_precedence, `output "test" {
+					value = provider::standesamt::environment_names(local.config, "azurerm_resource_group", local.settings, "application", ["dev", "production"])
+				}`),
+				ExpectError: regexp.MustCompile(`Name has 28 characters,\s+but maximum is set to 20\.`),
+			},
+		},
+	})
+}
+
+func TestEnvironmentNamesFunction_MaxLengthSetting(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactoriesUnique(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf("%s %s", default_config_with_no_settings_default_precedence, `output "test" {
+					value = provider::standesamt::environment_names(local.config, "azurerm_resource_group", {
+						max_length = 10
+					}, "app", ["dev"])
+				}`),
+				ExpectError: regexp.MustCompile(`Name has 13 characters,\s+but maximum is set to 10\.`),
+			},
+		},
+	})
+}
+
+func TestEnvironmentNamesFunction_EmptyEnvironment(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactoriesUnique(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf("%s %s", default_config_with_no_settings_default_precedence, `output "test" {
+					value = provider::standesamt::environment_names(local.config, "azurerm_resource_group", local.settings, "app", [""])
+				}`),
+				ExpectError: regexp.MustCompile(`environments must not contain empty strings`),
+			},
+		},
+	})
+}
@@ -0,0 +1,211 @@
+// Copyright glueckkanja AG 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	s "terraform-provider-standesamt/internal/schema"
+)
+
+// inlineSchemaModel is a naming schema defined in the provider configuration.
+// It mirrors s.JsonNamingSchema with the attribute names of s.NamingSchema.
+type inlineSchemaModel struct {
+	ResourceType    types.String                    `tfsdk:"resource_type"`
+	Abbreviation    types.String                    `tfsdk:"abbreviation"`
+	MinLength       types.Int64                     `tfsdk:"min_length"`
+	MaxLength       types.Int64                     `tfsdk:"max_length"`
+	ValidationRegex types.String                    `tfsdk:"validation_regex"`
+	Scope           types.String                    `tfsdk:"scope"`
+	Configuration   *inlineSchemaConfigurationModel `tfsdk:"configuration"`
+}
+
+type inlineSchemaConfigurationModel struct {
+	UseEnvironment        types.Bool   `tfsdk:"use_environment"`
+	UseLowerCase          types.Bool   `tfsdk:"use_lower_case"`
+	UseUpperCase          types.Bool   `tfsdk:"use_upper_case"`
+	UseSeparator          types.Bool   `tfsdk:"use_separator"`
+	Separator             types.String `tfsdk:"separator"`
+	DenyDoubleHyphens     types.Bool   `tfsdk:"deny_double_hyphens"`
+	DenyRepeatedSeparator types.Bool   `tfsdk:"deny_repeated_separator"`
+	DenyLeading           types.String `tfsdk:"deny_leading"`
+	DenyTrailing          types.String `tfsdk:"deny_trailing"`
+	NamePrecedence        types.List   `tfsdk:"name_precedence"`
+	HashLength            types.Int32  `tfsdk:"hash_length"`
+	DenyPatterns          types.List   `tfsdk:"deny_patterns"`
+}
+
+func inlineSchemaAttribute() schema.ListNestedAttribute {
+	return schema.ListNestedAttribute{
+		Optional:            true,
+		Description:         "Naming schemas merged over the schema library, e.g. for internal resource types. A schema replaces the library schema with the same resource_type.",
+		MarkdownDescription: "Naming schemas merged over the schema library, e.g. for internal CRDs or `azapi` types. A schema replaces the library schema with the same `resource_type`. The attributes match the schema library format.",
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"resource_type": schema.StringAttribute{
+					Required:            true,
+					Description:         "The resource type, e.g. 'azapi_container_app'.",
+					MarkdownDescription: "The resource type, e.g. `azapi_container_app`.",
+				},
+				"abbreviation": schema.StringAttribute{
+					Required:            true,
+					Description:         "The abbreviation of the resource type.",
+					MarkdownDescription: "The abbreviation of the resource type.",
+				},
+				"min_length": schema.Int64Attribute{
+					Optional:            true,
+					Description:         "The minimum length of a name. Default 1",
+					MarkdownDescription: "The minimum length of a name. Default `1`",
+				},
+				"max_length": schema.Int64Attribute{
+					Required:            true,
+					Description:         "The maximum length of a name.",
+					MarkdownDescription: "The maximum length of a name.",
+				},
+				"validation_regex": schema.StringAttribute{
+					Required:            true,
+					Description:         "The regular expression a name has to match.",
+					MarkdownDescription: "The regular expression a name has to match.",
+				},
+				"scope": schema.StringAttribute{
+					Optional:            true,
+					Description:         "The scope in which a name has to be unique: 'global', 'resourceGroup' or 'parent'.",
+					MarkdownDescription: "The scope in which a name has to be unique: `global`, `resourceGroup` or `parent`.",
+					Validators: []validator.String{
+						stringvalidator.OneOf(s.Scopes[:]...),
+					},
+				},
+				"configuration": schema.SingleNestedAttribute{
+					Optional:            true,
+					Description:         "The naming configuration of the resource type. Unset values default to false, empty or 0.",
+					MarkdownDescription: "The naming configuration of the resource type. Unset values default to `false`, empty or `0`.",
+					Attributes: map[string]schema.Attribute{
+						"use_environment":         schema.BoolAttribute{Optional: true},
+						"use_lower_case":          schema.BoolAttribute{Optional: true},
+						"use_upper_case":          schema.BoolAttribute{Optional: true},
+						"use_separator":           schema.BoolAttribute{Optional: true},
+						"separator":               schema.StringAttribute{Optional: true},
+						"deny_double_hyphens":     schema.BoolAttribute{Optional: true},
+						"deny_repeated_separator": schema.BoolAttribute{Optional: true},
+						"deny_leading":            schema.StringAttribute{Optional: true},
+						"deny_trailing":           schema.StringAttribute{Optional: true},
+						"name_precedence":         schema.ListAttribute{Optional: true, ElementType: types.StringType},
+						"hash_length":             schema.Int32Attribute{Optional: true, Validators: []validator.Int32{int32validator.Between(0, maxHashLength)}},
+						"deny_patterns":           schema.ListAttribute{Optional: true, ElementType: types.StringType},
+					},
+				},
+			},
+		},
+	}
+}
+
+// schemaOverrideModel tightens the length limits of a resource type of the schema library.
+type schemaOverrideModel struct {
+	MinLength types.Int64 `tfsdk:"min_length"`
+	MaxLength types.Int64 `tfsdk:"max_length"`
+}
+
+func schemaOverridesAttribute() schema.MapNestedAttribute {
+	return schema.MapNestedAttribute{
+		Optional:            true,
+		Description:         "Stricter length limits per resource type, e.g. an internal maximum length below the Azure limit. The limits are clamped to the schema library, so they can only be stricter.",
+		MarkdownDescription: "Stricter length limits keyed by resource type, e.g. an internal maximum length below the Azure limit. The limits are clamped to the schema library, so they can only be stricter.",
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"min_length": schema.Int64Attribute{
+					Optional:            true,
+					Description:         "The minimum length of a name.",
+					MarkdownDescription: "The minimum length of a name.",
+				},
+				"max_length": schema.Int64Attribute{
+					Optional:            true,
+					Description:         "The maximum length of a name.",
+					MarkdownDescription: "The maximum length of a name.",
+				},
+			},
+		},
+	}
+}
+
+// schemaOverrides converts the schema_overrides attribute into length overrides.
+func (d providerData) schemaOverrides(ctx context.Context) (map[string]s.LengthOverride, diag.Diagnostics) {
+	if d.SchemaOverrides.IsNull() || d.SchemaOverrides.IsUnknown() {
+		return nil, nil
+	}
+
+	var models map[string]schemaOverrideModel
+	if diags := d.SchemaOverrides.ElementsAs(ctx, &models, false); diags.HasError() {
+		return nil, diags
+	}
+
+	overrides := make(map[string]s.LengthOverride, len(models))
+	for k, m := range models {
+		overrides[k] = s.LengthOverride{
+			MinLength: int(m.MinLength.ValueInt64()),
+			MaxLength: int(m.MaxLength.ValueInt64()),
+		}
+	}
+
+	return overrides, nil
+}
+
+// inlineSchemas converts the inline_schema attribute into schema library entries.
+func (d providerData) inlineSchemas(ctx context.Context) ([]s.JsonNamingSchema, diag.Diagnostics) {
+	if d.InlineSchema.IsNull() || d.InlineSchema.IsUnknown() {
+		return nil, nil
+	}
+
+	var models []inlineSchemaModel
+	if diags := d.InlineSchema.ElementsAs(ctx, &models, false); diags.HasError() {
+		return nil, diags
+	}
+
+	schemas := make([]s.JsonNamingSchema, 0, len(models))
+	for _, m := range models {
+		namingSchema := s.JsonNamingSchema{
+			ResourceType:    m.ResourceType.ValueString(),
+			Abbreviation:    m.Abbreviation.ValueString(),
+			MinLength:       1,
+			MaxLength:       int(m.MaxLength.ValueInt64()),
+			ValidationRegex: m.ValidationRegex.ValueString(),
+			Scope:           m.Scope.ValueString(),
+		}
+		if !m.MinLength.IsNull() {
+			namingSchema.MinLength = int(m.MinLength.ValueInt64())
+		}
+		if c := m.Configuration; c != nil {
+			namePrecedence := extractStringSlice(c.NamePrecedence)
+			if err := s.ValidateNamePrecedence(namePrecedence); err != nil {
+				var diags diag.Diagnostics
+				diags.AddAttributeError(path.Root("inline_schema"), "Invalid name precedence",
+					fmt.Sprintf("resource type '%s': %s", namingSchema.ResourceType, err))
+				return nil, diags
+			}
+			namingSchema.Configuration = s.JsonConfigurationSchema{
+				UseEnvironment:        c.UseEnvironment.ValueBool(),
+				UseLowerCase:          c.UseLowerCase.ValueBool(),
+				UseUpperCase:          c.UseUpperCase.ValueBool(),
+				UseSeparator:          c.UseSeparator.ValueBool(),
+				Separator:             c.Separator.ValueString(),
+				DenyDoubleHyphens:     c.DenyDoubleHyphens.ValueBool(),
+				DenyRepeatedSeparator: c.DenyRepeatedSeparator.ValueBool(),
+				DenyLeading:           c.DenyLeading.ValueString(),
+				DenyTrailing:          c.DenyTrailing.ValueString(),
+				NamePrecedence:        namePrecedence,
+				HashLength:            int(c.HashLength.ValueInt32()),
+				DenyPatterns:          extractStringSlice(c.DenyPatterns),
+			}
+		}
+		schemas = append(schemas, namingSchema)
+	}
+
+	return schemas, nil
+}
@@ -0,0 +1,162 @@
+// Copyright glueckkanja AG 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ function.Function = &LocationShortFunction{}
+	_ function.Function = &LocationLongFunction{}
+)
+
+type LocationShortFunction struct{}
+
+func NewLocationShortFunction() function.Function {
+	return &LocationShortFunction{}
+}
+
+func (f *LocationShortFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "location_short"
+}
+
+func (f *LocationShortFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Return the short token of a location",
+		Description: "Return the short token of a location, e.g. we for westeurope, from the locations map of the configuration.",
+		MarkdownDescription: "Return the short token of a location, e.g. `we` for `westeurope`, from the `locations` map of the " +
+			"configuration, like the naming functions resolve the `location`. Display names like `West Europe` resolve as " +
+			"well. The function fails if the location is not in the map.",
+		Parameters: []function.Parameter{
+			configurationsParameter(),
+			function.StringParameter{
+				Name:        "location",
+				Description: "The location, e.g. \"westeurope\".",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *LocationShortFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var (
+		configurationsDynamic types.Dynamic
+		location              string
+	)
+
+	if resp.Error = req.Arguments.Get(ctx, &configurationsDynamic, &location); resp.Error != nil {
+		return
+	}
+
+	configurations, err := configurationsArgument(ctx, configurationsDynamic)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	if !isWhollyKnown(ctx, configurations) {
+		// The result is left unknown until the configuration is known.
+		return
+	}
+
+	model, diags := configurationsFromObject(ctx, configurations)
+	if resp.Error = function.FuncErrorFromDiags(ctx, diags); resp.Error != nil {
+		return
+	}
+
+	token, ok := lookupLocation(model.Locations, location)
+	if !ok {
+		resp.Error = function.NewArgumentFuncError(1, fmt.Sprintf("location '%s' not found in provided locations map", location))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, token))
+}
+
+type LocationLongFunction struct{}
+
+func NewLocationLongFunction() function.Function {
+	return &LocationLongFunction{}
+}
+
+func (f *LocationLongFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "location_long"
+}
+
+func (f *LocationLongFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Return the location of a short token",
+		Description: "Return the location of a short token, e.g. westeurope for we, from the locations map of the configuration.",
+		MarkdownDescription: "Return the location of a short token, e.g. `westeurope` for `we`, from the `locations` map of the " +
+			"configuration. If several locations share the token, the first in alphabetical order is returned, like in the " +
+			"`inverted` map of `standesamt_locations`. The function fails if no location has the token.",
+		Parameters: []function.Parameter{
+			configurationsParameter(),
+			function.StringParameter{
+				Name:        "token",
+				Description: "The short token of the location, e.g. \"we\".",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *LocationLongFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var (
+		configurationsDynamic types.Dynamic
+		token                 string
+	)
+
+	if resp.Error = req.Arguments.Get(ctx, &configurationsDynamic, &token); resp.Error != nil {
+		return
+	}
+
+	configurations, err := configurationsArgument(ctx, configurationsDynamic)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	if !isWhollyKnown(ctx, configurations) {
+		// The result is left unknown until the configuration is known.
+		return
+	}
+
+	model, diags := configurationsFromObject(ctx, configurations)
+	if resp.Error = function.FuncErrorFromDiags(ctx, diags); resp.Error != nil {
+		return
+	}
+
+	locations := make(map[string]string, len(model.Locations))
+	for k, v := range model.Locations {
+		locations[k] = v.ValueString()
+	}
+	location, ok := invertLocations(locations)[token]
+	if !ok {
+		resp.Error = function.NewArgumentFuncError(1, fmt.Sprintf("no location with token '%s' in provided locations map", token))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, location))
+}
+
+// invertLocations maps the tokens of locations to their location. Tokens
+// shared by several locations map to the first location in alphabetical
+// order, so the result does not depend on the map order.
+func invertLocations(locations map[string]string) map[string]string {
+	inverted := make(map[string]string, len(locations))
+	for _, k := range slices.Sorted(maps.Keys(locations)) {
+		if _, ok := inverted[locations[k]]; !ok {
+			inverted[locations[k]] = k
+		}
+	}
+	return inverted
+}
@@ -0,0 +1,66 @@
+// Copyright glueckkanja AG 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInvertLocations(t *testing.T) {
+	inverted := invertLocations(map[string]string{
+		"westeurope":  "we",
+		"West Europe": "we",
+		"northeurope": "ne",
+	})
+	assert.Equal(t, map[string]string{"we": "West Europe", "ne": "northeurope"}, inverted)
+	assert.Empty(t, invertLocations(nil))
+}
+
+func TestLocationFunction_Lookup(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactoriesUnique(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf("%s %s", default_config_with_no_settings_default_precedence, `
+				output "short" {
+					value = provider::standesamt::location_short(local.config, "West Europe")
+				}
+				output "long" {
+					value = provider::standesamt::location_long(local.config, "we")
+				}`),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("short", knownvalue.StringExact("we")),
+					statecheck.ExpectKnownOutputValue("long", knownvalue.StringExact("westeurope")),
+				},
+			},
+		},
+	})
+}
+
+func TestLocationFunction_NotFound(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactoriesUnique(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf("%s %s", default_config_with_no_settings_default_precedence, `output "test" {
+					value = provider::standesamt::location_short(local.config, "eastus")
+				}`),
+				ExpectError: regexp.MustCompile(`location 'eastus' not found in provided locations map`),
+			},
+			{
+				Config: fmt.Sprintf("%s %s", default_config_with_no_settings_default_precedence, `output "test" {
+					value = provider::standesamt::location_long(local.config, "eus")
+				}`),
+				ExpectError: regexp.MustCompile(`no location with token 'eus' in provided locations map`),
+			},
+		},
+	})
+}
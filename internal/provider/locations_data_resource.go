@@ -6,11 +6,13 @@ package provider
 import (
 	"context"
 	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"io/fs"
 	s "terraform-provider-standesamt/internal/schema"
 )
 
@@ -18,7 +20,12 @@ import (
 var _ datasource.DataSource = &LocationDataSource{}
 
 type locationDataSourceModel struct {
-	Locations types.Map `tfsdk:"locations"`
+	Source          types.String `tfsdk:"source"`
+	Locations       types.Map    `tfsdk:"locations"`
+	Inverted        types.Map    `tfsdk:"inverted"`
+	DisplayNames    types.Map    `tfsdk:"display_names"`
+	Paired          types.Map    `tfsdk:"paired"`
+	GeographyGroups types.Map    `tfsdk:"geography_groups"`
 }
 
 func NewLocationDataSource() datasource.DataSource {
@@ -27,7 +34,7 @@ func NewLocationDataSource() datasource.DataSource {
 
 // SchemaDataSource defines the data source implementation.
 type LocationDataSource struct {
-	sourceRef fs.FS
+	providerConfig *ProviderConfig
 }
 
 func (d *LocationDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -38,12 +45,45 @@ func (d *LocationDataSource) Schema(_ context.Context, _ datasource.SchemaReques
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Data source to build a map of the locations schema file.",
 		Attributes: map[string]schema.Attribute{
+			"source": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "Where the locations come from, one of the values of the location_source of the provider, which is used if not set. Allows to read the locations of several sources side by side, e.g. to compare them during a migration. The locations of the provider are applied to every source.",
+				MarkdownDescription: "Where the locations come from, one of the values of the `location_source` of the provider, which is used if not set. Allows to read the locations of several sources side by side, e.g. to compare them during a migration. The `locations` of the provider are applied to every source.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(append([]string{locationSourceLibrary}, s.LocationCatalogNames()...)...),
+				},
+			},
 			"locations": schema.MapAttribute{
 				Description:         "You can use this map to pass to the name function and use the location in the name.",
 				MarkdownDescription: "You can use this map to pass to the name function and use the location in the name.",
 				Computed:            true,
 				ElementType:         types.StringType,
 			},
+			"inverted": schema.MapAttribute{
+				Description:         "Map of location tokens to location names, the inverse of locations, e.g. we = westeurope. If several locations share a token, the first in alphabetical order is used.",
+				MarkdownDescription: "Map of location tokens to location names, the inverse of `locations`, e.g. `we = \"westeurope\"`. If several locations share a token, the first in alphabetical order is used.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"display_names": schema.MapAttribute{
+				Description:         "Map of location names to human-readable labels, e.g. westeurope = West Europe. Only contains locations the schema library provides details for.",
+				MarkdownDescription: "Map of location names to human-readable labels, e.g. `westeurope = \"West Europe\"`. Only contains locations the schema library provides `details` for.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"paired": schema.MapAttribute{
+				Description:         "Map of location names to the name of their paired location. Only contains locations the schema library provides a pair for.",
+				MarkdownDescription: "Map of location names to the name of their paired location. Only contains locations the schema library provides a pair for.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"geography_groups": schema.MapAttribute{
+				Description:         "Map of location names to their geography group, e.g. Europe. Only contains locations the schema library provides a geography group for.",
+				MarkdownDescription: "Map of location names to their geography group, e.g. `Europe`. Only contains locations the schema library provides a geography group for.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
 		},
 	}
 }
@@ -63,7 +103,7 @@ func (d *LocationDataSource) Configure(_ context.Context, req datasource.Configu
 		return
 	}
 
-	d.sourceRef = data.SourceRef
+	d.providerConfig = data
 }
 
 func (d *LocationDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
@@ -75,20 +115,56 @@ func (d *LocationDataSource) Read(ctx context.Context, req datasource.ReadReques
 		return
 	}
 
-	result := s.Result{}
-	process := s.NewProcessorClient(d.sourceRef)
-	if err := process.Process(&result); err != nil {
+	result, err := d.providerConfig.Result()
+	if err != nil {
 		resp.Diagnostics.AddError("source_reference", err.Error())
 		return
 	}
 
+	if model.Source.IsNull() {
+		model.Source = types.StringValue(d.providerConfig.LocationSource)
+	}
+	sourceLocations, err := d.providerConfig.LocationsFromSource(model.Source.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("source"), "Invalid location source", err.Error())
+		return
+	}
+
 	locations := make(map[string]attr.Value)
 
-	for k, v := range result.Locations {
+	for k, v := range sourceLocations {
 		locations[k] = types.StringValue(v)
 	}
 
 	model.Locations = types.MapValueMust(types.StringType, locations)
 
+	inverted := make(map[string]attr.Value)
+	for k, v := range invertLocations(sourceLocations) {
+		inverted[k] = types.StringValue(v)
+	}
+	model.Inverted = types.MapValueMust(types.StringType, inverted)
+
+	// Details of locations the provider configuration removed are left out.
+	displayNames := make(map[string]attr.Value)
+	paired := make(map[string]attr.Value)
+	geographyGroups := make(map[string]attr.Value)
+	for k, v := range result.LocationDetails {
+		if _, ok := sourceLocations[k]; !ok {
+			continue
+		}
+		if v.DisplayName != "" {
+			displayNames[k] = types.StringValue(v.DisplayName)
+		}
+		if v.Paired != "" {
+			paired[k] = types.StringValue(v.Paired)
+		}
+		if v.GeographyGroup != "" {
+			geographyGroups[k] = types.StringValue(v.GeographyGroup)
+		}
+	}
+	model.DisplayNames = types.MapValueMust(types.StringType, displayNames)
+	model.Paired = types.MapValueMust(types.StringType, paired)
+	model.GeographyGroups = types.MapValueMust(types.StringType, geographyGroups)
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
 }
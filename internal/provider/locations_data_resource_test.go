@@ -0,0 +1,46 @@
+// Copyright glueckkanja AG 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccStandesamtLocations(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactoriesWithLibrary(testLibrary),
+		ExternalProviders:        map[string]resource.ExternalProvider{},
+		Steps: []resource.TestStep{
+			{
+				Config: `data "standesamt_locations" "test" {}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.standesamt_locations.test", "locations.westeurope", "weu"),
+					resource.TestCheckResourceAttr("data.standesamt_locations.test", "display_names.westeurope", "West Europe"),
+					resource.TestCheckResourceAttr("data.standesamt_locations.test", "paired.westeurope", "northeurope"),
+					resource.TestCheckResourceAttr("data.standesamt_locations.test", "geography_groups.westeurope", "Europe"),
+					resource.TestCheckResourceAttr("data.standesamt_locations.test", "source", "library"),
+					resource.TestCheckResourceAttr("data.standesamt_locations.test", "inverted.weu", "westeurope"),
+				),
+			},
+			{
+				Config: `
+data "standesamt_locations" "library" {}
+
+data "standesamt_locations" "aws" {
+  source = "aws"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.standesamt_locations.library", "locations.westeurope", "weu"),
+					resource.TestCheckNoResourceAttr("data.standesamt_locations.library", "locations.eu-central-1"),
+					resource.TestCheckResourceAttr("data.standesamt_locations.aws", "locations.eu-central-1", "euc1"),
+					resource.TestCheckNoResourceAttr("data.standesamt_locations.aws", "locations.westeurope"),
+				),
+			},
+		},
+	})
+}
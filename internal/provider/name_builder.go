@@ -5,12 +5,21 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"hash/fnv"
+	"maps"
+	"math"
+	"math/big"
 	"regexp"
+	"slices"
+	"strconv"
 	"strings"
+	"sync"
 	"terraform-provider-standesamt/internal/random"
 	s "terraform-provider-standesamt/internal/schema"
 	"terraform-provider-standesamt/internal/tools"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -27,50 +36,281 @@ type nameBuilder struct {
 	typeSchema        *s.NamingSchema
 	buildNameSettings *s.BuildNameSettingsModel
 	result            *buildNameResultModel
+	segments          []nameSegment
+	truncated         bool
+
+	// warnings are the violations of a non-strict name and the names that
+	// differ under the compatibility library in warn mode. Functions can only
+	// log them, the name resources and data sources report them as diagnostics.
+	warnings []string
+
+	// sources records the layer every merged setting was taken from.
+	sources map[string]settingLayer
 }
 
-// extractStringSlice extracts a string slice from a types.List or types.Tuple
+// extractStringSlice extracts a string slice from a types.List or types.Tuple.
+// Numbers and bools are converted to their string representation, elements of
+// other types are skipped. Use coerceStringSlice to report them instead.
 func extractStringSlice(value attr.Value) []string {
-	var result []string
+	result, _ := coerceStringSlice(value)
+	return result
+}
+
+// coerceStringSlice extracts a string slice from a types.List or types.Tuple.
+// Numbers and bools are converted to their string representation, e.g. 1 to "1",
+// so HCL literals like [1, "a"] do not silently lose elements. Null and unknown
+// elements are skipped, elements of any other type return an error.
+func coerceStringSlice(value attr.Value) ([]string, error) {
+	var elements []attr.Value
 
 	switch v := value.(type) {
 	case types.List:
 		if v.IsNull() || v.IsUnknown() {
-			return nil
+			return nil, nil
+		}
+		elements = v.Elements()
+	case types.Tuple:
+		if v.IsNull() || v.IsUnknown() {
+			return nil, nil
+		}
+		elements = v.Elements()
+	default:
+		return nil, nil
+	}
+
+	var result []string
+	for i, elem := range elements {
+		if elem.IsNull() || elem.IsUnknown() {
+			continue
+		}
+		switch e := elem.(type) {
+		case types.String:
+			result = append(result, e.ValueString())
+		case types.Number:
+			result = append(result, e.ValueBigFloat().Text('f', -1))
+		case types.Int64:
+			result = append(result, strconv.FormatInt(e.ValueInt64(), 10))
+		case types.Bool:
+			result = append(result, strconv.FormatBool(e.ValueBool()))
+		default:
+			return result, fmt.Errorf("element %d must be a string, number or bool", i)
 		}
-		for _, elem := range v.Elements() {
-			if str, ok := elem.(types.String); ok && !str.IsNull() && !str.IsUnknown() {
-				result = append(result, str.ValueString())
+	}
+
+	return result, nil
+}
+
+// settingKind is the expected value type of a settings key.
+type settingKind string
+
+const (
+	settingKindString settingKind = "string"
+	settingKindNumber settingKind = "number"
+	settingKindBool   settingKind = "bool"
+	settingKindList   settingKind = "list"
+)
+
+// nameSettingsKeys are the settings keys of the naming functions.
+var nameSettingsKeys = map[string]settingKind{
+	"convention":            settingKindString,
+	"environment":           settingKindString,
+	"location":              settingKindString,
+	"location_short":        settingKindString,
+	"workspace":             settingKindString,
+	"stack":                 settingKindString,
+	"static_date":           settingKindString,
+	"date_format":           settingKindString,
+	"separator_conflict":    settingKindString,
+	"separator_replacement": settingKindString,
+	"separator":             settingKindString,
+	"hash_length":           settingKindNumber,
+	"random_seed":           settingKindNumber,
+	"seed_key":              settingKindString,
+	"collision_domain":      settingKindString,
+	"lowercase":             settingKindBool,
+	"uppercase":             settingKindBool,
+	"strict":                settingKindBool,
+	"reserved_words_check":  settingKindBool,
+	"sensitive":             settingKindBool,
+	"prefixes":              settingKindList,
+	"suffixes":              settingKindList,
+	"name_precedence":       settingKindList,
+	"use_separator":         settingKindBool,
+	"hash_mode":             settingKindString,
+	"hash_charset":          settingKindString,
+	"truncate_keep_hash":    settingKindBool,
+	"collapse_separators":   settingKindBool,
+	"post_process":          settingKindList,
+	"preset":                settingKindString,
+	"transliterate":         settingKindString,
+	"prefix_set":            settingKindString,
+	"suffix_set":            settingKindString,
+	"min_length":            settingKindNumber,
+	"max_length":            settingKindNumber,
+	"min_unique_suffix":     settingKindNumber,
+	"deny_double_hyphens":   settingKindBool,
+	"validation_regex":      settingKindString,
+}
+
+// checkSettingsKeys reports settings keys that are not in allowed and values
+// that do not match the expected type, so typos like `seperator` fail instead
+// of being ignored. Null and unknown values are not type checked.
+func checkSettingsKeys(attrs map[string]attr.Value, allowed map[string]settingKind) error {
+	keys := make([]string, 0, len(attrs))
+	for k := range attrs {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+
+	for _, k := range keys {
+		kind, ok := allowed[k]
+		if !ok {
+			allowedKeys := make([]string, 0, len(allowed))
+			for a := range allowed {
+				allowedKeys = append(allowedKeys, a)
 			}
+			slices.Sort(allowedKeys)
+			return fmt.Errorf("unknown setting '%s', expected one of: %s", k, strings.Join(allowedKeys, ", "))
 		}
-	case types.Tuple:
+
+		v := attrs[k]
 		if v.IsNull() || v.IsUnknown() {
-			return nil
+			continue
 		}
-		for _, elem := range v.Elements() {
-			if str, ok := elem.(types.String); ok && !str.IsNull() && !str.IsUnknown() {
-				result = append(result, str.ValueString())
+
+		var valid bool
+		switch kind {
+		case settingKindString:
+			_, valid = v.(types.String)
+		case settingKindBool:
+			_, valid = v.(types.Bool)
+		case settingKindNumber:
+			switch v.(type) {
+			case types.Number, types.Int64, types.Int32:
+				valid = true
 			}
+		case settingKindList:
+			switch v.(type) {
+			case types.List, types.Tuple:
+				valid = true
+			}
+		}
+		if !valid {
+			return fmt.Errorf("setting '%s' must be a %s", k, kind)
 		}
 	}
 
-	return result
+	return nil
 }
 
-// parseSettingsFromDynamic extracts settings from a dynamic parameter without JSON
-func parseSettingsFromDynamic(settingsDynamic types.Dynamic) (*s.BuildNameSettingsModel, error) {
-	settings := &s.BuildNameSettingsModel{}
-
+// settingsAttributes returns the attributes of the dynamic settings parameter
+// after checking them against allowed. Besides objects, maps are accepted so
+// settings can be composed from variables of type map(string). String values of
+// a map are converted to the type of the key: numbers and bools are parsed and
+// lists are split at commas, e.g. "app,core". Null settings return no attributes.
+func settingsAttributes(settingsDynamic types.Dynamic, allowed map[string]settingKind) (map[string]attr.Value, error) {
 	if settingsDynamic.IsNull() || settingsDynamic.IsUnderlyingValueNull() {
-		return settings, nil
+		return nil, nil
 	}
 
-	obj, ok := settingsDynamic.UnderlyingValue().(types.Object)
-	if !ok {
-		return nil, fmt.Errorf("settings must be an object")
+	var attrs map[string]attr.Value
+	switch v := settingsDynamic.UnderlyingValue().(type) {
+	case types.Object:
+		attrs = v.Attributes()
+	case types.Map:
+		attrs = make(map[string]attr.Value, len(v.Elements()))
+		for k, elem := range v.Elements() {
+			value, err := coerceSettingString(k, elem, allowed[k])
+			if err != nil {
+				return nil, err
+			}
+			attrs[k] = value
+		}
+	default:
+		return nil, fmt.Errorf("settings must be an object or a map")
+	}
+
+	if err := checkSettingsKeys(attrs, allowed); err != nil {
+		return nil, err
+	}
+
+	return attrs, nil
+}
+
+// coerceSettingString converts a string value of a settings map to kind. Values
+// of other types and unknown keys are returned unchanged for checkSettingsKeys.
+func coerceSettingString(key string, value attr.Value, kind settingKind) (attr.Value, error) {
+	str, ok := value.(types.String)
+	if !ok || str.IsNull() || str.IsUnknown() {
+		return value, nil
+	}
+
+	switch kind {
+	case settingKindNumber:
+		f, _, err := big.ParseFloat(strings.TrimSpace(str.ValueString()), 10, 512, big.ToNearestEven)
+		if err != nil {
+			return nil, fmt.Errorf("setting '%s' must be a number", key)
+		}
+		return types.NumberValue(f), nil
+	case settingKindBool:
+		b, err := strconv.ParseBool(strings.TrimSpace(str.ValueString()))
+		if err != nil {
+			return nil, fmt.Errorf("setting '%s' must be a bool", key)
+		}
+		return types.BoolValue(b), nil
+	case settingKindList:
+		elements := make([]attr.Value, 0)
+		for _, part := range strings.Split(str.ValueString(), ",") {
+			if part = strings.TrimSpace(part); part != "" {
+				elements = append(elements, types.StringValue(part))
+			}
+		}
+		return types.ListValueMust(types.StringType, elements), nil
+	}
+
+	return value, nil
+}
+
+// maxHashLength is the maximum length of the hash segment.
+const maxHashLength = 64
+
+// settingInt returns the value of a number setting, which may be a types.Int32,
+// types.Int64 or types.Number. Fractions and values outside of minimum and
+// maximum are rejected instead of being truncated. Missing, null and unknown
+// settings return false.
+func settingInt(attrs map[string]attr.Value, key string, minimum, maximum int64) (int64, bool, error) {
+	v, ok := attrs[key]
+	if !ok || v.IsNull() || v.IsUnknown() {
+		return 0, false, nil
+	}
+
+	var value *big.Float
+	switch n := v.(type) {
+	case types.Int32:
+		value = new(big.Float).SetInt64(int64(n.ValueInt32()))
+	case types.Int64:
+		value = new(big.Float).SetInt64(n.ValueInt64())
+	case types.Number:
+		value = n.ValueBigFloat()
+	default:
+		return 0, false, nil
+	}
+
+	i, accuracy := value.Int64()
+	if !value.IsInt() || accuracy != big.Exact || i < minimum || i > maximum {
+		return 0, false, fmt.Errorf("setting '%s' must be a whole number between %d and %d, got %s", key, minimum, maximum, value.Text('g', -1))
 	}
+	return i, true, nil
+}
+
+// parseSettingsFromDynamic extracts settings from a dynamic parameter without JSON
+func parseSettingsFromDynamic(settingsDynamic types.Dynamic) (*s.BuildNameSettingsModel, error) {
+	settings := &s.BuildNameSettingsModel{}
 
-	attrs := obj.Attributes()
+	attrs, err := settingsAttributes(settingsDynamic, nameSettingsKeys)
+	if err != nil {
+		return nil, err
+	}
 
 	// Extract each attribute with null/unknown checks
 	if v, ok := attrs["convention"].(types.String); ok && !v.IsNull() && !v.IsUnknown() {
@@ -81,6 +321,26 @@ func parseSettingsFromDynamic(settingsDynamic types.Dynamic) (*s.BuildNameSettin
 		settings.Location = v.ValueString()
 	}
 
+	if v, ok := attrs["location_short"].(types.String); ok && !v.IsNull() && !v.IsUnknown() {
+		settings.LocationShort = v.ValueString()
+	}
+
+	if v, ok := attrs["workspace"].(types.String); ok && !v.IsNull() && !v.IsUnknown() {
+		settings.Workspace = v.ValueString()
+	}
+
+	if v, ok := attrs["stack"].(types.String); ok && !v.IsNull() && !v.IsUnknown() {
+		settings.Stack = v.ValueString()
+	}
+
+	if v, ok := attrs["static_date"].(types.String); ok && !v.IsNull() && !v.IsUnknown() {
+		settings.StaticDate = v.ValueString()
+	}
+
+	if v, ok := attrs["date_format"].(types.String); ok && !v.IsNull() && !v.IsUnknown() {
+		settings.DateFormat = v.ValueString()
+	}
+
 	if v, ok := attrs["environment"].(types.String); ok && !v.IsNull() && !v.IsUnknown() {
 		settings.Environment = v.ValueString()
 	}
@@ -89,22 +349,37 @@ func parseSettingsFromDynamic(settingsDynamic types.Dynamic) (*s.BuildNameSettin
 		settings.Separator = v.ValueString()
 	}
 
-	// Handle hash_length - can be types.Int32, types.Int64, or types.Number
-	if v, ok := attrs["hash_length"].(types.Int32); ok && !v.IsNull() && !v.IsUnknown() {
-		settings.HashLength = v.ValueInt32()
-	} else if v, ok := attrs["hash_length"].(types.Int64); ok && !v.IsNull() && !v.IsUnknown() {
-		settings.HashLength = int32(v.ValueInt64())
-	} else if v, ok := attrs["hash_length"].(types.Number); ok && !v.IsNull() && !v.IsUnknown() {
-		val, _ := v.ValueBigFloat().Int64()
-		settings.HashLength = int32(val)
+	if v, ok, err := settingInt(attrs, "hash_length", 0, maxHashLength); err != nil {
+		return nil, err
+	} else if ok {
+		settings.HashLength = int32(v)
+	}
+
+	// The settings are parsed in order, so an invalid call always reports the
+	// same error.
+	for _, setting := range []struct {
+		key    string
+		target *int
+	}{
+		{"min_length", &settings.MinLength},
+		{"max_length", &settings.MaxLength},
+		{"min_unique_suffix", &settings.MinUniqueSuffix},
+	} {
+		if v, ok, err := settingInt(attrs, setting.key, 0, math.MaxInt32); err != nil {
+			return nil, err
+		} else if ok {
+			*setting.target = int(v)
+		}
 	}
 
-	// Handle random_seed - can be types.Int64 or types.Number
-	if v, ok := attrs["random_seed"].(types.Int64); ok && !v.IsNull() && !v.IsUnknown() {
-		settings.RandomSeed = v.ValueInt64()
-	} else if v, ok := attrs["random_seed"].(types.Number); ok && !v.IsNull() && !v.IsUnknown() {
-		val, _ := v.ValueBigFloat().Int64()
-		settings.RandomSeed = val
+	// A seed of 0 would silently fall back to the seed of the configuration.
+	if v, ok, err := settingInt(attrs, "random_seed", math.MinInt64, math.MaxInt64); err != nil {
+		return nil, err
+	} else if ok {
+		if v == 0 {
+			return nil, fmt.Errorf("setting 'random_seed' must not be 0")
+		}
+		settings.RandomSeed = v
 	}
 
 	if v, ok := attrs["lowercase"].(types.Bool); ok && !v.IsNull() && !v.IsUnknown() {
@@ -115,22 +390,146 @@ func parseSettingsFromDynamic(settingsDynamic types.Dynamic) (*s.BuildNameSettin
 		settings.Uppercase = v.ValueBool()
 	}
 
+	if v, ok := attrs["reserved_words_check"].(types.Bool); ok && !v.IsNull() && !v.IsUnknown() {
+		settings.ReservedWordsCheck = v.ValueBool()
+	}
+
+	if v, ok := attrs["sensitive"].(types.Bool); ok && !v.IsNull() && !v.IsUnknown() {
+		settings.Sensitive = v.ValueBool()
+	}
+
+	if v, ok := attrs["deny_double_hyphens"].(types.Bool); ok && !v.IsNull() && !v.IsUnknown() {
+		settings.DenyDoubleHyphens = v.ValueBool()
+	}
+
+	if v, ok := attrs["validation_regex"].(types.String); ok && !v.IsNull() && !v.IsUnknown() {
+		if _, err := compileValidationRegex(v.ValueString()); err != nil {
+			return nil, fmt.Errorf("setting 'validation_regex': %w", err)
+		}
+		settings.ValidationRegex = v.ValueString()
+	}
+
+	if v, ok := attrs["strict"].(types.Bool); ok && !v.IsNull() && !v.IsUnknown() {
+		strict := v.ValueBool()
+		settings.Strict = &strict
+	}
+
 	// Handle list/tuple attributes - HCL uses tuples for literal lists
 	if v, ok := attrs["prefixes"]; ok {
-		settings.Prefixes = extractStringSlice(v)
+		prefixes, err := coerceStringSlice(v)
+		if err != nil {
+			return nil, fmt.Errorf("prefixes: %w", err)
+		}
+		settings.Prefixes = prefixes
 	}
 
 	if v, ok := attrs["suffixes"]; ok {
-		settings.Suffixes = extractStringSlice(v)
+		suffixes, err := coerceStringSlice(v)
+		if err != nil {
+			return nil, fmt.Errorf("suffixes: %w", err)
+		}
+		settings.Suffixes = suffixes
 	}
 
 	if v, ok := attrs["name_precedence"]; ok {
-		settings.NamePrecedence = extractStringSlice(v)
+		namePrecedence, err := coerceStringSlice(v)
+		if err != nil {
+			return nil, fmt.Errorf("name_precedence: %w", err)
+		}
+		if err := s.ValidateNamePrecedence(namePrecedence); err != nil {
+			return nil, fmt.Errorf("name_precedence: %w", err)
+		}
+		settings.NamePrecedence = namePrecedence
+	}
+
+	if v, ok := attrs["use_separator"].(types.Bool); ok && !v.IsNull() && !v.IsUnknown() {
+		useSeparator := v.ValueBool()
+		settings.UseSeparator = &useSeparator
+	}
+
+	if v, ok := attrs["seed_key"].(types.String); ok && !v.IsNull() && !v.IsUnknown() {
+		settings.SeedKey = v.ValueString()
+	}
+
+	if v, ok := attrs["collision_domain"].(types.String); ok && !v.IsNull() && !v.IsUnknown() {
+		settings.CollisionDomain = v.ValueString()
+	}
+
+	if v, ok := attrs["hash_mode"].(types.String); ok && !v.IsNull() && !v.IsUnknown() {
+		settings.HashMode = v.ValueString()
+	}
+
+	if v, ok := attrs["hash_charset"].(types.String); ok && !v.IsNull() && !v.IsUnknown() {
+		settings.HashCharset = v.ValueString()
+	}
+
+	if v, ok := attrs["truncate_keep_hash"].(types.Bool); ok && !v.IsNull() && !v.IsUnknown() {
+		settings.TruncateKeepHash = v.ValueBool()
+	}
+
+	if v, ok := attrs["collapse_separators"].(types.Bool); ok && !v.IsNull() && !v.IsUnknown() {
+		settings.CollapseSeparators = v.ValueBool()
+	}
+
+	if v, ok := attrs["post_process"]; ok {
+		postProcess, err := coerceStringSlice(v)
+		if err != nil {
+			return nil, fmt.Errorf("post_process: %w", err)
+		}
+		if err := validatePostProcess(postProcess); err != nil {
+			return nil, err
+		}
+		settings.PostProcess = postProcess
+	}
+
+	if v, ok := attrs["preset"].(types.String); ok && !v.IsNull() && !v.IsUnknown() {
+		settings.Preset = v.ValueString()
+	}
+
+	if v, ok := attrs["transliterate"].(types.String); ok && !v.IsNull() && !v.IsUnknown() {
+		settings.Transliterate = v.ValueString()
+	}
+
+	if v, ok := attrs["prefix_set"].(types.String); ok && !v.IsNull() && !v.IsUnknown() {
+		settings.PrefixSet = v.ValueString()
+	}
+
+	if v, ok := attrs["suffix_set"].(types.String); ok && !v.IsNull() && !v.IsUnknown() {
+		settings.SuffixSet = v.ValueString()
+	}
+
+	if v, ok := attrs["separator_conflict"].(types.String); ok && !v.IsNull() && !v.IsUnknown() {
+		settings.SeparatorConflict = v.ValueString()
+	}
+
+	if v, ok := attrs["separator_replacement"].(types.String); ok && !v.IsNull() && !v.IsUnknown() {
+		settings.SeparatorReplacement = v.ValueString()
+	}
+
+	if err := applyPreset(settings, attrs); err != nil {
+		return nil, err
+	}
+
+	if err := validateSeparatorConflict(settings.SeparatorConflict); err != nil {
+		return nil, err
+	}
+
+	if err := validateHashSettings(settings); err != nil {
+		return nil, err
+	}
+
+	if err := validateTransliterate(settings.Transliterate); err != nil {
+		return nil, err
 	}
 
 	return settings, nil
 }
 
+// schemaFilterHint is appended to unknown resource type errors. Functions cannot read
+// the provider configuration, so a schema map reduced by the data source cannot be
+// completed by the function itself.
+const schemaFilterHint = "If the schema is filtered with `resource_types` or `include_schema` on the standesamt_config data source, make sure the resource type is included."
+
 // parseArguments extracts and validates the function arguments
 func parseArguments(
 	ctx context.Context,
@@ -138,40 +537,215 @@ func parseArguments(
 	resp *function.RunResponse,
 ) (*configurationsModel, string, *s.BuildNameSettingsModel, types.String, *s.NamingSchema, error) {
 	var (
-		model             = configurationsModel{}
-		name              types.String
-		nameType          string
-		configurations    types.Object
-		settingsDynamic   types.Dynamic
-		buildNameSettings s.BuildNameSettingsModel
-		typeSchema        s.NamingSchema
+		name                  types.String
+		nameType              string
+		configurationsDynamic types.Dynamic
+		settingsDynamic       types.Dynamic
 	)
 
-	if resp.Error = req.Arguments.Get(ctx, &configurations, &nameType, &settingsDynamic, &name); resp.Error != nil {
-		return nil, "", nil, types.String{}, nil, fmt.Errorf("failed to get arguments: %s", resp.Error.Error())
+	// The arguments are read one by one, as functions may define further
+	// parameters after the name, e.g. the mode of validate.
+	for i, target := range []any{&configurationsDynamic, &nameType, &settingsDynamic, &name} {
+		if resp.Error = req.Arguments.GetArgument(ctx, i, target); resp.Error != nil {
+			return nil, "", nil, types.String{}, nil, fmt.Errorf("failed to get arguments: %s", resp.Error.Error())
+		}
 	}
 
-	diags := configurations.As(ctx, &model, basetypes.ObjectAsOptions{})
-	resp.Error = function.ConcatFuncErrors(resp.Error, function.FuncErrorFromDiags(ctx, diags))
-	if resp.Error != nil {
-		return nil, "", nil, types.String{}, nil, fmt.Errorf("failed to parse configurations: %s", resp.Error.Error())
+	configurations, err := configurationsArgument(ctx, configurationsDynamic)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return nil, "", nil, types.String{}, nil, err
 	}
 
-	// Find the schema for the requested name type
-	schemaFound := false
-	for k, o := range model.Schema {
-		if k == nameType {
-			diagnose := o.As(ctx, &typeSchema, basetypes.ObjectAsOptions{})
-			resp.Error = function.ConcatFuncErrors(resp.Error, function.FuncErrorFromDiags(ctx, diagnose))
-			if resp.Error != nil {
-				return nil, "", nil, types.String{}, nil, fmt.Errorf("failed to parse schema for type '%s': %s", nameType, resp.Error.Error())
+	if name.IsUnknown() {
+		tflog.Debug(ctx, "name is unknown, returning unknown result")
+		return nil, "", nil, types.String{}, nil, errUnknownArguments
+	}
+
+	model, buildNameSettings, typeSchema, err := parseConfigurations(ctx, configurations, nameType, settingsDynamic, resp)
+	if err != nil {
+		return nil, "", nil, types.String{}, nil, err
+	}
+
+	warnDeprecated(ctx, nameType, typeSchema)
+
+	return model, nameType, buildNameSettings, name, typeSchema, nil
+}
+
+// applyLengthSettings tightens the length limits of the naming schema with the
+// min_length and max_length settings. The settings cannot loosen the limits.
+func applyLengthSettings(typeSchema *s.NamingSchema, settings *s.BuildNameSettingsModel) error {
+	if settings.MinLength == 0 && settings.MaxLength == 0 {
+		return nil
+	}
+
+	override := s.LengthOverride{MinLength: settings.MinLength, MaxLength: settings.MaxLength}
+	minLength, maxLength, err := override.Apply(int(typeSchema.MinLength.ValueInt64()), int(typeSchema.MaxLength.ValueInt64()))
+	if err != nil {
+		return fmt.Errorf("settings: %w", err)
+	}
+	typeSchema.MinLength = types.Int64Value(int64(minLength))
+	typeSchema.MaxLength = types.Int64Value(int64(maxLength))
+	return nil
+}
+
+// applyValidationSettings denies double hyphens in the naming schema if the
+// deny_double_hyphens setting is set. Like the length settings it cannot allow
+// double hyphens the schema denies.
+func applyValidationSettings(typeSchema *s.NamingSchema, settings *s.BuildNameSettingsModel) {
+	if settings.DenyDoubleHyphens {
+		typeSchema.Configuration.DenyDoubleHyphens = types.BoolValue(true)
+	}
+}
+
+// deprecationMessage returns the migration hint for a deprecated resource type
+// or an empty string if the resource type is not deprecated.
+func deprecationMessage(nameType string, typeSchema *s.NamingSchema) string {
+	if !typeSchema.Deprecated.ValueBool() {
+		return ""
+	}
+	if replacedBy := typeSchema.ReplacedBy.ValueString(); replacedBy != "" {
+		return fmt.Sprintf("resource type '%s' is deprecated, use '%s' instead", nameType, replacedBy)
+	}
+	return fmt.Sprintf("resource type '%s' is deprecated", nameType)
+}
+
+// warnDeprecated logs a warning when a deprecated resource type is used, as
+// functions cannot return warning diagnostics. The name resources and the
+// standesamt_naming_schema data source report it as a diagnostic as well.
+func warnDeprecated(ctx context.Context, nameType string, typeSchema *s.NamingSchema) {
+	if message := deprecationMessage(nameType, typeSchema); message != "" {
+		tflog.Warn(ctx, message, map[string]interface{}{"name_type": nameType})
+	}
+}
+
+// errUnknownArguments is returned by parseArguments and parseConfigurations when an
+// argument is not known yet, e.g. computed from another resource during plan. The
+// function result is left unknown, which is the default of the framework, so the
+// name is computed once all values are known instead of failing or dropping segments.
+var errUnknownArguments = errors.New("arguments contain unknown values")
+
+// isWhollyKnown reports whether the value and all nested values are known. The
+// framework values are walked directly, as converting the configurations object
+// with its schema map into a terraform value dominated the function run time.
+func isWhollyKnown(ctx context.Context, value attr.Value) bool {
+	if value == nil || value.IsNull() {
+		return true
+	}
+	if value.IsUnknown() {
+		return false
+	}
+
+	var elements []attr.Value
+	switch v := value.(type) {
+	case types.Object:
+		for _, e := range v.Attributes() {
+			elements = append(elements, e)
+		}
+	case types.Map:
+		for _, e := range v.Elements() {
+			elements = append(elements, e)
+		}
+	case types.List:
+		elements = v.Elements()
+	case types.Set:
+		elements = v.Elements()
+	case types.Tuple:
+		elements = v.Elements()
+	case types.Dynamic:
+		return isWhollyKnown(ctx, v.UnderlyingValue())
+	case types.String, types.Bool, types.Number, types.Int32, types.Int64, types.Float32, types.Float64:
+		return true
+	default:
+		tfValue, err := value.ToTerraformValue(ctx)
+		if err != nil {
+			return false
+		}
+		return tfValue.IsFullyKnown()
+	}
+
+	for _, e := range elements {
+		if !isWhollyKnown(ctx, e) {
+			return false
+		}
+	}
+	return true
+}
+
+// configurationsFromObject converts the configurations argument into its model.
+// The schema map is taken over as is instead of converted by reflection, as only
+// the naming schema of the requested resource type is parsed.
+func configurationsFromObject(ctx context.Context, configurations types.Object) (configurationsModel, diag.Diagnostics) {
+	var (
+		model configurationsModel
+		diags diag.Diagnostics
+	)
+	attrs := configurations.Attributes()
+
+	configuration, ok := attrs["configuration"].(types.Object)
+	if !ok || configuration.IsNull() {
+		diags.AddError("Invalid configurations", "configurations must contain a configuration object")
+		return model, diags
+	}
+	if diags.Append(configuration.As(ctx, &model.Configuration, basetypes.ObjectAsOptions{})...); diags.HasError() {
+		return model, diags
+	}
+
+	if locations, ok := attrs["locations"].(types.Map); ok && !locations.IsNull() {
+		if diags.Append(locations.ElementsAs(ctx, &model.Locations, false)...); diags.HasError() {
+			return model, diags
+		}
+	}
+
+	if schema, ok := attrs["schema"].(types.Map); ok && !schema.IsNull() {
+		elements := schema.Elements()
+		model.Schema = make(map[string]types.Object, len(elements))
+		for k, v := range elements {
+			obj, ok := v.(types.Object)
+			if !ok {
+				diags.AddError("Invalid configurations", fmt.Sprintf("schema of resource type '%s' must be an object", k))
+				return model, diags
 			}
-			schemaFound = true
-			break
+			model.Schema[k] = obj
 		}
 	}
 
-	if !schemaFound {
+	return model, diags
+}
+
+// parseConfigurations resolves the configurations object, the naming schema of the
+// requested name type and the optional settings, which may tighten the naming
+// schema. Argument errors are reported
+// relative to the parameter order configurations, name_type, settings.
+func parseConfigurations(
+	ctx context.Context,
+	configurations types.Object,
+	nameType string,
+	settingsDynamic types.Dynamic,
+	resp *function.RunResponse,
+) (*configurationsModel, *s.BuildNameSettingsModel, *s.NamingSchema, error) {
+	var typeSchema s.NamingSchema
+
+	if !isWhollyKnown(ctx, configurations) || !isWhollyKnown(ctx, settingsDynamic) {
+		tflog.Debug(ctx, "configurations or settings contain unknown values, returning unknown result")
+		return nil, nil, nil, errUnknownArguments
+	}
+
+	model, diags := configurationsFromObject(ctx, configurations)
+	resp.Error = function.ConcatFuncErrors(resp.Error, function.FuncErrorFromDiags(ctx, diags))
+	if resp.Error != nil {
+		return nil, nil, nil, fmt.Errorf("failed to parse configurations: %s", resp.Error.Error())
+	}
+
+	// Find the schema for the requested name type
+	o, schemaFound := model.Schema[nameType]
+	if schemaFound {
+		diagnose := o.As(ctx, &typeSchema, basetypes.ObjectAsOptions{})
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.FuncErrorFromDiags(ctx, diagnose))
+		if resp.Error != nil {
+			return nil, nil, nil, fmt.Errorf("failed to parse schema for type '%s': %s", nameType, resp.Error.Error())
+		}
+	} else {
 		// Collect available resource types for helpful error message
 		availableTypes := make([]string, 0, len(model.Schema))
 		for k := range model.Schema {
@@ -180,26 +754,43 @@ func parseArguments(
 
 		var errorMsg string
 		if len(availableTypes) == 0 {
-			errorMsg = fmt.Sprintf("resource type '%s' not found in schema. The schema appears to be empty - please verify your schema configuration is loaded correctly.", nameType)
+			errorMsg = fmt.Sprintf("resource type '%s' not found in schema. The schema appears to be empty - please verify your schema configuration is loaded correctly. %s", nameType, schemaFilterHint)
 		} else {
-			errorMsg = fmt.Sprintf("resource type '%s' not found in schema. Available resource types (%d): %s", nameType, len(availableTypes), strings.Join(availableTypes, ", "))
+			errorMsg = fmt.Sprintf("resource type '%s' not found in schema. Available resource types (%d): %s. %s", nameType, len(availableTypes), strings.Join(availableTypes, ", "), schemaFilterHint)
 		}
 		resp.Error = function.NewArgumentFuncError(1, errorMsg)
 		// Return a standard error to ensure the nil-interface check works correctly
-		return nil, "", nil, types.String{}, nil, fmt.Errorf("%s", errorMsg)
+		return nil, nil, nil, fmt.Errorf("%s", errorMsg)
+	}
+
+	buildNameSettings, err := parseNameSettings(settingsDynamic, &typeSchema)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(2, err.Error()))
+		return nil, nil, nil, fmt.Errorf("failed to parse settings: %s", err.Error())
 	}
 
-	// Parse optional settings from dynamic parameter
+	return &model, buildNameSettings, &typeSchema, nil
+}
+
+// parseNameSettings parses the optional settings of a naming function and
+// tightens the naming schema with their length and validation settings, so
+// every function checks a name against the same limits.
+func parseNameSettings(settingsDynamic types.Dynamic, typeSchema *s.NamingSchema) (*s.BuildNameSettingsModel, error) {
+	settings := &s.BuildNameSettingsModel{}
 	if !settingsDynamic.IsNull() && !settingsDynamic.IsUnderlyingValueNull() {
 		parsedSettings, err := parseSettingsFromDynamic(settingsDynamic)
 		if err != nil {
-			resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(2, err.Error()))
-			return nil, "", nil, types.String{}, nil, fmt.Errorf("failed to parse settings: %s", err.Error())
+			return nil, err
 		}
-		buildNameSettings = *parsedSettings
+		settings = parsedSettings
+	}
+
+	if err := applyLengthSettings(typeSchema, settings); err != nil {
+		return nil, err
 	}
+	applyValidationSettings(typeSchema, settings)
 
-	return &model, nameType, &buildNameSettings, name, &typeSchema, nil
+	return settings, nil
 }
 
 // newNameBuilder creates a new nameBuilder instance
@@ -225,17 +816,26 @@ func (nb *nameBuilder) setConvention() {
 
 // resolveLocation determines the location to use
 func (nb *nameBuilder) resolveLocation(resp *function.RunResponse) {
-	var location string
-	if nb.buildNameSettings.Location != "" {
-		location = nb.buildNameSettings.Location
-	} else if !nb.model.Configuration.Location.IsNull() {
-		location = nb.model.Configuration.Location.ValueString()
-	} else {
-		location = ""
+	// A location_short setting is used as is, without a lookup in the locations map.
+	if nb.buildNameSettings.LocationShort != "" {
+		nb.result.Location = mergeBuilderSetting(nb, "location",
+			inLayer(settingLayerSettings, types.StringValue(nb.buildNameSettings.LocationShort), true))
+		return
+	}
+
+	// The location is only looked up if it is rendered, so resource types
+	// without a location segment do not fail on a missing locations entry.
+	if !nb.usesSegment("location") {
+		return
 	}
 
+	location := mergeBuilderSetting(nb, "location",
+		inLayer(settingLayerSettings, nb.buildNameSettings.Location, nb.buildNameSettings.Location != ""),
+		inLayer(settingLayerConfiguration, nb.model.Configuration.Location.ValueString(), !nb.model.Configuration.Location.IsNull()),
+	)
+
 	if location != "" {
-		if v, ok := nb.model.Locations[location]; ok {
+		if v, ok := lookupLocation(nb.model.Locations, location); ok {
 			nb.result.Location = v
 		} else {
 			resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, "location not found in provided locations map"))
@@ -243,157 +843,664 @@ func (nb *nameBuilder) resolveLocation(resp *function.RunResponse) {
 	}
 }
 
-// resolveEnvironment determines the environment to use
-func (nb *nameBuilder) resolveEnvironment() {
-	if nb.buildNameSettings.Environment != "" {
-		nb.result.Environment = types.StringValue(nb.buildNameSettings.Environment)
-	} else if nb.typeSchema.Configuration.UseEnvironment.ValueBool() {
-		nb.result.Environment = nb.model.Configuration.Environment
-	} else {
-		nb.result.Environment = types.StringValue("")
+// lookupLocation returns the token of a location. A location without an exact
+// match in the locations map is compared in its normalized form, so display
+// names like "West Europe" resolve to the entry of westeurope.
+func lookupLocation(locations map[string]types.String, location string) (types.String, bool) {
+	if v, ok := locations[location]; ok {
+		return v, true
+	}
+	normalized := tools.NormalizeLocation(location)
+	for _, k := range slices.Sorted(maps.Keys(locations)) {
+		if tools.NormalizeLocation(k) == normalized {
+			return locations[k], true
+		}
 	}
+	return types.String{}, false
 }
 
-// resolveSeparator determines the separator to use.
-// Priority chain (highest to lowest):
-//  1. Per-call settings.separator
-//     2a. Schema-level separator from the naming schema (when useSeparator=true and non-empty)
-//     2b. Provider-level separator (when useSeparator=true, no schema-level value)
-//  3. Empty string (when useSeparator=false)
-func (nb *nameBuilder) resolveSeparator() {
-	if nb.buildNameSettings.Separator != "" {
-		nb.result.Separator = types.StringValue(nb.buildNameSettings.Separator)
-	} else if nb.typeSchema.Configuration.UseSeparator.ValueBool() {
-		if nb.typeSchema.Configuration.Separator.ValueString() != "" {
-			nb.result.Separator = nb.typeSchema.Configuration.Separator
-		} else {
-			nb.result.Separator = nb.model.Configuration.Separator
-		}
-	} else {
-		nb.result.Separator = types.StringValue("")
+// resolveMetadata determines the workspace and stack segments. Settings take
+// precedence over the configuration.
+func (nb *nameBuilder) resolveMetadata() {
+	nb.result.Workspace = types.StringValue(nb.model.Configuration.Workspace.ValueString())
+	if nb.buildNameSettings.Workspace != "" {
+		nb.result.Workspace = types.StringValue(nb.buildNameSettings.Workspace)
+	}
+
+	nb.result.Stack = types.StringValue(nb.model.Configuration.Stack.ValueString())
+	if nb.buildNameSettings.Stack != "" {
+		nb.result.Stack = types.StringValue(nb.buildNameSettings.Stack)
 	}
 }
 
-// resolveNamePrecedence determines the name precedence order
-func (nb *nameBuilder) resolveNamePrecedence(resp *function.RunResponse) {
-	var diagnose diag.Diagnostics
-	nb.result.NamePrecedence, diagnose = types.ListValueFrom(nb.ctx, types.StringType, s.DefaultNamePrecedence[:])
-	resp.Error = function.ConcatFuncErrors(resp.Error, function.FuncErrorFromDiags(nb.ctx, diagnose))
+// defaultDateFormat is the format of the date segment if date_format is not set.
+const defaultDateFormat = "yyyymmdd"
+
+// dateLayout converts a date_format like yyyymmdd into a Go time layout.
+var dateLayout = strings.NewReplacer("yyyy", "2006", "yy", "06", "mm", "01", "dd", "02")
 
-	var itemsNamePrecedence = nb.typeSchema.Configuration.NamePrecedence.Elements()
-	if len(itemsNamePrecedence) > 0 {
-		tflog.Debug(nb.ctx, "build_resource_name: setting NamePrecedence from schema")
-		nb.result.NamePrecedence = nb.typeSchema.Configuration.NamePrecedence
+// resolveDate determines the date segment. Functions must return the same
+// result during plan and apply, so the date is never read from the clock but
+// passed with the static_date setting, e.g. from a time_static resource.
+func (nb *nameBuilder) resolveDate(resp *function.RunResponse) {
+	nb.result.Date = types.StringValue("")
+	if !nb.usesSegment("date") {
+		return
 	}
 
-	if len(nb.buildNameSettings.NamePrecedence) > 0 {
-		nb.result.NamePrecedence, diagnose = types.ListValueFrom(nb.ctx, types.StringType, nb.buildNameSettings.NamePrecedence)
-		resp.Error = function.ConcatFuncErrors(resp.Error, function.FuncErrorFromDiags(nb.ctx, diagnose))
+	staticDate := nb.buildNameSettings.StaticDate
+	if staticDate == "" {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(2,
+			"the date segment requires the static_date setting, e.g. time_static.example.rfc3339, as the name must not change between plan and apply"))
+		return
 	}
-}
 
-// resolvePrefixes determines the prefixes to use
-func (nb *nameBuilder) resolvePrefixes(resp *function.RunResponse) {
-	if len(nb.buildNameSettings.Prefixes) == 0 || nb.buildNameSettings.Prefixes == nil {
-		nb.result.Prefixes = nb.model.Configuration.Prefixes
-	} else {
-		var diagnose diag.Diagnostics
-		nb.result.Prefixes, diagnose = types.ListValueFrom(nb.ctx, types.StringType, nb.buildNameSettings.Prefixes)
-		resp.Error = function.ConcatFuncErrors(resp.Error, function.FuncErrorFromDiags(nb.ctx, diagnose))
+	date, err := time.Parse(time.DateOnly, staticDate)
+	if err != nil {
+		date, err = time.Parse(time.RFC3339, staticDate)
+	}
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(2,
+			fmt.Sprintf("invalid static_date '%s', expected YYYY-MM-DD or an RFC 3339 timestamp", staticDate)))
+		return
 	}
-}
 
-// resolveSuffixes determines the suffixes to use
-func (nb *nameBuilder) resolveSuffixes(resp *function.RunResponse) {
-	if len(nb.buildNameSettings.Suffixes) == 0 || nb.buildNameSettings.Suffixes == nil {
-		nb.result.Suffixes = nb.model.Configuration.Suffixes
-	} else {
-		var diagnose diag.Diagnostics
-		nb.result.Suffixes, diagnose = types.ListValueFrom(nb.ctx, types.StringType, nb.buildNameSettings.Suffixes)
-		resp.Error = function.ConcatFuncErrors(resp.Error, function.FuncErrorFromDiags(nb.ctx, diagnose))
+	format := nb.buildNameSettings.DateFormat
+	if format == "" {
+		format = defaultDateFormat
 	}
+	nb.result.Date = types.StringValue(date.UTC().Format(dateLayout.Replace(format)))
 }
 
-// resolveHashLength determines the hash length to use
-func (nb *nameBuilder) resolveHashLength() {
-	if nb.buildNameSettings.HashLength > 0 {
-		nb.result.HashLength = types.Int32Value(nb.buildNameSettings.HashLength)
-	} else if nb.model.Configuration.HashLength.ValueInt32() > 0 {
-		nb.result.HashLength = nb.model.Configuration.HashLength
-	} else {
-		nb.result.HashLength = nb.typeSchema.Configuration.HashLength
+// resolveEnvironment determines the environment to use. Long environment names
+// of the environment catalog are replaced by their short token, and the token
+// must be one of the allowed environments of the configuration, if any.
+func (nb *nameBuilder) resolveEnvironment(resp *function.RunResponse) {
+	// A naming schema without environment drops the environment of the
+	// configuration, but not the one of the settings.
+	nb.result.Environment = mergeBuilderSetting(nb, "environment",
+		inLayer(settingLayerSettings, types.StringValue(nb.buildNameSettings.Environment), nb.buildNameSettings.Environment != ""),
+		inLayer(settingLayerSchema, types.StringValue(""), !nb.typeSchema.Configuration.UseEnvironment.ValueBool()),
+		inLayer(settingLayerConfiguration, nb.model.Configuration.Environment, true),
+	)
+
+	environment := nb.result.Environment.ValueString()
+	if environment == "" {
+		return
 	}
-}
 
-// resolveRandomSeed determines the random seed to use
-func (nb *nameBuilder) resolveRandomSeed() {
-	if nb.buildNameSettings.RandomSeed > 0 {
-		nb.result.RandomSeed = types.Int64Value(nb.buildNameSettings.RandomSeed)
-	} else {
-		nb.result.RandomSeed = nb.model.Configuration.RandomSeed
+	token, err := environmentToken(&nb.model.Configuration, environment)
+	nb.result.Environment = types.StringValue(token)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
 	}
 }
 
-// buildNameComponents constructs the name from individual components
-func (nb *nameBuilder) buildNameComponents(name types.String) {
-	var calculatedContent []string
+// environmentToken returns the short token of a long environment name of the
+// environment catalog of the configuration, or the environment itself if it is
+// not in the catalog. The token must be one of the allowed environments of the
+// configuration, i.e. of the catalog narrowed by a standesamt_convention, if any.
+func environmentToken(configuration *configurationModel, environment string) (string, error) {
+	if token, ok := extractStringMap(configuration.Environments)[environment]; ok {
+		environment = token
+	}
+
+	allowed := extractStringSlice(configuration.AllowedEnvironments)
+	if len(allowed) > 0 && !slices.Contains(allowed, environment) {
+		return environment, fmt.Errorf("environment '%s' is not one of the allowed environments of the configuration: %s", environment, strings.Join(allowed, ", "))
+	}
+	return environment, nil
+}
+
+// checkAllowedPrefixes checks that every prefix is one of the allowed prefixes
+// of the configuration, if any, e.g. of a standesamt_convention.
+func checkAllowedPrefixes(configuration *configurationModel, prefixes []string) error {
+	allowed := extractStringSlice(configuration.AllowedPrefixes)
+	if len(allowed) == 0 {
+		return nil
+	}
+	for _, prefix := range prefixes {
+		if !slices.Contains(allowed, prefix) {
+			return fmt.Errorf("prefix '%s' is not one of the allowed prefixes of the configuration: %s", prefix, strings.Join(allowed, ", "))
+		}
+	}
+	return nil
+}
+
+// resolveSeparator determines the separator to use.
+// Priority chain (highest to lowest):
+//  1. Per-call settings.separator, or no separator with use_separator = false
+//  2. Schema-level separator (when useSeparator=true and non-empty), or no
+//     separator (when useSeparator=false)
+//  3. Provider-level separator
+func (nb *nameBuilder) resolveSeparator() {
+	settingsSeparator := nb.buildNameSettings.Separator
+	noSettingsSeparator := nb.buildNameSettings.UseSeparator != nil && !*nb.buildNameSettings.UseSeparator
+	schemaSeparator := types.StringValue("")
+	if nb.typeSchema.Configuration.UseSeparator.ValueBool() {
+		schemaSeparator = nb.typeSchema.Configuration.Separator
+	}
+
+	nb.result.Separator = mergeBuilderSetting(nb, "separator",
+		inLayer(settingLayerSettings, types.StringValue(settingsSeparator), settingsSeparator != "" || noSettingsSeparator),
+		inLayer(settingLayerSchema, schemaSeparator, schemaSeparator.ValueString() != "" || !nb.typeSchema.Configuration.UseSeparator.ValueBool()),
+		inLayer(settingLayerConfiguration, nb.model.Configuration.Separator, true),
+	)
+}
+
+// resolveNamePrecedence determines the name precedence order
+func (nb *nameBuilder) resolveNamePrecedence(resp *function.RunResponse) {
+	precedence := mergeBuilderSetting(nb, "name_precedence",
+		inLayer(settingLayerSettings, nb.buildNameSettings.NamePrecedence, len(nb.buildNameSettings.NamePrecedence) > 0),
+		inLayer(settingLayerSchema, extractStringSlice(nb.typeSchema.Configuration.NamePrecedence), len(nb.typeSchema.Configuration.NamePrecedence.Elements()) > 0),
+		inLayer(settingLayerDefault, s.DefaultNamePrecedence[:], true),
+	)
+	var diagnose diag.Diagnostics
+	nb.result.NamePrecedence, diagnose = types.ListValueFrom(nb.ctx, types.StringType, precedence)
+	resp.Error = function.ConcatFuncErrors(resp.Error, function.FuncErrorFromDiags(nb.ctx, diagnose))
+
+	if nb.sources["name_precedence"] == settingLayerSettings {
+
+		// The schema library may mandate segments, e.g. the abbreviation, that
+		// an overridden name precedence must not drop.
+		for _, segment := range extractStringSlice(nb.typeSchema.Configuration.RequiredSegments) {
+			if !slices.Contains(nb.buildNameSettings.NamePrecedence, segment) {
+				resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(2, fmt.Sprintf(
+					"name_precedence must contain the segment '%s', the schema library requires it for resource type '%s'",
+					segment, nb.typeSchema.ResourceType.ValueString())))
+			}
+		}
+	}
+}
+
+// affixSet returns the affix set of the configuration with the given name.
+func (nb *nameBuilder) affixSet(name string) (s.AffixSet, error) {
+	affixes := affixesFromMap(nb.model.Configuration.Affixes)
+	set, ok := affixes[name]
+	if !ok {
+		names := make([]string, 0, len(affixes))
+		for k := range affixes {
+			names = append(names, k)
+		}
+		slices.Sort(names)
+		return s.AffixSet{}, fmt.Errorf("unknown affix set '%s', expected one of: %s", name, strings.Join(names, ", "))
+	}
+	return set, nil
+}
+
+// resolvePrefixes determines the prefixes to use. The prefixes of a prefix_set
+// come first, followed by the prefixes of the settings. The default prefixes of
+// the naming schema are only used if neither the settings nor the configuration
+// set any prefixes. Every prefix must be one of the allowed prefixes of the
+// configuration, if any.
+func (nb *nameBuilder) resolvePrefixes(resp *function.RunResponse) {
+	if name := nb.buildNameSettings.PrefixSet; name != "" {
+		set, err := nb.affixSet(name)
+		if err != nil {
+			resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(fmt.Sprintf("prefix_set: %s", err)))
+			return
+		}
+		nb.result.Prefixes = mergeBuilderSetting(nb, "prefixes",
+			inLayer(settingLayerSettings, stringSliceToList(slices.Concat(set.Prefixes, nb.buildNameSettings.Prefixes)), true))
+	} else {
+		schemaDefaults := nb.typeSchema.Configuration.DefaultPrefixes
+		nb.result.Prefixes = mergeBuilderSetting(nb, "prefixes",
+			inLayer(settingLayerSettings, stringSliceToList(nb.buildNameSettings.Prefixes), len(nb.buildNameSettings.Prefixes) > 0),
+			inLayer(settingLayerConfiguration, nb.model.Configuration.Prefixes, len(nb.model.Configuration.Prefixes.Elements()) > 0 || len(schemaDefaults.Elements()) == 0),
+			inLayer(settingLayerSchema, schemaDefaults, len(schemaDefaults.Elements()) > 0),
+		)
+	}
+
+	if err := checkAllowedPrefixes(&nb.model.Configuration, extractStringSlice(nb.result.Prefixes)); err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+	}
+}
+
+// resolveSuffixes determines the suffixes to use. The suffixes of a suffix_set
+// come first, followed by the suffixes of the settings. The default suffixes of
+// the naming schema are only used if neither the settings nor the configuration
+// set any suffixes.
+func (nb *nameBuilder) resolveSuffixes(resp *function.RunResponse) {
+	if name := nb.buildNameSettings.SuffixSet; name != "" {
+		set, err := nb.affixSet(name)
+		if err != nil {
+			resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(fmt.Sprintf("suffix_set: %s", err)))
+			return
+		}
+		nb.result.Suffixes = mergeBuilderSetting(nb, "suffixes",
+			inLayer(settingLayerSettings, stringSliceToList(slices.Concat(set.Suffixes, nb.buildNameSettings.Suffixes)), true))
+	} else {
+		schemaDefaults := nb.typeSchema.Configuration.DefaultSuffixes
+		nb.result.Suffixes = mergeBuilderSetting(nb, "suffixes",
+			inLayer(settingLayerSettings, stringSliceToList(nb.buildNameSettings.Suffixes), len(nb.buildNameSettings.Suffixes) > 0),
+			inLayer(settingLayerConfiguration, nb.model.Configuration.Suffixes, len(nb.model.Configuration.Suffixes.Elements()) > 0 || len(schemaDefaults.Elements()) == 0),
+			inLayer(settingLayerSchema, schemaDefaults, len(schemaDefaults.Elements()) > 0),
+		)
+	}
+}
+
+// resolveHashLength determines the hash length to use. Unlike the other
+// settings, a hash length of the configuration overrides the naming schema.
+func (nb *nameBuilder) resolveHashLength() {
+	// Globally unique names must not rely on a schema without hash.
+	schemaHashLength := nb.typeSchema.Configuration.HashLength
+	minLength := nb.model.Configuration.MinGlobalHashLength.ValueInt32()
+	if nb.typeSchema.Scope.ValueString() == s.ScopeGlobal && schemaHashLength.ValueInt32() < minLength {
+		schemaHashLength = types.Int32Value(minLength)
+	}
+
+	nb.result.HashLength = mergeBuilderSetting(nb, "hash_length",
+		inLayer(settingLayerSettings, types.Int32Value(nb.buildNameSettings.HashLength), nb.buildNameSettings.HashLength > 0),
+		inLayer(settingLayerConfiguration, nb.model.Configuration.HashLength, nb.model.Configuration.HashLength.ValueInt32() > 0),
+		inLayer(settingLayerSchema, schemaHashLength, true),
+	)
+}
+
+// resolveRandomSeed determines the random seed to use
+func (nb *nameBuilder) resolveRandomSeed() {
+	nb.result.RandomSeed = mergeBuilderSetting(nb, "random_seed",
+		inLayer(settingLayerSettings, types.Int64Value(nb.buildNameSettings.RandomSeed), nb.buildNameSettings.RandomSeed != 0),
+		inLayer(settingLayerConfiguration, nb.model.Configuration.RandomSeed, true),
+	)
+}
+
+// isStrict reports whether validation failures of the built name are errors.
+// Settings take precedence over the configuration; strict is the default.
+func (nb *nameBuilder) isStrict() bool {
+	strict := nb.model.Configuration.Strict
+	return mergeBuilderSetting(nb, "strict",
+		inLayer(settingLayerSettings, nb.buildNameSettings.Strict != nil && *nb.buildNameSettings.Strict, nb.buildNameSettings.Strict != nil),
+		inLayer(settingLayerConfiguration, strict.ValueBool(), !strict.IsNull() && !strict.IsUnknown()),
+		inLayer(settingLayerDefault, true, true),
+	)
+}
+
+// policySegmentTypes maps the name precedence tokens of required_segments to the
+// segment types of the built name.
+var policySegmentTypes = map[string]string{
+	"abbreviation": "abbreviation",
+	"prefixes":     "prefix",
+	"name":         "name",
+	"location":     "location",
+	"environment":  "environment",
+	"hash":         "hash",
+	"suffixes":     "suffix",
+	"workspace":    "workspace",
+	"stack":        "stack",
+	"date":         "date",
+}
+
+// validatePolicy checks the required segments and the required prefix regex of a
+// naming policy.
+func validatePolicy(requiredSegments []string, requiredPrefixRegex string) error {
+	for _, segment := range requiredSegments {
+		if _, ok := policySegmentTypes[segment]; !ok {
+			return fmt.Errorf("unknown required segment '%s', expected one of: %v", segment, s.NamePrecedenceTokens)
+		}
+	}
+	if requiredPrefixRegex != "" {
+		if _, err := compileCachedRegex(requiredPrefixRegex); err != nil {
+			return fmt.Errorf("invalid required prefix regex '%s': %s", requiredPrefixRegex, err)
+		}
+	}
+	return nil
+}
+
+// policyViolations checks the built name against the naming policy of the
+// configuration. Names of the passthrough convention are not checked.
+func (nb *nameBuilder) policyViolations() ([]string, error) {
+	configuration := nb.model.Configuration
+	requiredSegments := extractStringSlice(configuration.RequiredSegments)
+	requiredPrefixRegex := configuration.RequiredPrefixRegex.ValueString()
+
+	if err := validatePolicy(requiredSegments, requiredPrefixRegex); err != nil {
+		return nil, err
+	}
+	if nb.result.Convention.ValueString() != "default" {
+		return nil, nil
+	}
 
-	for i := 0; i < len(nb.result.NamePrecedence.Elements()); i++ {
-		switch c := (nb.result.NamePrecedence.Elements())[i].String(); strings.Trim(c, "\"") {
+	name := nb.result.Name.ValueString()
+	violations := make([]string, 0)
+	for _, segment := range requiredSegments {
+		found := slices.ContainsFunc(nb.segments, func(n nameSegment) bool {
+			return n.Type == policySegmentTypes[segment] && n.Value != ""
+		})
+		if !found {
+			violations = append(violations, fmt.Sprintf("Policy violation: '%s' is missing required segment '%s'", name, segment))
+		}
+	}
+
+	if requiredPrefixRegex != "" {
+		regex, _ := compileCachedRegex(requiredPrefixRegex)
+		found := slices.ContainsFunc(nb.segments, func(n nameSegment) bool {
+			return n.Type == "prefix" && regex.MatchString(n.Value)
+		})
+		if !found {
+			violations = append(violations, fmt.Sprintf("Policy violation: no prefix of '%s' matches required prefix regex '%s'", name, requiredPrefixRegex))
+		}
+	}
+
+	return violations, nil
+}
+
+// redactedValue replaces sensitive values in messages.
+const redactedValue = "(sensitive)"
+
+// redact replaces the quoted name, input name, prefixes and suffixes in a
+// message of the builder with the sensitive setting. Longer values are
+// replaced first, so a value contained in another does not leave parts of it.
+func (nb *nameBuilder) redact(message string, name types.String) string {
+	if !nb.buildNameSettings.Sensitive {
+		return message
+	}
+	values := []string{nb.result.Name.ValueString(), name.ValueString()}
+	values = append(values, extractStringSlice(nb.result.Prefixes)...)
+	values = append(values, extractStringSlice(nb.result.Suffixes)...)
+	slices.SortFunc(values, func(a, b string) int { return len(b) - len(a) })
+	for _, value := range values {
+		if value != "" {
+			message = strings.ReplaceAll(message, "'"+value+"'", "'"+redactedValue+"'")
+		}
+	}
+	return message
+}
+
+// nameSegment is a single part of the resulting name, e.g. the abbreviation or a prefix.
+type nameSegment struct {
+	Type  string
+	Value string
+}
+
+// Values of the separator_conflict setting.
+const (
+	separatorConflictAllow    = "allow"
+	separatorConflictError    = "error"
+	separatorConflictSanitize = "sanitize"
+)
+
+// separatorConflictSegments are the segment types that take user input and may
+// contain the separator.
+var separatorConflictSegments = []string{"prefix", "suffix", "environment", "name"}
+
+func validateSeparatorConflict(mode string) error {
+	if mode != "" && !slices.Contains([]string{separatorConflictAllow, separatorConflictError, separatorConflictSanitize}, mode) {
+		return fmt.Errorf("invalid separator_conflict '%s', expected one of: %s, %s, %s", mode, separatorConflictAllow, separatorConflictError, separatorConflictSanitize)
+	}
+	return nil
+}
+
+// checkSegmentSeparators handles segments that contain the separator, as they
+// make the name ambiguous and can trip the double hyphen check, e.g. a prefix
+// "team-" with separator "-". With separator_conflict = "error" such segments
+// are reported, with "sanitize" the separator is replaced inside the segment.
+func (nb *nameBuilder) checkSegmentSeparators(segments []nameSegment, resp *function.RunResponse) {
+	separator := nb.result.Separator.ValueString()
+	mode := nb.buildNameSettings.SeparatorConflict
+	if separator == "" || mode == "" || mode == separatorConflictAllow {
+		return
+	}
+
+	for i, segment := range segments {
+		if !slices.Contains(separatorConflictSegments, segment.Type) || !strings.Contains(segment.Value, separator) {
+			continue
+		}
+		if mode == separatorConflictSanitize {
+			segments[i].Value = strings.ReplaceAll(segment.Value, separator, nb.buildNameSettings.SeparatorReplacement)
+			continue
+		}
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(
+			fmt.Sprintf("%s segment '%s' contains the separator '%s'", segment.Type, segment.Value, separator)))
+	}
+}
+
+// buildNameComponents constructs the name from individual components
+func (nb *nameBuilder) buildNameComponents(name types.String, resp *function.RunResponse) {
+	var segments []nameSegment
+	hashIndex := -1
+
+	for _, token := range extractStringSlice(nb.result.NamePrecedence) {
+		switch token {
 		case "abbreviation":
 			if len(nb.typeSchema.Abbreviation.String()) > 0 {
-				calculatedContent = append(calculatedContent, tools.GetBaseString(nb.typeSchema.Abbreviation))
+				segments = append(segments, nameSegment{Type: "abbreviation", Value: tools.GetBaseString(nb.typeSchema.Abbreviation)})
 			}
 		case "prefixes":
-			for j := 0; j < len(nb.result.Prefixes.Elements()); j++ {
-				calculatedContent = append(calculatedContent,
-					strings.Trim(nb.result.Prefixes.Elements()[j].String(), "\""))
+			for _, prefix := range extractStringSlice(nb.result.Prefixes) {
+				segments = append(segments, nameSegment{Type: "prefix", Value: prefix})
 			}
 		case "suffixes":
-			for j := 0; j < len(nb.result.Suffixes.Elements()); j++ {
-				calculatedContent = append(calculatedContent,
-					strings.Trim(nb.result.Suffixes.Elements()[j].String(), "\""))
+			for _, suffix := range extractStringSlice(nb.result.Suffixes) {
+				segments = append(segments, nameSegment{Type: "suffix", Value: suffix})
 			}
 		case "name":
 			if len(name.String()) > 0 {
-				calculatedContent = append(calculatedContent, tools.GetBaseString(name))
+				segments = append(segments, nameSegment{Type: "name", Value: tools.GetBaseString(name)})
 			}
 		case "environment":
 			if len(nb.result.Environment.ValueString()) > 0 {
-				calculatedContent = append(calculatedContent, tools.GetBaseString(nb.result.Environment))
+				segments = append(segments, nameSegment{Type: "environment", Value: tools.GetBaseString(nb.result.Environment)})
 			}
 		case "location":
 			if len(nb.result.Location.ValueString()) > 0 {
-				calculatedContent = append(calculatedContent, tools.GetBaseString(nb.result.Location))
+				segments = append(segments, nameSegment{Type: "location", Value: tools.GetBaseString(nb.result.Location)})
+			}
+		case "workspace":
+			if len(nb.result.Workspace.ValueString()) > 0 {
+				segments = append(segments, nameSegment{Type: "workspace", Value: nb.result.Workspace.ValueString()})
+			}
+		case "stack":
+			if len(nb.result.Stack.ValueString()) > 0 {
+				segments = append(segments, nameSegment{Type: "stack", Value: nb.result.Stack.ValueString()})
+			}
+		case "date":
+			if len(nb.result.Date.ValueString()) > 0 {
+				segments = append(segments, nameSegment{Type: "date", Value: nb.result.Date.ValueString()})
 			}
 		case "hash":
-			if !nb.result.HashLength.IsNull() {
-				var hashLength = nb.result.HashLength.ValueInt32()
-				if hashLength > 0 {
-					randomHash := random.Hash(int(hashLength), nb.result.RandomSeed.ValueInt64())
-					calculatedContent = append(calculatedContent, randomHash)
+			if !nb.result.HashLength.IsNull() && nb.result.HashLength.ValueInt32() > 0 {
+				hashIndex = len(segments)
+				segments = append(segments, nameSegment{Type: "hash"})
+			}
+		}
+	}
+
+	nb.checkSegmentSeparators(segments, resp)
+
+	// The hash is generated last, as a derived hash depends on all other segments.
+	if hashIndex >= 0 {
+		segments[hashIndex].Value = nb.hash(segments)
+	}
+
+	segments = nb.postProcess(segments)
+
+	nb.segments = segments
+	nb.result.Name = types.StringValue(joinSegments(segments, nb.result.Separator.ValueString()))
+}
+
+// hash generates the hash segment. The seed key is mixed into the seed, so
+// callers sharing a random seed get different hashes per key. In derived mode
+// the seed is combined with all other segments, so names that differ in any
+// segment get different hashes. The collision domain salts a derived hash, so
+// the same name gets the same hash within a domain and different hashes across
+// domains.
+func (nb *nameBuilder) hash(segments []nameSegment) string {
+	seed := nb.result.RandomSeed.ValueInt64()
+	if key := nb.buildNameSettings.SeedKey; key != "" {
+		h := fnv.New64a()
+		_, _ = h.Write([]byte(key))
+		seed ^= int64(h.Sum64())
+	}
+	if nb.buildNameSettings.HashMode == hashModeDerived {
+		h := fnv.New64a()
+		if domain := nb.buildNameSettings.CollisionDomain; domain != "" {
+			_, _ = h.Write([]byte("collision_domain=" + domain + "\x00"))
+		}
+		for _, segment := range segments {
+			if segment.Type != "hash" {
+				_, _ = h.Write([]byte(segment.Type + "=" + segment.Value + "\x00"))
+			}
+		}
+		seed ^= int64(h.Sum64())
+	}
+
+	charset := random.Lowercase
+	switch nb.buildNameSettings.HashCharset {
+	case hashCharsetAlphanumeric:
+		charset = random.LowercaseAlphanumeric
+	case hashCharsetAuto:
+		charset = nb.autoHashCharset(segments)
+	}
+
+	return random.HashWithCharset(int(nb.result.HashLength.ValueInt32()), seed, charset)
+}
+
+// autoHashCharset returns the lowercase letters, uppercase letters and digits
+// the validation regex of the resource type allows at the position of the hash.
+// A character is allowed if the name with a hash of only that character, in the
+// casing of the name, matches the regex. If no character passes, e.g. because
+// the name is too long before truncation, the hash is probed on its own. Names
+// whose regex allows none of the characters get a lowercase hash and fail
+// validation as before.
+func (nb *nameBuilder) autoHashCharset(segments []nameSegment) string {
+	wantLower, wantUpper := nb.casing()
+	applyCasing := func(value string) string {
+		if wantLower {
+			return strings.ToLower(value)
+		} else if wantUpper {
+			return strings.ToUpper(value)
+		}
+		return value
+	}
+
+	var candidates strings.Builder
+	for _, c := range random.Lowercase + random.Uppercase + random.Digits {
+		if cased := applyCasing(string(c)); !strings.Contains(candidates.String(), cased) {
+			candidates.WriteString(cased)
+		}
+	}
+
+	pattern := tools.GetBaseString(nb.typeSchema.ValidationRegex)
+	if pattern == "" {
+		return candidates.String()
+	}
+	re, err := compileValidationRegex(pattern)
+	if err != nil {
+		return random.Lowercase
+	}
+
+	// allowed returns the candidates for which the probe matches the regex.
+	allowed := func(probe func(c string) string) string {
+		var result strings.Builder
+		for _, c := range candidates.String() {
+			if re.MatchString(probe(string(c))) {
+				result.WriteRune(c)
+			}
+		}
+		return result.String()
+	}
+
+	hashLength := int(nb.result.HashLength.ValueInt32())
+	if len(segments) > 0 {
+		charset := allowed(func(c string) string {
+			probeSegments := slices.Clone(segments)
+			for i := range probeSegments {
+				if probeSegments[i].Type == "hash" {
+					probeSegments[i].Value = strings.Repeat(c, hashLength)
 				}
 			}
+			return applyCasing(joinSegments(probeSegments, nb.result.Separator.ValueString()))
+		})
+		if charset != "" {
+			return charset
 		}
 	}
-	nb.result.Name = types.StringValue(strings.Join(calculatedContent, nb.result.Separator.ValueString()))
+
+	probeLength := max(hashLength, int(nb.typeSchema.MinLength.ValueInt64()), 1)
+	if charset := allowed(func(c string) string { return strings.Repeat(c, probeLength) }); charset != "" {
+		return charset
+	}
+	return random.Lowercase
+}
+
+// truncateKeepHash shortens the name segment so the name fits into the maximum
+// length of the resource type while the hash is kept intact. Only the name
+// segment is ever shortened: a name that is still too long afterwards fails
+// validation instead of losing characters of the hash.
+func (nb *nameBuilder) truncateKeepHash(segments []nameSegment) []nameSegment {
+	overflow := len(joinSegments(segments, nb.result.Separator.ValueString())) - int(nb.typeSchema.MaxLength.ValueInt64())
+	if overflow <= 0 {
+		return segments
+	}
+
+	for i, segment := range segments {
+		if segment.Type != "name" {
+			continue
+		}
+		nb.truncated = true
+		if overflow < len(segment.Value) {
+			segments[i].Value = segment.Value[:len(segment.Value)-overflow]
+			return segments
+		}
+		// The name segment is dropped entirely, including its separator.
+		return append(segments[:i], segments[i+1:]...)
+	}
+
+	return segments
+}
+
+// hashSegment returns the hash segment of the built name, or an empty string if
+// the name has no hash.
+func (nb *nameBuilder) hashSegment() string {
+	for _, segment := range nb.casedSegments() {
+		if segment.Type == "hash" {
+			return segment.Value
+		}
+	}
+	return ""
+}
+
+// expectedHash returns the hash a name built with the settings contains, without
+// building the name. A derived hash depends on the other segments and cannot be
+// recomputed from a raw name.
+func (nb *nameBuilder) expectedHash() (string, error) {
+	nb.resolveHashLength()
+	nb.resolveRandomSeed()
+	if nb.result.HashLength.IsNull() || nb.result.HashLength.ValueInt32() <= 0 {
+		return "", nil
+	}
+	if nb.buildNameSettings.HashMode == hashModeDerived {
+		return "", fmt.Errorf("min_unique_suffix cannot be checked for a raw name with hash_mode '%s'", hashModeDerived)
+	}
+	return nb.hash(nil), nil
+}
+
+func joinSegments(segments []nameSegment, separator string) string {
+	values := make([]string, 0, len(segments))
+	for _, segment := range segments {
+		values = append(values, segment.Value)
+	}
+	return strings.Join(values, separator)
 }
 
 // applyCasing converts the name to lower or upper case if needed.
-// Returns an error if both lowercase and uppercase are simultaneously requested.
+// Returns an error if both lowercase and uppercase are simultaneously requested
+// or a lowercase step of post_process meets upper case.
 func (nb *nameBuilder) applyCasing(resp *function.RunResponse) {
-	wantLower := nb.typeSchema.Configuration.UseLowerCase.ValueBool() ||
-		nb.model.Configuration.Lowercase.ValueBool() ||
-		nb.buildNameSettings.Lowercase
-	wantUpper := nb.typeSchema.Configuration.UseUpperCase.ValueBool() ||
-		nb.model.Configuration.Uppercase.ValueBool() ||
-		nb.buildNameSettings.Uppercase
+	wantLower, wantUpper := nb.casing()
 
 	if wantLower && wantUpper {
 		resp.Error = function.ConcatFuncErrors(resp.Error,
 			function.NewFuncError("Invalid configuration: lowercase and uppercase cannot both be true"))
 		return
 	}
+	// The casing is applied after the pipeline and would silently undo a
+	// lowercase step.
+	if wantUpper && slices.Contains(nb.buildNameSettings.PostProcess, postProcessLowercase) {
+		resp.Error = function.ConcatFuncErrors(resp.Error,
+			function.NewArgumentFuncError(2, "Invalid settings: post_process step 'lowercase' conflicts with the upper case of the configuration or resource type"))
+		return
+	}
 	if wantLower {
 		nb.result.Name = toLower(nb.result.Name)
 	} else if wantUpper {
@@ -401,20 +1508,60 @@ func (nb *nameBuilder) applyCasing(resp *function.RunResponse) {
 	}
 }
 
+// casing reports whether the name is converted to lower or upper case.
+func (nb *nameBuilder) casing() (wantLower, wantUpper bool) {
+	wantLower = nb.typeSchema.Configuration.UseLowerCase.ValueBool() ||
+		nb.model.Configuration.Lowercase.ValueBool() ||
+		nb.buildNameSettings.Lowercase
+	wantUpper = nb.typeSchema.Configuration.UseUpperCase.ValueBool() ||
+		nb.model.Configuration.Uppercase.ValueBool() ||
+		nb.buildNameSettings.Uppercase
+	return wantLower, wantUpper
+}
+
+// casedSegments returns the segments of the name with the casing of the name applied.
+func (nb *nameBuilder) casedSegments() []nameSegment {
+	wantLower, wantUpper := nb.casing()
+	segments := make([]nameSegment, 0, len(nb.segments))
+	for _, segment := range nb.segments {
+		if wantLower {
+			segment.Value = strings.ToLower(segment.Value)
+		} else if wantUpper {
+			segment.Value = strings.ToUpper(segment.Value)
+		}
+		segments = append(segments, segment)
+	}
+	return segments
+}
+
+// validateTransliterate reports an unknown transliteration mode.
+func validateTransliterate(mode string) error {
+	if mode != "" && !slices.Contains(tools.TransliterationModes, mode) {
+		return fmt.Errorf("invalid transliterate '%s', expected one of: %s", mode, strings.Join(tools.TransliterationModes, ", "))
+	}
+	return nil
+}
+
 // buildName orchestrates the name building process
 func (nb *nameBuilder) buildName(name types.String, resp *function.RunResponse) types.String {
 	nb.setConvention()
 
+	if mode := nb.buildNameSettings.Transliterate; mode != "" && !name.IsNull() {
+		name = types.StringValue(tools.Transliterate(name.ValueString(), mode))
+	}
+
 	if nb.result.Convention.ValueString() == "default" {
+		nb.resolveNamePrecedence(resp)
 		nb.resolveLocation(resp)
-		nb.resolveEnvironment()
+		nb.resolveEnvironment(resp)
+		nb.resolveMetadata()
+		nb.resolveDate(resp)
 		nb.resolveSeparator()
-		nb.resolveNamePrecedence(resp)
 		nb.resolvePrefixes(resp)
 		nb.resolveSuffixes(resp)
 		nb.resolveHashLength()
 		nb.resolveRandomSeed()
-		nb.buildNameComponents(name)
+		nb.buildNameComponents(name, resp)
 	} else {
 		tflog.Debug(nb.ctx, "configuring with passthrough convention")
 		nb.result.Name = name
@@ -424,34 +1571,275 @@ func (nb *nameBuilder) buildName(name types.String, resp *function.RunResponse)
 	return nb.result.Name
 }
 
+// usesSegment reports whether the resolved name precedence contains the given segment
+func (nb *nameBuilder) usesSegment(segment string) bool {
+	for _, e := range nb.result.NamePrecedence.Elements() {
+		if v, ok := e.(types.String); ok && v.ValueString() == segment {
+			return true
+		}
+	}
+	return false
+}
+
+// minHashedNameLength returns the length of the shortest name of a naming schema
+// with a hash of hashLength: the abbreviation and the hash joined with the
+// separator, which truncation never shortens. It is 0 if the name precedence of
+// the schema has no hash.
+func minHashedNameLength(typeSchema *s.NamingSchema, hashLength int32, separator string) int64 {
+	precedence := extractStringSlice(typeSchema.Configuration.NamePrecedence)
+	if len(precedence) == 0 {
+		precedence = s.DefaultNamePrecedence[:]
+	}
+	if hashLength <= 0 || !slices.Contains(precedence, "hash") {
+		return 0
+	}
+	length := int64(hashLength)
+	if abbreviation := typeSchema.Abbreviation.ValueString(); abbreviation != "" && slices.Contains(precedence, "abbreviation") {
+		length += int64(len(abbreviation) + len(separator))
+	}
+	return length
+}
+
+// hashLengthConflict returns a violation if the hash length of the configuration
+// leaves no room for a valid name of the resource type. A hash length passed in
+// the settings is the choice of the caller and is not reported.
+func (nb *nameBuilder) hashLengthConflict(nameType string) string {
+	hashLength := nb.model.Configuration.HashLength.ValueInt32()
+	if nb.buildNameSettings.HashLength > 0 || hashLength <= 0 || !nb.usesSegment("hash") {
+		return ""
+	}
+	maxLength := nb.typeSchema.MaxLength.ValueInt64()
+	minLength := minHashedNameLength(nb.typeSchema, hashLength, nb.result.Separator.ValueString())
+	if maxLength <= 0 || minLength <= maxLength {
+		return ""
+	}
+	return fmt.Sprintf("hash_length %d of the configuration does not fit resource type '%s': the abbreviation and the hash alone need %d characters, but maximum is set to %d. Pass a smaller hash_length in the settings",
+		hashLength, nameType, minLength, maxLength)
+}
+
+// nameBudget returns the number of characters left for the free-form name segment
+// once all other segments are rendered. The name segment is rendered empty, so the
+// separators around it are already accounted for. The result is negative when the
+// other segments alone exceed the maximum length of the resource type.
+func (nb *nameBuilder) nameBudget(resp *function.RunResponse) int64 {
+	rest := tools.GetBaseString(nb.buildName(types.StringValue(""), resp))
+	maxLength := nb.typeSchema.MaxLength.ValueInt64()
+
+	if nb.result.Convention.ValueString() != "default" {
+		return maxLength
+	}
+	if !nb.usesSegment("name") {
+		return 0
+	}
+	return maxLength - int64(len(rest))
+}
+
 // validationResult encapsulates the validation results for a name
 type validationResult struct {
-	RegexValid         bool
-	LengthValid        bool
-	DoubleHyphensFound bool
-	Name               string
-	NameLength         int64
-	ValidationRegex    string
-	MaxLength          int64
-	MinLength          int64
-	DenyDoubleHyphens  bool
-}
-
-// validateName performs validation checks on a name and returns structured results
-func validateName(name string, schema *s.NamingSchema) *validationResult {
+	RegexValid             bool
+	LengthValid            bool
+	DoubleHyphensFound     bool
+	Name                   string
+	NameLength             int64
+	ValidationRegex        string
+	MaxLength              int64
+	MinLength              int64
+	DenyDoubleHyphens      bool
+	RepeatedSeparator      string
+	DenyRepeatedSeparator  bool
+	RepeatedSeparatorFound bool
+	DenyLeading            string
+	DenyTrailing           string
+	LeadingValid           bool
+	TrailingValid          bool
+	UniqueSuffixLength     int64
+	MinUniqueSuffix        int64
+	UniqueSuffixValid      bool
+	SettingsRegex          string
+	SettingsRegexValid     bool
+	DeniedPatterns         []string
+	ReservedWords          []string
+}
+
+// validationRegexCache holds compiled validation regexes keyed by their pattern.
+// Large plans validate thousands of names against a handful of patterns, so
+// every pattern is compiled only once per provider process.
+var validationRegexCache sync.Map
+
+// compileValidationRegex returns the compiled regex for pattern, compiling and
+// caching it on first use. Invalid patterns (e.g. from a custom schema library)
+// are reported as an error instead of panicking.
+func compileValidationRegex(pattern string) (*regexp.Regexp, error) {
+	re, err := compileCachedRegex(pattern)
+	if err != nil {
+		return nil, fmt.Errorf("invalid validation regex '%s': %s", pattern, err.Error())
+	}
+	return re, nil
+}
+
+// compileDenyPattern is the deny_patterns counterpart of compileValidationRegex.
+func compileDenyPattern(pattern string) (*regexp.Regexp, error) {
+	re, err := compileCachedRegex(pattern)
+	if err != nil {
+		return nil, fmt.Errorf("invalid deny pattern '%s': %s", pattern, err.Error())
+	}
+	return re, nil
+}
+
+// compileCharacterRule compiles the anchored deny_leading or deny_trailing
+// character class of a schema.
+func compileCharacterRule(field, class, pattern string) (*regexp.Regexp, error) {
+	re, err := compileCachedRegex(pattern)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s character class '%s': %s", field, class, err.Error())
+	}
+	return re, nil
+}
+
+func compileCachedRegex(pattern string) (*regexp.Regexp, error) {
+	if re, ok := validationRegexCache.Load(pattern); ok {
+		return re.(*regexp.Regexp), nil
+	}
+
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, err
+	}
+
+	actual, _ := validationRegexCache.LoadOrStore(pattern, re)
+	return actual.(*regexp.Regexp), nil
+}
+
+// violations returns a message for every naming constraint the name breaks.
+func (r *validationResult) violations() []string {
+	var violations []string
+
+	if r.DenyDoubleHyphens && r.DoubleHyphensFound {
+		violations = append(violations, fmt.Sprintf("Invalid name: '%s' contains double hyphens", r.Name))
+	}
+
+	if r.DenyRepeatedSeparator && r.RepeatedSeparatorFound {
+		violations = append(violations, fmt.Sprintf("Invalid name: '%s' contains repeated separator '%s'", r.Name, r.RepeatedSeparator))
+	}
+
+	if !r.LeadingValid {
+		violations = append(violations, fmt.Sprintf("Invalid name: '%s' starts with a character denied by '%s'", r.Name, r.DenyLeading))
+	}
+
+	if !r.TrailingValid {
+		violations = append(violations, fmt.Sprintf("Invalid name: '%s' ends with a character denied by '%s'", r.Name, r.DenyTrailing))
+	}
+
+	if !r.UniqueSuffixValid {
+		violations = append(violations, fmt.Sprintf("Invalid name: '%s' keeps %d characters of the hash, but min_unique_suffix is set to %d", r.Name, r.UniqueSuffixLength, r.MinUniqueSuffix))
+	}
+
+	for _, pattern := range r.DeniedPatterns {
+		violations = append(violations, fmt.Sprintf("Invalid name: '%s' matches denied pattern '%s'", r.Name, pattern))
+	}
+
+	for _, word := range r.ReservedWords {
+		violations = append(violations, fmt.Sprintf("Invalid name: '%s' contains reserved word '%s'", r.Name, word))
+	}
+
+	if !r.SettingsRegexValid {
+		violations = append(violations, fmt.Sprintf("Invalid name: '%s' does not match validation_regex '%s' of the settings", r.Name, r.SettingsRegex))
+	}
+
+	if !r.RegexValid {
+		violations = append(violations, "Name does not match regex")
+	} else if !r.LengthValid {
+		if r.NameLength > r.MaxLength {
+			violations = append(violations, fmt.Sprintf("Name has %d characters, but maximum is set to %d", r.NameLength, r.MaxLength))
+		} else if r.NameLength < r.MinLength {
+			violations = append(violations, fmt.Sprintf("Name has %d characters, but minimum is set to %d", r.NameLength, r.MinLength))
+		}
+	}
+
+	return violations
+}
+
+// checkReservedWords records the Azure reserved words contained in the name.
+// Names of resource types of other platforms, e.g. kubernetes_namespace, are
+// not checked.
+func (r *validationResult) checkReservedWords(resourceType string) {
+	if !s.IsAzureResourceType(resourceType) {
+		r.ReservedWords = []string{}
+		return
+	}
+	r.ReservedWords = s.FindReservedWords(r.Name)
+}
+
+// checkSettingsRegex records whether the name matches the validation_regex
+// setting. The setting is checked in addition to the regex of the schema, so a
+// name must match both.
+func (r *validationResult) checkSettingsRegex(pattern string) error {
+	r.SettingsRegex = pattern
+	r.SettingsRegexValid = true
+	if pattern == "" {
+		return nil
+	}
+	re, err := compileValidationRegex(pattern)
+	if err != nil {
+		return err
+	}
+	r.SettingsRegexValid = re.MatchString(r.Name)
+	return nil
+}
+
+// checkUniqueSuffix records how many characters of the hash the name keeps. The
+// whole hash may be anywhere in the name, a hash cut off by truncating the name
+// counts with the characters left at the end of the name.
+func (r *validationResult) checkUniqueSuffix(hash string, minUniqueSuffix int) {
+	r.MinUniqueSuffix = int64(minUniqueSuffix)
+	name := strings.ToLower(r.Name)
+	hash = strings.ToLower(hash)
+
+	kept := 0
+	if hash != "" && strings.Contains(name, hash) {
+		kept = len(hash)
+	} else {
+		for n := len(hash) - 1; n > 0; n-- {
+			if strings.HasSuffix(name, hash[:n]) {
+				kept = n
+				break
+			}
+		}
+	}
+
+	r.UniqueSuffixLength = int64(kept)
+	r.UniqueSuffixValid = kept >= minUniqueSuffix
+}
+
+// validateName performs validation checks on a name and returns structured results.
+// denyPatterns are checked in addition to the deny patterns of the schema. The
+// separator is the separator of the name, used by the repeated separator check.
+func validateName(name string, schema *s.NamingSchema, denyPatterns []string, separator string) (*validationResult, error) {
 	result := &validationResult{
-		Name:              name,
-		NameLength:        int64(len(name)),
-		ValidationRegex:   tools.GetBaseString(schema.ValidationRegex),
-		MaxLength:         schema.MaxLength.ValueInt64(),
-		MinLength:         schema.MinLength.ValueInt64(),
-		DenyDoubleHyphens: schema.Configuration.DenyDoubleHyphens.ValueBool(),
-		RegexValid:        true,
-		LengthValid:       true,
+		Name:                  name,
+		NameLength:            int64(len(name)),
+		ValidationRegex:       tools.GetBaseString(schema.ValidationRegex),
+		MaxLength:             schema.MaxLength.ValueInt64(),
+		MinLength:             schema.MinLength.ValueInt64(),
+		DenyDoubleHyphens:     schema.Configuration.DenyDoubleHyphens.ValueBool(),
+		DenyRepeatedSeparator: schema.Configuration.DenyRepeatedSeparator.ValueBool(),
+		DenyLeading:           schema.Configuration.DenyLeading.ValueString(),
+		DenyTrailing:          schema.Configuration.DenyTrailing.ValueString(),
+		DeniedPatterns:        []string{},
+		ReservedWords:         []string{},
+		RegexValid:            true,
+		LengthValid:           true,
+		LeadingValid:          true,
+		TrailingValid:         true,
+		UniqueSuffixValid:     true,
+		SettingsRegexValid:    true,
 	}
 
 	// Check regex validation
-	re := regexp.MustCompile(result.ValidationRegex)
+	re, err := compileValidationRegex(result.ValidationRegex)
+	if err != nil {
+		return nil, err
+	}
 	if !re.MatchString(name) {
 		result.RegexValid = false
 	}
@@ -464,5 +1852,95 @@ func validateName(name string, schema *s.NamingSchema) *validationResult {
 	// Check for double hyphens
 	result.DoubleHyphensFound = strings.Contains(name, "--")
 
-	return result
+	// Check for repeated separators
+	if separator != "" {
+		result.RepeatedSeparator = separator + separator
+		result.RepeatedSeparatorFound = strings.Contains(name, result.RepeatedSeparator)
+	}
+
+	// Check the first and last character
+	if result.DenyLeading != "" {
+		re, err := compileCharacterRule("deny_leading", result.DenyLeading, "^(?:"+result.DenyLeading+")")
+		if err != nil {
+			return nil, err
+		}
+		result.LeadingValid = !re.MatchString(name)
+	}
+	if result.DenyTrailing != "" {
+		re, err := compileCharacterRule("deny_trailing", result.DenyTrailing, "(?:"+result.DenyTrailing+")$")
+		if err != nil {
+			return nil, err
+		}
+		result.TrailingValid = !re.MatchString(name)
+	}
+
+	// Check deny patterns
+	for _, pattern := range slices.Concat(denyPatterns, extractStringSlice(schema.Configuration.DenyPatterns)) {
+		re, err := compileDenyPattern(pattern)
+		if err != nil {
+			return nil, err
+		}
+		if re.MatchString(name) {
+			result.DeniedPatterns = append(result.DeniedPatterns, pattern)
+		}
+	}
+
+	return result, nil
+}
+
+// compatibilityMessage builds the name with the naming schema of the
+// compatibility library and describes the difference to the built name. It
+// returns an empty message if the resource type is not part of the
+// compatibility library or both names are equal.
+func (nb *nameBuilder) compatibilityMessage(nameType string, name types.String) (string, error) {
+	value, ok := nb.model.Configuration.Compatibility.Elements()[nameType]
+	if !ok {
+		return "", nil
+	}
+	obj, ok := value.(types.Object)
+	if !ok {
+		return "", nil
+	}
+
+	var compatibilitySchema s.NamingSchema
+	if diags := obj.As(nb.ctx, &compatibilitySchema, basetypes.ObjectAsOptions{}); diags.HasError() {
+		return "", fmt.Errorf("failed to parse compatibility schema for type '%s'", nameType)
+	}
+
+	// A name that cannot be built under the compatibility library has no
+	// previous value to compare against.
+	compatibilityResp := &function.RunResponse{}
+	compatibilityName := newNameBuilder(nb.ctx, nb.model, &compatibilitySchema, nb.buildNameSettings).buildName(name, compatibilityResp)
+	if compatibilityResp.Error != nil || compatibilityName.IsUnknown() || nb.result.Name.IsUnknown() {
+		return "", nil
+	}
+
+	if compatibilityName.ValueString() == nb.result.Name.ValueString() {
+		return "", nil
+	}
+	if nb.buildNameSettings.Sensitive {
+		return fmt.Sprintf("name of resource type '%s' changes under the compatibility schema", nameType), nil
+	}
+	return fmt.Sprintf("name of resource type '%s' changes from '%s' under the compatibility schema to '%s'",
+		nameType, compatibilityName.ValueString(), nb.result.Name.ValueString()), nil
+}
+
+// checkCompatibility reports names that differ under the compatibility library
+// as errors or, with compatibility_mode "warn", as warnings.
+func (nb *nameBuilder) checkCompatibility(nameType string, name types.String, resp *function.RunResponse) {
+	message, err := nb.compatibilityMessage(nameType, name)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, err.Error()))
+		return
+	}
+	if message == "" {
+		return
+	}
+
+	if nb.model.Configuration.CompatibilityMode.ValueString() == compatibilityModeWarn {
+		tflog.Warn(nb.ctx, message, map[string]interface{}{"name_type": nameType})
+		nb.warnings = append(nb.warnings, message)
+		return
+	}
+	resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(message))
 }
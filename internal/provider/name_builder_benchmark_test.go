@@ -0,0 +1,132 @@
+// Copyright glueckkanja AG 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	s "terraform-provider-standesamt/internal/schema"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// benchmarkSchemaTypes is the size of the schema library of the benchmarks,
+// about the size of a full azurerm and azapi library.
+const benchmarkSchemaTypes = 500
+
+// benchmarkResourceType is the resource type the benchmarks build names for.
+const benchmarkResourceType = "azurerm_type_250"
+
+// benchmarkSchemaMap returns a naming schema map with benchmarkSchemaTypes
+// resource types.
+func benchmarkSchemaMap() s.NamingSchemaMap {
+	schemas := make([]s.JsonNamingSchema, 0, benchmarkSchemaTypes)
+	for i := range benchmarkSchemaTypes {
+		schemas = append(schemas, s.JsonNamingSchema{
+			ResourceType:    fmt.Sprintf("azurerm_type_%d", i),
+			Abbreviation:    fmt.Sprintf("t%d", i),
+			MinLength:       1,
+			MaxLength:       63,
+			ValidationRegex: "^[a-z0-9-]{1,63}$",
+			Configuration: s.JsonConfigurationSchema{
+				UseEnvironment: true,
+				UseLowerCase:   true,
+				UseSeparator:   true,
+				NamePrecedence: []string{"abbreviation", "prefixes", "name", "location", "environment", "hash", "suffixes"},
+				HashLength:     4,
+			},
+		})
+	}
+	return s.NewNamingSchemaMap(schemas)
+}
+
+// benchmarkConfigurations returns the configurations argument of the naming
+// functions for the benchmark schema library.
+func benchmarkConfigurations(b testing.TB) *configurationsModel {
+	b.Helper()
+
+	data := providerData{}
+	data.configProviderDefaults()
+	configuration := data.configuration()
+	configuration.Environment = types.StringValue("prd")
+	configuration.Location = types.StringValue("westeurope")
+	configuration.Prefixes = types.ListValueMust(types.StringType, []attr.Value{types.StringValue("app"), types.StringValue("core")})
+	configuration.Suffixes = types.ListValueMust(types.StringType, []attr.Value{types.StringValue("001")})
+
+	schemaObjects := map[string]types.Object{}
+	for k, v := range benchmarkSchemaMap() {
+		obj, diags := types.ObjectValueFrom(context.Background(), s.SchemaTypeAttributes(), v)
+		if diags.HasError() {
+			b.Fatalf("failed to convert schema: %v", diags)
+		}
+		schemaObjects[k] = obj
+	}
+
+	return &configurationsModel{
+		Configuration: configuration,
+		Locations:     map[string]types.String{"westeurope": types.StringValue("we")},
+		Schema:        schemaObjects,
+	}
+}
+
+func BenchmarkParseArguments(b *testing.B) {
+	ctx := context.Background()
+	model := benchmarkConfigurations(b)
+	configurations, diags := types.ObjectValueFrom(ctx, configurationsTypeAttributes(), model)
+	if diags.HasError() {
+		b.Fatalf("failed to convert configurations: %v", diags)
+	}
+	settings := types.DynamicValue(types.ObjectValueMust(
+		map[string]attr.Type{"hash_length": types.NumberType},
+		map[string]attr.Value{"hash_length": types.NumberValue(big.NewFloat(6))},
+	))
+	req := function.RunRequest{
+		Arguments: function.NewArgumentsData([]attr.Value{
+			configurations, types.StringValue(benchmarkResourceType), settings, types.StringValue("billing"),
+		}),
+	}
+
+	b.ReportAllocs()
+	for b.Loop() {
+		resp := &function.RunResponse{}
+		if _, _, _, _, _, err := parseArguments(ctx, req, resp); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkBuildName(b *testing.B) {
+	ctx := context.Background()
+	model := benchmarkConfigurations(b)
+	var typeSchema s.NamingSchema
+	if diags := model.Schema[benchmarkResourceType].As(ctx, &typeSchema, basetypes.ObjectAsOptions{}); diags.HasError() {
+		b.Fatalf("failed to parse schema: %v", diags)
+	}
+
+	b.ReportAllocs()
+	for b.Loop() {
+		resp := &function.RunResponse{}
+		newNameBuilder(ctx, model, &typeSchema, &s.BuildNameSettingsModel{}).buildName(types.StringValue("billing"), resp)
+		if resp.Error != nil {
+			b.Fatal(resp.Error)
+		}
+	}
+}
+
+func BenchmarkValidateName(b *testing.B) {
+	typeSchema := benchmarkSchemaMap()[benchmarkResourceType]
+	typeSchema.Configuration.DenyPatterns = types.ListValueMust(types.StringType, []attr.Value{types.StringValue("(?i)microsoft")})
+
+	b.ReportAllocs()
+	for b.Loop() {
+		if _, err := validateName("t250-app-core-billing-we-prd-abcd-001", &typeSchema, []string{"^[0-9]"}, "-"); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -4,9 +4,15 @@
 package provider
 
 import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
 	"testing"
 
+	"terraform-provider-standesamt/internal/random"
 	s "terraform-provider-standesamt/internal/schema"
+	"terraform-provider-standesamt/internal/tools"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/function"
@@ -38,6 +44,14 @@ func TestExtractStringSlice(t *testing.T) {
 			list:     types.ListNull(types.StringType),
 			expected: nil,
 		},
+		{
+			name: "list of numbers",
+			list: types.ListValueMust(types.NumberType, []attr.Value{
+				types.NumberValue(big.NewFloat(1)),
+				types.NumberValue(big.NewFloat(2.5)),
+			}),
+			expected: []string{"1", "2.5"},
+		},
 	}
 
 	for _, tt := range tests {
@@ -105,6 +119,113 @@ func TestParseSettingsFromDynamic(t *testing.T) {
 			dynamic: types.DynamicValue(types.StringValue("not an object")),
 			wantErr: true,
 		},
+		{
+			name: "prefixes and suffixes with numbers and bools",
+			dynamic: types.DynamicValue(types.ObjectValueMust(
+				map[string]attr.Type{
+					"prefixes": types.TupleType{ElemTypes: []attr.Type{types.NumberType, types.StringType}},
+					"suffixes": types.TupleType{ElemTypes: []attr.Type{types.BoolType, types.NumberType}},
+				},
+				map[string]attr.Value{
+					"prefixes": types.TupleValueMust(
+						[]attr.Type{types.NumberType, types.StringType},
+						[]attr.Value{types.NumberValue(big.NewFloat(1)), types.StringValue("a")},
+					),
+					"suffixes": types.TupleValueMust(
+						[]attr.Type{types.BoolType, types.NumberType},
+						[]attr.Value{types.BoolValue(true), types.NumberValue(big.NewFloat(2))},
+					),
+				},
+			)),
+			wantErr: false,
+			checkResult: func(t *testing.T, result *parseSettingsResult) {
+				assert.Equal(t, []string{"1", "a"}, result.settings.Prefixes)
+				assert.Equal(t, []string{"true", "2"}, result.settings.Suffixes)
+			},
+		},
+		{
+			name: "unknown key",
+			dynamic: types.DynamicValue(types.ObjectValueMust(
+				map[string]attr.Type{"seperator": types.StringType},
+				map[string]attr.Value{"seperator": types.StringValue("_")},
+			)),
+			wantErr: true,
+		},
+		{
+			name: "type mismatch",
+			dynamic: types.DynamicValue(types.ObjectValueMust(
+				map[string]attr.Type{"lowercase": types.StringType},
+				map[string]attr.Value{"lowercase": types.StringValue("yes")},
+			)),
+			wantErr: true,
+		},
+		{
+			name:    "empty object",
+			dynamic: types.DynamicValue(types.ObjectValueMust(map[string]attr.Type{}, map[string]attr.Value{})),
+			wantErr: false,
+			checkResult: func(t *testing.T, result *parseSettingsResult) {
+				assert.Equal(t, s.BuildNameSettingsModel{}, *result.settings)
+			},
+		},
+		{
+			name: "map of strings",
+			dynamic: types.DynamicValue(types.MapValueMust(types.StringType, map[string]attr.Value{
+				"environment": types.StringValue("prod"),
+				"hash_length": types.StringValue("4"),
+				"lowercase":   types.StringValue("true"),
+				"prefixes":    types.StringValue("app, core"),
+				"suffixes":    types.StringValue(""),
+			})),
+			wantErr: false,
+			checkResult: func(t *testing.T, result *parseSettingsResult) {
+				assert.Equal(t, "prod", result.settings.Environment)
+				assert.Equal(t, int32(4), result.settings.HashLength)
+				assert.True(t, result.settings.Lowercase)
+				assert.Equal(t, []string{"app", "core"}, result.settings.Prefixes)
+				assert.Empty(t, result.settings.Suffixes)
+			},
+		},
+		{
+			name: "map with unparsable number",
+			dynamic: types.DynamicValue(types.MapValueMust(types.StringType, map[string]attr.Value{
+				"hash_length": types.StringValue("four"),
+			})),
+			wantErr: true,
+		},
+		{
+			name: "map with unknown key",
+			dynamic: types.DynamicValue(types.MapValueMust(types.StringType, map[string]attr.Value{
+				"seperator": types.StringValue("_"),
+			})),
+			wantErr: true,
+		},
+		{
+			name: "prefixes with unsupported element",
+			dynamic: types.DynamicValue(types.ObjectValueMust(
+				map[string]attr.Type{
+					"prefixes": types.TupleType{ElemTypes: []attr.Type{types.StringType, types.ListType{ElemType: types.StringType}}},
+				},
+				map[string]attr.Value{
+					"prefixes": types.TupleValueMust(
+						[]attr.Type{types.StringType, types.ListType{ElemType: types.StringType}},
+						[]attr.Value{types.StringValue("a"), types.ListValueMust(types.StringType, []attr.Value{})},
+					),
+				},
+			)),
+			wantErr: true,
+		},
+		{
+			name: "name_precedence with unknown token",
+			dynamic: types.DynamicValue(types.ObjectValueMust(
+				map[string]attr.Type{
+					"name_precedence": types.ListType{ElemType: types.StringType},
+				},
+				map[string]attr.Value{
+					"name_precedence": types.ListValueMust(types.StringType, []attr.Value{types.StringValue("abreviation"), types.StringValue("name")}),
+				},
+			)),
+			wantErr: true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -294,3 +415,1004 @@ func TestParseArguments_MissingResourceType(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateName_InvalidRegex(t *testing.T) {
+	schema := &s.NamingSchema{
+		ValidationRegex: types.StringValue("^[a-z+$"),
+		MinLength:       types.Int64Value(1),
+		MaxLength:       types.Int64Value(10),
+	}
+
+	result, err := validateName("test", schema, nil, "-")
+	assert.Nil(t, result)
+	assert.ErrorContains(t, err, "invalid validation regex '^[a-z+$'")
+}
+
+func TestCompileValidationRegex_Cached(t *testing.T) {
+	first, err := compileValidationRegex("^[a-z]+$")
+	assert.NoError(t, err)
+
+	second, err := compileValidationRegex("^[a-z]+$")
+	assert.NoError(t, err)
+	assert.Same(t, first, second)
+}
+
+func makeTestBuilderForBudget(precedence []string, settings *s.BuildNameSettingsModel) *nameBuilder {
+	precedenceElements := make([]attr.Value, 0, len(precedence))
+	for _, p := range precedence {
+		precedenceElements = append(precedenceElements, types.StringValue(p))
+	}
+
+	return newNameBuilder(context.Background(), &configurationsModel{
+		Configuration: configurationModel{
+			Convention:  types.StringValue("default"),
+			Environment: types.StringValue("tst"),
+			Separator:   types.StringValue("-"),
+			RandomSeed:  types.Int64Value(1337),
+			HashLength:  types.Int32Value(0),
+			Prefixes:    types.ListValueMust(types.StringType, []attr.Value{types.StringValue("app")}),
+			Suffixes:    types.ListValueMust(types.StringType, []attr.Value{}),
+			Location:    types.StringValue("westeurope"),
+		},
+		Locations: map[string]types.String{"westeurope": types.StringValue("we")},
+	}, &s.NamingSchema{
+		Abbreviation: types.StringValue("st"),
+		MaxLength:    types.Int64Value(24),
+		Configuration: s.Configuration{
+			UseEnvironment: types.BoolValue(true),
+			UseSeparator:   types.BoolValue(true),
+			NamePrecedence: types.ListValueMust(types.StringType, precedenceElements),
+			HashLength:     types.Int32Value(4),
+		},
+	}, settings)
+}
+
+func TestNameBudget(t *testing.T) {
+	tests := []struct {
+		name       string
+		precedence []string
+		settings   *s.BuildNameSettingsModel
+		want       int64
+	}{
+		{
+			name:       "all segments",
+			precedence: s.DefaultNamePrecedence[:],
+			settings:   &s.BuildNameSettingsModel{},
+			// 24 - len("st-app--we-tst-xxxx")
+			want: 5,
+		},
+		{
+			name:       "name only",
+			precedence: []string{"name"},
+			settings:   &s.BuildNameSettingsModel{},
+			want:       24,
+		},
+		{
+			name:       "name not in precedence",
+			precedence: []string{"abbreviation", "location"},
+			settings:   &s.BuildNameSettingsModel{},
+			want:       0,
+		},
+		{
+			name:       "passthrough convention",
+			precedence: s.DefaultNamePrecedence[:],
+			settings:   &s.BuildNameSettingsModel{Convention: "passthrough"},
+			want:       24,
+		},
+		{
+			name:       "exceeded budget",
+			precedence: s.DefaultNamePrecedence[:],
+			settings:   &s.BuildNameSettingsModel{Prefixes: []string{"averyveryverylongprefix"}},
+			want:       -15,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := &function.RunResponse{}
+			nb := makeTestBuilderForBudget(tt.precedence, tt.settings)
+			assert.Equal(t, tt.want, nb.nameBudget(resp))
+			assert.Nil(t, resp.Error)
+		})
+	}
+}
+
+func TestIsStrict(t *testing.T) {
+	enabled, disabled := true, false
+
+	tests := []struct {
+		name     string
+		config   types.Bool
+		settings *bool
+		want     bool
+	}{
+		{name: "defaults to strict", config: types.BoolNull(), want: true},
+		{name: "configuration disables strict", config: types.BoolValue(false), want: false},
+		{name: "settings disable strict", config: types.BoolValue(true), settings: &disabled, want: false},
+		{name: "settings enable strict", config: types.BoolValue(false), settings: &enabled, want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			nb := &nameBuilder{
+				model:             &configurationsModel{Configuration: configurationModel{Strict: tt.config}},
+				buildNameSettings: &s.BuildNameSettingsModel{Strict: tt.settings},
+			}
+			assert.Equal(t, tt.want, nb.isStrict())
+		})
+	}
+}
+
+func TestValidationResultViolations(t *testing.T) {
+	schema := &s.NamingSchema{
+		ValidationRegex: types.StringValue("^[a-z-]+$"),
+		MinLength:       types.Int64Value(1),
+		MaxLength:       types.Int64Value(10),
+		Configuration: s.Configuration{
+			DenyDoubleHyphens: types.BoolValue(true),
+		},
+	}
+
+	valid, err := validateName("rg-app", schema, nil, "-")
+	assert.NoError(t, err)
+	assert.Empty(t, valid.violations())
+
+	invalid, err := validateName("rg--too-long-name", schema, nil, "-")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{
+		"Invalid name: 'rg--too-long-name' contains double hyphens",
+		"Name has 17 characters, but maximum is set to 10",
+	}, invalid.violations())
+}
+
+func TestValidateName_RepeatedSeparator(t *testing.T) {
+	schema := &s.NamingSchema{
+		ValidationRegex: types.StringValue("^[a-z_-]+$"),
+		MinLength:       types.Int64Value(1),
+		MaxLength:       types.Int64Value(30),
+		Configuration: s.Configuration{
+			DenyRepeatedSeparator: types.BoolValue(true),
+		},
+	}
+
+	result, err := validateName("rg__app", schema, nil, "_")
+	assert.NoError(t, err)
+	assert.True(t, result.RepeatedSeparatorFound)
+	assert.Equal(t, []string{"Invalid name: 'rg__app' contains repeated separator '__'"}, result.violations())
+
+	result, err = validateName("rg--app", schema, nil, "_")
+	assert.NoError(t, err)
+	assert.Empty(t, result.violations())
+
+	result, err = validateName("rg__app", schema, nil, "")
+	assert.NoError(t, err)
+	assert.False(t, result.RepeatedSeparatorFound)
+}
+
+func TestValidateName_LeadingTrailing(t *testing.T) {
+	schema := &s.NamingSchema{
+		ValidationRegex: types.StringValue("^[a-z0-9-]+$"),
+		MinLength:       types.Int64Value(1),
+		MaxLength:       types.Int64Value(30),
+		Configuration: s.Configuration{
+			DenyLeading:  types.StringValue("[0-9]"),
+			DenyTrailing: types.StringValue("[-.]"),
+		},
+	}
+
+	result, err := validateName("1-app-", schema, nil, "-")
+	assert.NoError(t, err)
+	assert.False(t, result.LeadingValid)
+	assert.False(t, result.TrailingValid)
+	assert.Equal(t, []string{
+		"Invalid name: '1-app-' starts with a character denied by '[0-9]'",
+		"Invalid name: '1-app-' ends with a character denied by '[-.]'",
+	}, result.violations())
+
+	result, err = validateName("app-1", schema, nil, "-")
+	assert.NoError(t, err)
+	assert.True(t, result.LeadingValid)
+	assert.True(t, result.TrailingValid)
+	assert.Empty(t, result.violations())
+
+	schema.Configuration.DenyLeading = types.StringValue("[0-9")
+	_, err = validateName("app", schema, nil, "-")
+	assert.ErrorContains(t, err, "invalid deny_leading character class '[0-9'")
+}
+
+func TestValidateName_UniqueSuffix(t *testing.T) {
+	schema := &s.NamingSchema{
+		ValidationRegex: types.StringValue("^[a-z0-9-]+$"),
+		MinLength:       types.Int64Value(1),
+		MaxLength:       types.Int64Value(30),
+	}
+
+	result, err := validateName("st-app-abcd-we", schema, nil, "-")
+	assert.NoError(t, err)
+	result.checkUniqueSuffix("ABCD", 4)
+	assert.Equal(t, int64(4), result.UniqueSuffixLength)
+	assert.Empty(t, result.violations())
+
+	// A hash cut off by truncating the name counts with the remaining characters.
+	result, err = validateName("st-app-ab", schema, nil, "-")
+	assert.NoError(t, err)
+	result.checkUniqueSuffix("abcd", 4)
+	assert.Equal(t, int64(2), result.UniqueSuffixLength)
+	assert.Equal(t, []string{"Invalid name: 'st-app-ab' keeps 2 characters of the hash, but min_unique_suffix is set to 4"}, result.violations())
+
+	result, err = validateName("st-app", schema, nil, "-")
+	assert.NoError(t, err)
+	result.checkUniqueSuffix("", 0)
+	assert.True(t, result.UniqueSuffixValid)
+}
+
+func TestHash_SeedKey(t *testing.T) {
+	hash := func(settings *s.BuildNameSettingsModel) string {
+		nb := makeTestBuilderForBudget(s.DefaultNamePrecedence[:], settings)
+		resp := &function.RunResponse{}
+		nb.buildName(types.StringValue("app"), resp)
+		assert.Nil(t, resp.Error)
+		return nb.hashSegment()
+	}
+
+	plain := hash(&s.BuildNameSettingsModel{})
+	first := hash(&s.BuildNameSettingsModel{SeedKey: "billing-prd"})
+	second := hash(&s.BuildNameSettingsModel{SeedKey: "shop-prd"})
+
+	assert.NotEqual(t, plain, first)
+	assert.NotEqual(t, first, second)
+	assert.Equal(t, first, hash(&s.BuildNameSettingsModel{SeedKey: "billing-prd"}))
+}
+
+func TestExpectedHash(t *testing.T) {
+	nb := makeTestBuilderForBudget(s.DefaultNamePrecedence[:], &s.BuildNameSettingsModel{TruncateKeepHash: true})
+	resp := &function.RunResponse{}
+	nb.buildName(types.StringValue("averyverylongapplicationname"), resp)
+	assert.Nil(t, resp.Error)
+
+	// The hash survives truncation and matches the hash recomputed for a raw name.
+	assert.True(t, nb.truncated)
+	hash := nb.hashSegment()
+	assert.Len(t, hash, 4)
+	assert.True(t, strings.HasSuffix(nb.result.Name.ValueString(), hash))
+
+	raw := makeTestBuilderForBudget(s.DefaultNamePrecedence[:], &s.BuildNameSettingsModel{})
+	expected, err := raw.expectedHash()
+	assert.NoError(t, err)
+	assert.Equal(t, hash, expected)
+
+	derived := makeTestBuilderForBudget(s.DefaultNamePrecedence[:], &s.BuildNameSettingsModel{HashMode: hashModeDerived})
+	_, err = derived.expectedHash()
+	assert.ErrorContains(t, err, "min_unique_suffix cannot be checked for a raw name")
+}
+
+func TestValidateName_DenyPatterns(t *testing.T) {
+	schema := &s.NamingSchema{
+		ValidationRegex: types.StringValue("^[a-z0-9-]+$"),
+		MinLength:       types.Int64Value(1),
+		MaxLength:       types.Int64Value(30),
+		Configuration: s.Configuration{
+			DenyPatterns: types.ListValueMust(types.StringType, []attr.Value{types.StringValue("^[0-9]")}),
+		},
+	}
+
+	result, err := validateName("1-microsoft-app", schema, []string{"(?i)microsoft", "windows"}, "-")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"(?i)microsoft", "^[0-9]"}, result.DeniedPatterns)
+	assert.Equal(t, []string{
+		"Invalid name: '1-microsoft-app' matches denied pattern '(?i)microsoft'",
+		"Invalid name: '1-microsoft-app' matches denied pattern '^[0-9]'",
+	}, result.violations())
+
+	result, err = validateName("app", schema, nil, "-")
+	assert.NoError(t, err)
+	assert.Empty(t, result.DeniedPatterns)
+
+	_, err = validateName("app", schema, []string{"("}, "-")
+	assert.ErrorContains(t, err, "invalid deny pattern '('")
+}
+
+func TestValidationResultViolations_ReservedWords(t *testing.T) {
+	schema := &s.NamingSchema{
+		ValidationRegex: types.StringValue("^[a-z-]+$"),
+		MinLength:       types.Int64Value(1),
+		MaxLength:       types.Int64Value(30),
+	}
+
+	result, err := validateName("prod-login-app", schema, nil, "-")
+	assert.NoError(t, err)
+	assert.Empty(t, result.violations())
+
+	result.checkReservedWords("azurerm_resource_group")
+	assert.Equal(t, []string{"Invalid name: 'prod-login-app' contains reserved word 'LOGIN'"}, result.violations())
+
+	// The reserved words only apply to Azure resource types.
+	result.checkReservedWords("kubernetes_namespace")
+	assert.Empty(t, result.violations())
+}
+
+func TestApplyPreset(t *testing.T) {
+	settings := &s.BuildNameSettingsModel{Preset: "global_unique", HashLength: 6}
+	assert.NoError(t, applyPreset(settings, map[string]attr.Value{"hash_length": types.NumberValue(big.NewFloat(6))}))
+	assert.False(t, *settings.UseSeparator)
+	assert.True(t, settings.Lowercase)
+	assert.Equal(t, int32(6), settings.HashLength)
+	assert.Equal(t, hashModeDerived, settings.HashMode)
+	assert.Equal(t, hashCharsetAlphanumeric, settings.HashCharset)
+	assert.True(t, settings.TruncateKeepHash)
+
+	withSeparator := &s.BuildNameSettingsModel{Preset: "global_unique", Separator: "-"}
+	assert.NoError(t, applyPreset(withSeparator, map[string]attr.Value{"separator": types.StringValue("-")}))
+	assert.Nil(t, withSeparator.UseSeparator)
+
+	assert.ErrorContains(t, applyPreset(&s.BuildNameSettingsModel{Preset: "unknown"}, nil), "unknown preset 'unknown', expected one of: global_unique")
+}
+
+func TestParseSettingsFromDynamic_PresetExplicitSettings(t *testing.T) {
+	settings, err := parseSettingsFromDynamic(types.DynamicValue(types.ObjectValueMust(
+		map[string]attr.Type{
+			"preset":             types.StringType,
+			"hash_length":        types.NumberType,
+			"lowercase":          types.BoolType,
+			"truncate_keep_hash": types.BoolType,
+		},
+		map[string]attr.Value{
+			"preset":             types.StringValue("global_unique"),
+			"hash_length":        types.NumberValue(big.NewFloat(0)),
+			"lowercase":          types.BoolValue(false),
+			"truncate_keep_hash": types.BoolValue(false),
+		},
+	)))
+	assert.NoError(t, err)
+	// Explicit settings win even if they are the zero value, absent settings
+	// are taken from the preset.
+	assert.Equal(t, int32(0), settings.HashLength)
+	assert.False(t, settings.Lowercase)
+	assert.False(t, settings.TruncateKeepHash)
+	assert.Equal(t, hashModeDerived, settings.HashMode)
+	assert.False(t, *settings.UseSeparator)
+}
+
+func TestValidateHashSettings(t *testing.T) {
+	assert.NoError(t, validateHashSettings(&s.BuildNameSettingsModel{HashMode: "derived", HashCharset: "alphanumeric"}))
+	assert.NoError(t, validateHashSettings(&s.BuildNameSettingsModel{HashCharset: "auto"}))
+	assert.ErrorContains(t, validateHashSettings(&s.BuildNameSettingsModel{HashMode: "other"}), "invalid hash_mode 'other'")
+	assert.ErrorContains(t, validateHashSettings(&s.BuildNameSettingsModel{HashCharset: "other"}), "invalid hash_charset 'other'")
+	assert.NoError(t, validateHashSettings(&s.BuildNameSettingsModel{HashMode: "derived", CollisionDomain: "resource_group:rg-app-prod"}))
+	assert.ErrorContains(t, validateHashSettings(&s.BuildNameSettingsModel{HashMode: "derived", CollisionDomain: "rg-app-prod"}), "expected <kind>:<id>")
+	assert.ErrorContains(t, validateHashSettings(&s.BuildNameSettingsModel{CollisionDomain: "resource_group:rg-app-prod"}), "requires hash_mode 'derived'")
+}
+
+func TestBuildName_DerivedHash(t *testing.T) {
+	build := func(name string, hashMode string) string {
+		nb := makeTestBuilderForBudget(s.DefaultNamePrecedence[:], &s.BuildNameSettingsModel{HashMode: hashMode})
+		resp := &function.RunResponse{}
+		result := nb.buildName(types.StringValue(name), resp)
+		assert.Nil(t, resp.Error)
+		return result.ValueString()
+	}
+
+	randomFirst, randomSecond := build("one", hashModeRandom), build("two", hashModeRandom)
+	assert.Equal(t, randomFirst[len(randomFirst)-4:], randomSecond[len(randomSecond)-4:])
+
+	derivedFirst, derivedSecond := build("one", hashModeDerived), build("two", hashModeDerived)
+	assert.NotEqual(t, derivedFirst[len(derivedFirst)-4:], derivedSecond[len(derivedSecond)-4:])
+	assert.Equal(t, derivedFirst, build("one", hashModeDerived))
+}
+
+func TestBuildName_CollisionDomain(t *testing.T) {
+	build := func(name, domain string) string {
+		nb := makeTestBuilderForBudget(s.DefaultNamePrecedence[:], &s.BuildNameSettingsModel{HashMode: hashModeDerived, CollisionDomain: domain})
+		resp := &function.RunResponse{}
+		result := nb.buildName(types.StringValue(name), resp)
+		assert.Nil(t, resp.Error)
+		return result.ValueString()
+	}
+
+	assert.Equal(t, build("app", "resource_group:rg-app-prod"), build("app", "resource_group:rg-app-prod"))
+	assert.NotEqual(t, build("app", "resource_group:rg-app-prod"), build("app", "resource_group:rg-app-dev"))
+	// Names without a domain keep their hash, a domain salts it.
+	assert.NotEqual(t, build("app", ""), build("app", "resource_group:rg-app-prod"))
+}
+
+func TestNameBuilder_Redact(t *testing.T) {
+	build := func(sensitive bool) *nameBuilder {
+		nb := makeTestBuilderForBudget(s.DefaultNamePrecedence[:], &s.BuildNameSettingsModel{Sensitive: sensitive})
+		resp := &function.RunResponse{}
+		nb.buildName(types.StringValue("secret"), resp)
+		assert.Nil(t, resp.Error)
+		return nb
+	}
+
+	nb := build(true)
+	message := fmt.Sprintf("Policy violation: '%s' is missing required segment 'environment', name 'secret', prefix 'app'", nb.result.Name.ValueString())
+	assert.Equal(t, "Policy violation: '(sensitive)' is missing required segment 'environment', name '(sensitive)', prefix '(sensitive)'", nb.redact(message, types.StringValue("secret")))
+
+	// Without the setting messages are left as is.
+	assert.Equal(t, message, build(false).redact(message, types.StringValue("secret")))
+}
+
+func TestBuildName_GlobalUniquePreset(t *testing.T) {
+	settings := &s.BuildNameSettingsModel{Preset: "global_unique"}
+	assert.NoError(t, applyPreset(settings, nil))
+
+	nb := makeTestBuilderForBudget(s.DefaultNamePrecedence[:], settings)
+	resp := &function.RunResponse{}
+	result := nb.buildName(types.StringValue("AVeryVeryLongApplicationName"), resp)
+	assert.Nil(t, resp.Error)
+
+	name := result.ValueString()
+	assert.Len(t, name, 24)
+	assert.Regexp(t, "^stappaveryverylwetst[a-z0-9]{4}$", name)
+	assert.NotContains(t, name, "-")
+	assert.Equal(t, "hash", nb.segments[len(nb.segments)-1].Type)
+	assert.Equal(t, nb.segments[len(nb.segments)-1].Value, name[20:])
+}
+
+func TestBuildName_AutoHashCharset(t *testing.T) {
+	build := func(regex string, settings *s.BuildNameSettingsModel) (*nameBuilder, string) {
+		nb := makeTestBuilderForBudget(s.DefaultNamePrecedence[:], settings)
+		nb.typeSchema.ValidationRegex = types.StringValue(regex)
+		resp := &function.RunResponse{}
+		result := nb.buildName(types.StringValue("app"), resp)
+		assert.Nil(t, resp.Error)
+		return nb, result.ValueString()
+	}
+
+	// A regex that only allows lowercase letters gives the default hash.
+	_, lowercase := build("^[a-z-]+$", &s.BuildNameSettingsModel{})
+	_, auto := build("^[a-z-]+$", &s.BuildNameSettingsModel{HashCharset: hashCharsetAuto})
+	assert.Equal(t, lowercase, auto)
+
+	nb, name := build("^[a-zA-Z0-9-]+$", &s.BuildNameSettingsModel{HashCharset: hashCharsetAuto})
+	assert.Equal(t, random.Lowercase+random.Uppercase+random.Digits, nb.autoHashCharset(nb.segments))
+	assert.Regexp(t, "^st-app-app-we-tst-[a-zA-Z0-9]{4}$", name)
+
+	// The casing of the name removes duplicates.
+	nb, _ = build("^[a-zA-Z0-9-]+$", &s.BuildNameSettingsModel{HashCharset: hashCharsetAuto, Lowercase: true})
+	assert.Equal(t, random.Lowercase+random.Digits, nb.autoHashCharset(nb.segments))
+
+	// Digits are allowed in the middle of the name but not at its end.
+	nb, _ = build("^[a-z][a-z0-9-]*[a-z]$", &s.BuildNameSettingsModel{HashCharset: hashCharsetAuto})
+	assert.Equal(t, random.Lowercase, nb.autoHashCharset(nb.segments))
+	nb.segments = append(nb.segments, nameSegment{Type: "suffix", Value: "x"})
+	assert.Equal(t, random.Lowercase+random.Digits, nb.autoHashCharset(nb.segments))
+
+	// Without segments the hash is probed on its own.
+	nb, _ = build("^[A-Z0-9]+$", &s.BuildNameSettingsModel{HashCharset: hashCharsetAuto})
+	assert.Equal(t, random.Uppercase+random.Digits, nb.autoHashCharset(nil))
+}
+
+func TestCheckSettingsKeys(t *testing.T) {
+	err := checkSettingsKeys(map[string]attr.Value{
+		"seperator": types.StringValue("_"),
+	}, slugSettingsKeys)
+	assert.EqualError(t, err, "unknown setting 'seperator', expected one of: lowercase, max_length, separator, transliterate")
+
+	err = checkSettingsKeys(map[string]attr.Value{
+		"hash_length": types.StringValue("4"),
+	}, nameSettingsKeys)
+	assert.EqualError(t, err, "setting 'hash_length' must be a number")
+
+	err = checkSettingsKeys(map[string]attr.Value{
+		"hash_length": types.NumberNull(),
+		"prefixes":    types.TupleValueMust([]attr.Type{types.StringType}, []attr.Value{types.StringValue("a")}),
+		"separator":   types.StringUnknown(),
+	}, nameSettingsKeys)
+	assert.NoError(t, err)
+}
+
+func TestSettingInt(t *testing.T) {
+	attrs := map[string]attr.Value{
+		"int32":    types.Int32Value(4),
+		"int64":    types.Int64Value(-4),
+		"number":   types.NumberValue(big.NewFloat(8)),
+		"fraction": types.NumberValue(big.NewFloat(1.5)),
+		"null":     types.NumberNull(),
+	}
+
+	v, ok, err := settingInt(attrs, "int32", 0, maxHashLength)
+	assert.Equal(t, []any{int64(4), true, nil}, []any{v, ok, err})
+	v, ok, err = settingInt(attrs, "number", 0, maxHashLength)
+	assert.Equal(t, []any{int64(8), true, nil}, []any{v, ok, err})
+	_, ok, err = settingInt(attrs, "null", 0, maxHashLength)
+	assert.False(t, ok)
+	assert.NoError(t, err)
+	_, ok, err = settingInt(attrs, "missing", 0, maxHashLength)
+	assert.False(t, ok)
+	assert.NoError(t, err)
+
+	_, _, err = settingInt(attrs, "int64", 0, maxHashLength)
+	assert.EqualError(t, err, "setting 'int64' must be a whole number between 0 and 64, got -4")
+	_, _, err = settingInt(attrs, "fraction", 0, maxHashLength)
+	assert.EqualError(t, err, "setting 'fraction' must be a whole number between 0 and 64, got 1.5")
+}
+
+func TestParseSettingsFromDynamic_NumberBounds(t *testing.T) {
+	parse := func(key string, value attr.Value) error {
+		_, err := parseSettingsFromDynamic(types.DynamicValue(types.ObjectValueMust(
+			map[string]attr.Type{key: value.Type(context.Background())},
+			map[string]attr.Value{key: value},
+		)))
+		return err
+	}
+
+	assert.NoError(t, parse("hash_length", types.NumberValue(big.NewFloat(64))))
+	assert.EqualError(t, parse("hash_length", types.NumberValue(big.NewFloat(65))), "setting 'hash_length' must be a whole number between 0 and 64, got 65")
+	assert.EqualError(t, parse("hash_length", types.NumberValue(big.NewFloat(-1))), "setting 'hash_length' must be a whole number between 0 and 64, got -1")
+	assert.EqualError(t, parse("max_length", types.NumberValue(big.NewFloat(-1))), "setting 'max_length' must be a whole number between 0 and 2147483647, got -1")
+
+	assert.NoError(t, parse("random_seed", types.NumberValue(big.NewFloat(-42))))
+	assert.EqualError(t, parse("random_seed", types.NumberValue(big.NewFloat(0))), "setting 'random_seed' must not be 0")
+	assert.ErrorContains(t, parse("random_seed", types.NumberValue(big.NewFloat(1e30))), "setting 'random_seed' must be a whole number")
+}
+
+func TestParseSettingsFromDynamic_ValidationSettings(t *testing.T) {
+	settings, err := parseSettingsFromDynamic(types.DynamicValue(types.ObjectValueMust(
+		map[string]attr.Type{"deny_double_hyphens": types.BoolType, "validation_regex": types.StringType},
+		map[string]attr.Value{"deny_double_hyphens": types.BoolValue(true), "validation_regex": types.StringValue("^[a-z-]+$")},
+	)))
+	assert.NoError(t, err)
+	assert.True(t, settings.DenyDoubleHyphens)
+	assert.Equal(t, "^[a-z-]+$", settings.ValidationRegex)
+
+	_, err = parseSettingsFromDynamic(types.DynamicValue(types.ObjectValueMust(
+		map[string]attr.Type{"validation_regex": types.StringType},
+		map[string]attr.Value{"validation_regex": types.StringValue("^[a-z")},
+	)))
+	assert.ErrorContains(t, err, "setting 'validation_regex': invalid validation regex '^[a-z'")
+}
+
+func TestParseConfigurations_UnknownValues(t *testing.T) {
+	ctx := context.Background()
+	configurationsType := configurationsTypeAttributes()
+
+	resp := &function.RunResponse{}
+	_, _, _, err := parseConfigurations(ctx, types.ObjectUnknown(configurationsType), "azurerm_resource_group", types.DynamicNull(), resp)
+	assert.ErrorIs(t, err, errUnknownArguments)
+	assert.Nil(t, resp.Error)
+
+	settings := types.DynamicValue(types.ObjectValueMust(
+		map[string]attr.Type{"location": types.StringType},
+		map[string]attr.Value{"location": types.StringUnknown()},
+	))
+	resp = &function.RunResponse{}
+	_, _, _, err = parseConfigurations(ctx, types.ObjectNull(configurationsType), "azurerm_resource_group", settings, resp)
+	assert.ErrorIs(t, err, errUnknownArguments)
+	assert.Nil(t, resp.Error)
+
+	assert.False(t, isWhollyKnown(ctx, settings))
+	assert.False(t, isWhollyKnown(ctx, types.DynamicUnknown()))
+	assert.True(t, isWhollyKnown(ctx, types.DynamicNull()))
+	assert.True(t, isWhollyKnown(ctx, types.StringValue("app")))
+}
+
+func TestValidatePolicy(t *testing.T) {
+	assert.NoError(t, validatePolicy([]string{"environment", "location", "prefixes"}, "^(app|core)$"))
+	assert.ErrorContains(t, validatePolicy([]string{"region"}, ""), "unknown required segment 'region'")
+	assert.ErrorContains(t, validatePolicy(nil, "^[a-z"), "invalid required prefix regex '^[a-z'")
+}
+
+func TestPolicyViolations(t *testing.T) {
+	build := func(requiredSegments []string, requiredPrefixRegex string) []string {
+		segments := make([]attr.Value, 0, len(requiredSegments))
+		for _, segment := range requiredSegments {
+			segments = append(segments, types.StringValue(segment))
+		}
+
+		nb := makeTestBuilderForBudget([]string{"abbreviation", "prefixes", "name", "environment"}, &s.BuildNameSettingsModel{})
+		nb.model.Configuration.RequiredSegments = types.ListValueMust(types.StringType, segments)
+		nb.model.Configuration.RequiredPrefixRegex = types.StringValue(requiredPrefixRegex)
+
+		resp := &function.RunResponse{}
+		nb.buildName(types.StringValue("test"), resp)
+		assert.Nil(t, resp.Error)
+
+		violations, err := nb.policyViolations()
+		assert.NoError(t, err)
+		return violations
+	}
+
+	assert.Empty(t, build([]string{"environment", "prefixes"}, "^app$"))
+	assert.Equal(t, []string{
+		"Policy violation: 'st-app-test-tst' is missing required segment 'location'",
+		"Policy violation: no prefix of 'st-app-test-tst' matches required prefix regex '^core$'",
+	}, build([]string{"environment", "location"}, "^core$"))
+}
+
+func TestDeprecationMessage(t *testing.T) {
+	assert.Empty(t, deprecationMessage("azurerm_resource_group", &s.NamingSchema{Deprecated: types.BoolValue(false)}))
+	assert.Equal(t, "resource type 'azurerm_app_service' is deprecated, use 'azurerm_linux_web_app' instead",
+		deprecationMessage("azurerm_app_service", &s.NamingSchema{Deprecated: types.BoolValue(true), ReplacedBy: types.StringValue("azurerm_linux_web_app")}))
+	assert.Equal(t, "resource type 'azurerm_app_service' is deprecated",
+		deprecationMessage("azurerm_app_service", &s.NamingSchema{Deprecated: types.BoolValue(true), ReplacedBy: types.StringValue("")}))
+}
+
+func TestResolveHashLength_GlobalScope(t *testing.T) {
+	resolve := func(scope string, minLength, configured int32, settings *s.BuildNameSettingsModel) int32 {
+		nb := makeTestBuilderForBudget(s.DefaultNamePrecedence[:], settings)
+		nb.typeSchema.Scope = types.StringValue(scope)
+		nb.typeSchema.Configuration.HashLength = types.Int32Value(0)
+		nb.model.Configuration.HashLength = types.Int32Value(configured)
+		nb.model.Configuration.MinGlobalHashLength = types.Int32Value(minLength)
+		nb.resolveHashLength()
+		return nb.result.HashLength.ValueInt32()
+	}
+
+	assert.Equal(t, int32(4), resolve(s.ScopeGlobal, 4, 0, &s.BuildNameSettingsModel{}))
+	assert.Equal(t, int32(0), resolve(s.ScopeResourceGroup, 4, 0, &s.BuildNameSettingsModel{}))
+	assert.Equal(t, int32(0), resolve(s.ScopeGlobal, 0, 0, &s.BuildNameSettingsModel{}))
+	assert.Equal(t, int32(2), resolve(s.ScopeGlobal, 4, 2, &s.BuildNameSettingsModel{}))
+	assert.Equal(t, int32(3), resolve(s.ScopeGlobal, 4, 0, &s.BuildNameSettingsModel{HashLength: 3}))
+}
+
+func TestBuildName_Transliterate(t *testing.T) {
+	settings, err := parseSettingsFromDynamic(types.DynamicValue(types.ObjectValueMust(
+		map[string]attr.Type{"transliterate": types.StringType},
+		map[string]attr.Value{"transliterate": types.StringValue(tools.TransliterateGerman)},
+	)))
+	assert.NoError(t, err)
+
+	nb := makeTestBuilderForBudget([]string{"abbreviation", "name"}, settings)
+	resp := &function.RunResponse{}
+	assert.Equal(t, "st-Muenchen", nb.buildName(types.StringValue("München"), resp).ValueString())
+	assert.Nil(t, resp.Error)
+
+	assert.EqualError(t, validateTransliterate("fr"), "invalid transliterate 'fr', expected one of: ascii, de")
+}
+
+func TestResolvePrefixes_AffixSet(t *testing.T) {
+	nb := makeTestBuilderForBudget([]string{"prefixes", "name"}, &s.BuildNameSettingsModel{PrefixSet: "platform", Prefixes: []string{"core"}})
+	nb.model.Configuration.Affixes = affixesMapValue(s.AffixesMapSchema{
+		"platform": {Prefixes: []string{"plt"}, Suffixes: []string{"shared"}},
+	})
+
+	resp := &function.RunResponse{}
+	nb.resolvePrefixes(resp)
+	assert.Nil(t, resp.Error)
+	assert.Equal(t, []string{"plt", "core"}, extractStringSlice(nb.result.Prefixes))
+
+	nb.buildNameSettings.SuffixSet = "unknown"
+	nb.resolveSuffixes(resp)
+	assert.ErrorContains(t, resp.Error, "suffix_set: unknown affix set 'unknown', expected one of: platform")
+}
+
+func TestResolveEnvironment_Catalog(t *testing.T) {
+	resolve := func(environment string) (string, *function.FuncError) {
+		nb := makeTestBuilderForBudget(s.DefaultNamePrecedence[:], &s.BuildNameSettingsModel{Environment: environment})
+		nb.model.Configuration.Environments, nb.model.Configuration.AllowedEnvironments = environmentsValues(s.EnvironmentsSchema{
+			Environments: map[string]string{"production": "prd", "development": "dev"},
+			Allowed:      []string{"prd", "dev"},
+		})
+		resp := &function.RunResponse{}
+		nb.resolveEnvironment(resp)
+		return nb.result.Environment.ValueString(), resp.Error
+	}
+
+	env, err := resolve("production")
+	assert.Nil(t, err)
+	assert.Equal(t, "prd", env)
+
+	env, err = resolve("dev")
+	assert.Nil(t, err)
+	assert.Equal(t, "dev", env)
+
+	_, err = resolve("staging")
+	assert.ErrorContains(t, err, "environment 'staging' is not one of the allowed environments of the configuration: prd, dev")
+}
+
+func TestCompatibilityMessage(t *testing.T) {
+	compatibility := func(abbreviation string) types.Map {
+		m, diags := types.MapValueFrom(context.Background(), types.ObjectType{AttrTypes: s.SchemaTypeAttributes()}, s.NewNamingSchemaMap([]s.JsonNamingSchema{{
+			ResourceType: "azurerm_storage_account",
+			Abbreviation: abbreviation,
+			MaxLength:    24,
+			Configuration: s.JsonConfigurationSchema{
+				UseEnvironment: true,
+				UseSeparator:   true,
+				NamePrecedence: []string{"abbreviation", "name"},
+				HashLength:     4,
+			},
+		}}))
+		assert.False(t, diags.HasError())
+		return m
+	}
+
+	build := func(abbreviation string) (string, error) {
+		nb := makeTestBuilderForBudget([]string{"abbreviation", "name"}, &s.BuildNameSettingsModel{})
+		nb.model.Configuration.Compatibility = compatibility(abbreviation)
+		nb.buildName(types.StringValue("logs"), &function.RunResponse{})
+		return nb.compatibilityMessage("azurerm_storage_account", types.StringValue("logs"))
+	}
+
+	message, err := build("st")
+	assert.NoError(t, err)
+	assert.Empty(t, message)
+
+	message, err = build("sa")
+	assert.NoError(t, err)
+	assert.Equal(t, "name of resource type 'azurerm_storage_account' changes from 'sa-logs' under the compatibility schema to 'st-logs'", message)
+
+	nb := makeTestBuilderForBudget([]string{"abbreviation", "name"}, &s.BuildNameSettingsModel{})
+	nb.model.Configuration.Compatibility = compatibility("sa")
+	message, err = nb.compatibilityMessage("azurerm_key_vault", types.StringValue("logs"))
+	assert.NoError(t, err)
+	assert.Empty(t, message)
+}
+
+func TestApplyLengthSettings(t *testing.T) {
+	typeSchema := &s.NamingSchema{MinLength: types.Int64Value(3), MaxLength: types.Int64Value(24)}
+
+	assert.NoError(t, applyLengthSettings(typeSchema, &s.BuildNameSettingsModel{}))
+	assert.Equal(t, int64(24), typeSchema.MaxLength.ValueInt64())
+
+	assert.NoError(t, applyLengthSettings(typeSchema, &s.BuildNameSettingsModel{MaxLength: 40, MinLength: 5}))
+	assert.Equal(t, int64(5), typeSchema.MinLength.ValueInt64())
+	assert.Equal(t, int64(24), typeSchema.MaxLength.ValueInt64())
+
+	assert.NoError(t, applyLengthSettings(typeSchema, &s.BuildNameSettingsModel{MaxLength: 16}))
+	assert.Equal(t, int64(16), typeSchema.MaxLength.ValueInt64())
+
+	assert.ErrorContains(t, applyLengthSettings(typeSchema, &s.BuildNameSettingsModel{MinLength: 20, MaxLength: 10}), "settings: min_length 20 is greater than max_length 10")
+}
+
+func TestApplyValidationSettings(t *testing.T) {
+	typeSchema := &s.NamingSchema{Configuration: s.Configuration{DenyDoubleHyphens: types.BoolValue(false)}}
+
+	applyValidationSettings(typeSchema, &s.BuildNameSettingsModel{})
+	assert.False(t, typeSchema.Configuration.DenyDoubleHyphens.ValueBool())
+
+	applyValidationSettings(typeSchema, &s.BuildNameSettingsModel{DenyDoubleHyphens: true})
+	assert.True(t, typeSchema.Configuration.DenyDoubleHyphens.ValueBool())
+
+	// The setting cannot allow double hyphens the schema denies.
+	applyValidationSettings(typeSchema, &s.BuildNameSettingsModel{DenyDoubleHyphens: false})
+	assert.True(t, typeSchema.Configuration.DenyDoubleHyphens.ValueBool())
+}
+
+func TestValidationResult_CheckSettingsRegex(t *testing.T) {
+	result := &validationResult{Name: "rg-App-we", RegexValid: true, LengthValid: true, LeadingValid: true, TrailingValid: true, UniqueSuffixValid: true}
+
+	assert.NoError(t, result.checkSettingsRegex(""))
+	assert.Empty(t, result.violations())
+
+	assert.NoError(t, result.checkSettingsRegex("^[a-z-]+$"))
+	assert.False(t, result.SettingsRegexValid)
+	assert.Equal(t, []string{"Invalid name: 'rg-App-we' does not match validation_regex '^[a-z-]+$' of the settings"}, result.violations())
+
+	assert.NoError(t, result.checkSettingsRegex("^[a-zA-Z-]+$"))
+	assert.True(t, result.SettingsRegexValid)
+
+	assert.ErrorContains(t, result.checkSettingsRegex("^[a-z"), "invalid validation regex")
+}
+
+func TestResolveLocation_LocationShort(t *testing.T) {
+	nb := makeTestBuilderForBudget([]string{"abbreviation", "name", "location"}, &s.BuildNameSettingsModel{LocationShort: "fra"})
+	nb.model.Locations = map[string]types.String{}
+
+	resp := &function.RunResponse{}
+	nb.resolveLocation(resp)
+	assert.Nil(t, resp.Error)
+	assert.Equal(t, "fra", nb.result.Location.ValueString())
+
+	nb = makeTestBuilderForBudget([]string{"abbreviation", "name", "location"}, &s.BuildNameSettingsModel{})
+	nb.model.Locations = map[string]types.String{}
+	nb.resolveNamePrecedence(resp)
+	nb.resolveLocation(resp)
+	assert.NotNil(t, resp.Error)
+}
+
+func TestResolveLocation_SegmentNotUsed(t *testing.T) {
+	nb := makeTestBuilderForBudget([]string{"abbreviation", "name", "environment"}, &s.BuildNameSettingsModel{Location: "germanywestcentral"})
+	nb.model.Locations = map[string]types.String{}
+
+	resp := &function.RunResponse{}
+	name := nb.buildName(types.StringValue("logs"), resp)
+	assert.Nil(t, resp.Error)
+	assert.Equal(t, "st-logs-tst", name.ValueString())
+}
+
+func TestResolveNamePrecedence_RequiredSegments(t *testing.T) {
+	nb := makeTestBuilderForBudget([]string{"abbreviation", "name"}, &s.BuildNameSettingsModel{NamePrecedence: []string{"name", "environment"}})
+	nb.typeSchema.ResourceType = types.StringValue("azurerm_storage_account")
+	nb.typeSchema.Configuration.RequiredSegments = types.ListValueMust(types.StringType, []attr.Value{types.StringValue("abbreviation")})
+
+	resp := &function.RunResponse{}
+	nb.resolveNamePrecedence(resp)
+	assert.NotNil(t, resp.Error)
+	assert.Contains(t, resp.Error.Error(), "name_precedence must contain the segment 'abbreviation', the schema library requires it for resource type 'azurerm_storage_account'")
+
+	nb.buildNameSettings.NamePrecedence = []string{"abbreviation", "name"}
+	resp = &function.RunResponse{}
+	nb.resolveNamePrecedence(resp)
+	assert.Nil(t, resp.Error)
+}
+
+func TestBuildName_WorkspaceAndStack(t *testing.T) {
+	nb := makeTestBuilderForBudget([]string{"abbreviation", "workspace", "name", "stack"}, &s.BuildNameSettingsModel{})
+	nb.model.Configuration.Workspace = types.StringValue("feature1")
+	nb.model.Configuration.Stack = types.StringValue("network")
+
+	resp := &function.RunResponse{}
+	assert.Equal(t, "st-feature1-logs-network", nb.buildName(types.StringValue("logs"), resp).ValueString())
+	assert.Nil(t, resp.Error)
+
+	nb = makeTestBuilderForBudget([]string{"abbreviation", "workspace", "name", "stack"}, &s.BuildNameSettingsModel{Workspace: "main"})
+	nb.model.Configuration.Workspace = types.StringValue("feature1")
+	nb.model.Configuration.Stack = types.StringNull()
+	assert.Equal(t, "st-main-logs", nb.buildName(types.StringValue("logs"), resp).ValueString())
+	assert.Nil(t, resp.Error)
+}
+
+func TestResolveDate(t *testing.T) {
+	build := func(settings *s.BuildNameSettingsModel) (string, *function.RunResponse) {
+		nb := makeTestBuilderForBudget([]string{"abbreviation", "name", "date"}, settings)
+		resp := &function.RunResponse{}
+		return nb.buildName(types.StringValue("sandbox"), resp).ValueString(), resp
+	}
+
+	name, resp := build(&s.BuildNameSettingsModel{StaticDate: "2026-10-16"})
+	assert.Nil(t, resp.Error)
+	assert.Equal(t, "st-sandbox-20261016", name)
+
+	name, resp = build(&s.BuildNameSettingsModel{StaticDate: "2026-10-16T22:30:00+02:00", DateFormat: "yymm"})
+	assert.Nil(t, resp.Error)
+	assert.Equal(t, "st-sandbox-2610", name)
+
+	_, resp = build(&s.BuildNameSettingsModel{})
+	assert.ErrorContains(t, resp.Error, "the date segment requires the static_date setting")
+
+	_, resp = build(&s.BuildNameSettingsModel{StaticDate: "16.10.2026"})
+	assert.ErrorContains(t, resp.Error, "invalid static_date '16.10.2026'")
+}
+
+func TestCheckSegmentSeparators(t *testing.T) {
+	build := func(settings *s.BuildNameSettingsModel) (string, *function.RunResponse) {
+		nb := makeTestBuilderForBudget([]string{"abbreviation", "prefixes", "name", "environment"}, settings)
+		nb.model.Configuration.Prefixes = types.ListValueMust(types.StringType, []attr.Value{types.StringValue("team-a")})
+		resp := &function.RunResponse{}
+		return nb.buildName(types.StringValue("web-logs"), resp).ValueString(), resp
+	}
+
+	name, resp := build(&s.BuildNameSettingsModel{})
+	assert.Nil(t, resp.Error)
+	assert.Equal(t, "st-team-a-web-logs-tst", name)
+
+	_, resp = build(&s.BuildNameSettingsModel{SeparatorConflict: separatorConflictError})
+	assert.ErrorContains(t, resp.Error, "prefix segment 'team-a' contains the separator '-'")
+	assert.ErrorContains(t, resp.Error, "name segment 'web-logs' contains the separator '-'")
+
+	name, resp = build(&s.BuildNameSettingsModel{SeparatorConflict: separatorConflictSanitize})
+	assert.Nil(t, resp.Error)
+	assert.Equal(t, "st-teama-weblogs-tst", name)
+
+	name, resp = build(&s.BuildNameSettingsModel{SeparatorConflict: separatorConflictSanitize, SeparatorReplacement: "_"})
+	assert.Nil(t, resp.Error)
+	assert.Equal(t, "st-team_a-web_logs-tst", name)
+
+	assert.ErrorContains(t, validateSeparatorConflict("other"), "invalid separator_conflict 'other'")
+}
+
+func TestBuildName_NonAzureResourceTypes(t *testing.T) {
+	ctx := context.Background()
+	schemaMap := s.NewNamingSchemaMap([]s.JsonNamingSchema{
+		{
+			ResourceType:    "kubernetes_namespace",
+			Abbreviation:    "ns",
+			MinLength:       1,
+			MaxLength:       63,
+			ValidationRegex: "^[a-z0-9]([-a-z0-9]*[a-z0-9])?$",
+			Configuration: s.JsonConfigurationSchema{
+				UseEnvironment: true,
+				UseLowerCase:   true,
+				UseSeparator:   true,
+				NamePrecedence: []string{"name", "environment", "abbreviation"},
+			},
+		},
+		{
+			ResourceType:    "github_repository",
+			MinLength:       1,
+			MaxLength:       100,
+			ValidationRegex: "^[A-Za-z0-9._-]+$",
+			Configuration: s.JsonConfigurationSchema{
+				UseSeparator:   true,
+				NamePrecedence: []string{"prefixes", "name"},
+			},
+		},
+	})
+
+	// A library without locations builds names of types without a location
+	// segment, and the Azure reserved words do not fail the names in strict mode.
+	model := benchmarkConfigurations(t)
+	model.Locations = map[string]types.String{}
+	model.Configuration.Strict = types.BoolValue(true)
+
+	for nameType, want := range map[string]string{
+		"kubernetes_namespace": "login-prd-ns",
+		"github_repository":    "app-core-login",
+	} {
+		typeSchema := schemaMap[nameType]
+		resp := &function.RunResponse{}
+		name := buildCheckedName(ctx, model, nameType, &s.BuildNameSettingsModel{ReservedWordsCheck: true}, types.StringValue("login"), &typeSchema, resp)
+		assert.Nil(t, resp.Error, nameType)
+		assert.Equal(t, want, name.ValueString())
+	}
+}
+
+func TestBuildName_HashLengthConflict(t *testing.T) {
+	ctx := context.Background()
+	schemaMap := s.NewNamingSchemaMap([]s.JsonNamingSchema{
+		{
+			ResourceType: "azurerm_short",
+			Abbreviation: "sh",
+			MinLength:    1,
+			MaxLength:    8,
+			Configuration: s.JsonConfigurationSchema{
+				UseSeparator:   true,
+				NamePrecedence: []string{"abbreviation", "name", "hash"},
+			},
+		},
+	})
+	typeSchema := schemaMap["azurerm_short"]
+
+	model := benchmarkConfigurations(t)
+	model.Configuration.HashLength = types.Int32Value(6)
+	model.Configuration.Strict = types.BoolValue(true)
+
+	// "sh-" and the hash need 9 characters, the name cannot be valid.
+	resp := &function.RunResponse{}
+	buildCheckedName(ctx, model, "azurerm_short", &s.BuildNameSettingsModel{}, types.StringValue("app"), &typeSchema, resp)
+	assert.NotNil(t, resp.Error)
+	assert.Contains(t, resp.Error.Text, "hash_length 6 of the configuration does not fit resource type 'azurerm_short': the abbreviation and the hash alone need 9 characters, but maximum is set to 8")
+
+	// A hash length of the settings is not reported as a conflict.
+	resp = &function.RunResponse{}
+	buildCheckedName(ctx, model, "azurerm_short", &s.BuildNameSettingsModel{HashLength: 6}, types.StringValue("app"), &typeSchema, resp)
+	assert.NotNil(t, resp.Error)
+	assert.NotContains(t, resp.Error.Text, "does not fit")
+
+	resp = &function.RunResponse{}
+	name := buildCheckedName(ctx, model, "azurerm_short", &s.BuildNameSettingsModel{HashLength: 2}, types.StringValue("a"), &typeSchema, resp)
+	assert.Nil(t, resp.Error)
+	assert.Regexp(t, "^sh-a-[a-z]{2}$", name.ValueString())
+}
+
+func TestLookupLocation(t *testing.T) {
+	locations := map[string]types.String{
+		"westeurope":   types.StringValue("we"),
+		"eu-central-1": types.StringValue("euc1"),
+	}
+
+	for location, want := range map[string]string{
+		"westeurope":   "we",
+		"West Europe":  "we",
+		"WESTEUROPE":   "we",
+		"EU-Central-1": "euc1",
+	} {
+		v, ok := lookupLocation(locations, location)
+		assert.True(t, ok, location)
+		assert.Equal(t, want, v.ValueString(), location)
+	}
+
+	_, ok := lookupLocation(locations, "North Europe")
+	assert.False(t, ok)
+}
+
+func TestBuildName_SchemaDefaultAffixes(t *testing.T) {
+	build := func(settings *s.BuildNameSettingsModel, configurationSuffixes ...string) string {
+		nb := makeTestBuilderForBudget(s.DefaultNamePrecedence[:], settings)
+		nb.model.Configuration.Suffixes = stringSliceToList(configurationSuffixes)
+		nb.typeSchema.MaxLength = types.Int64Value(64)
+		nb.typeSchema.Configuration.DefaultSuffixes = types.ListValueMust(types.StringType, []attr.Value{types.StringValue("diag")})
+		resp := &function.RunResponse{}
+		result := nb.buildName(types.StringValue("app"), resp)
+		assert.Nil(t, resp.Error)
+		return result.ValueString()
+	}
+
+	// The default suffixes of the schema replace the empty suffixes of the
+	// configuration, suffixes of the configuration and the settings win.
+	assert.Regexp(t, "^st-app-app-we-tst-[a-z]{4}-diag$", build(&s.BuildNameSettingsModel{}))
+	assert.Regexp(t, "^st-app-app-we-tst-[a-z]{4}-cfg$", build(&s.BuildNameSettingsModel{}, "cfg"))
+	assert.Regexp(t, "^st-app-app-we-tst-[a-z]{4}-log$", build(&s.BuildNameSettingsModel{Suffixes: []string{"log"}}, "cfg"))
+}
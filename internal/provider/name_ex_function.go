@@ -0,0 +1,150 @@
+// Copyright glueckkanja AG 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = &NameExFunction{}
+
+type NameExFunction struct{}
+
+func NewNameExFunction() function.Function {
+	return &NameExFunction{}
+}
+
+func nameExSegmentsTypeAttributes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"abbreviation": types.StringType,
+		"prefixes":     types.ListType{ElemType: types.StringType},
+		"name":         types.StringType,
+		"location":     types.StringType,
+		"environment":  types.StringType,
+		"workspace":    types.StringType,
+		"stack":        types.StringType,
+		"date":         types.StringType,
+		"hash":         types.StringType,
+		"suffixes":     types.ListType{ElemType: types.StringType},
+	}
+}
+
+func nameExTypeAttributes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"name":      types.StringType,
+		"segments":  types.ObjectType{AttrTypes: nameExSegmentsTypeAttributes()},
+		"hash":      types.StringType,
+		"truncated": types.BoolType,
+		"valid":     types.BoolType,
+		"sources":   types.MapType{ElemType: types.StringType},
+	}
+}
+
+func (f *NameExFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "name_ex"
+}
+
+func (f *NameExFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Provide a valid resource name together with its segments",
+		Description: "Build a resource name like the name function and return it together with its segments, the hash and whether the name was truncated.",
+		MarkdownDescription: "Build a resource name like the `name` function and return an object with the `name`, its `segments` " +
+			"(`abbreviation`, `prefixes`, `name`, `location`, `environment`, `workspace`, `stack`, `date`, `hash`, `suffixes`), the `hash`, whether the name " +
+			"segment was `truncated` by `truncate_keep_hash` or the `truncate` step of `post_process` and whether the name is `valid`. Use it to reuse the hash, e.g. for " +
+			"a DNS label. Segments that are not part of the name are empty. Violations fail like in the `name` function; with " +
+			"`strict = false` the name is returned with `valid = false`.\n\n" +
+			"`sources` maps the settings the name was built with, e.g. `separator` or `hash_length`, to the layer they were " +
+			"taken from: `settings` (the settings argument), `schema` (the naming schema of the resource type), `configuration` " +
+			"(the provider configuration and the `standesamt_config` data source) or `default`. The settings win over the schema, " +
+			"which wins over the configuration; only `hash_length`, `prefixes` and `suffixes` of the configuration override the schema.",
+		Parameters: []function.Parameter{
+			configurationsParameter(),
+			function.StringParameter{
+				Name:        "name_type",
+				Description: "The resource type to use for the name.",
+			},
+			settingsParameter(),
+			function.StringParameter{
+				Name:               "name",
+				Description:        "Name to parse",
+				AllowUnknownValues: true,
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: nameExTypeAttributes(),
+		},
+	}
+}
+
+func (f *NameExFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	model, nameType, buildNameSettings, name, typeSchema, err := parseArguments(ctx, req, resp)
+	if err != nil || resp.Error != nil {
+		return
+	}
+
+	builder, valid := buildAndCheckName(ctx, model, nameType, buildNameSettings, name, typeSchema, resp)
+	if resp.Error != nil {
+		return
+	}
+
+	result, diags := builder.nameExResult(valid)
+	if diags.HasError() {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.FuncErrorFromDiags(ctx, diags))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
+}
+
+// nameExResult converts the built name and its segments into the name_ex result.
+func (nb *nameBuilder) nameExResult(valid bool) (types.Object, diag.Diagnostics) {
+	values := map[string]string{}
+	prefixes := make([]attr.Value, 0)
+	suffixes := make([]attr.Value, 0)
+	for _, segment := range nb.casedSegments() {
+		switch segment.Type {
+		case "prefix":
+			prefixes = append(prefixes, types.StringValue(segment.Value))
+		case "suffix":
+			suffixes = append(suffixes, types.StringValue(segment.Value))
+		default:
+			values[segment.Type] = segment.Value
+		}
+	}
+
+	segments, diags := types.ObjectValue(nameExSegmentsTypeAttributes(), map[string]attr.Value{
+		"abbreviation": types.StringValue(values["abbreviation"]),
+		"prefixes":     types.ListValueMust(types.StringType, prefixes),
+		"name":         types.StringValue(values["name"]),
+		"location":     types.StringValue(values["location"]),
+		"environment":  types.StringValue(values["environment"]),
+		"workspace":    types.StringValue(values["workspace"]),
+		"stack":        types.StringValue(values["stack"]),
+		"date":         types.StringValue(values["date"]),
+		"hash":         types.StringValue(values["hash"]),
+		"suffixes":     types.ListValueMust(types.StringType, suffixes),
+	})
+	if diags.HasError() {
+		return types.ObjectNull(nameExTypeAttributes()), diags
+	}
+
+	sources := make(map[string]attr.Value, len(nb.sources))
+	for k, v := range nb.sources {
+		sources[k] = types.StringValue(string(v))
+	}
+
+	return types.ObjectValue(nameExTypeAttributes(), map[string]attr.Value{
+		"name":      nb.result.Name,
+		"segments":  segments,
+		"hash":      types.StringValue(values["hash"]),
+		"truncated": types.BoolValue(nb.truncated),
+		"valid":     types.BoolValue(valid),
+		"sources":   types.MapValueMust(types.StringType, sources),
+	})
+}
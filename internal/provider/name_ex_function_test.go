@@ -0,0 +1,95 @@
+// Copyright glueckkanja AG 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	s "terraform-provider-standesamt/internal/schema"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNameExFunction_Null(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactoriesUnique(),
+		Steps: []resource.TestStep{
+			{
+				Config: `output "test" {
+							value = provider::standesamt::name_ex(null, null, null, null)
+						}`,
+				ExpectError: regexp.MustCompile(`Invalid value for "configurations" parameter: argument must not be null\.`),
+			},
+		},
+	})
+}
+
+func TestNameExFunction_Segments(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactoriesUnique(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf("%s %s", default_config_with_no_settings_default_precedence, `output "test" {
+					value = provider::standesamt::name_ex(local.config, "azurerm_resource_group", local.settings, "test")
+				}`),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("test", knownvalue.ObjectExact(map[string]knownvalue.Check{
+						"name": knownvalue.StringExact("rg-test-we"),
+						"segments": knownvalue.ObjectExact(map[string]knownvalue.Check{
+							"abbreviation": knownvalue.StringExact("rg"),
+							"prefixes":     knownvalue.ListExact([]knownvalue.Check{}),
+							"name":         knownvalue.StringExact("test"),
+							"location":     knownvalue.StringExact("we"),
+							"environment":  knownvalue.StringExact(""),
+							"workspace":    knownvalue.StringExact(""),
+							"stack":        knownvalue.StringExact(""),
+							"date":         knownvalue.StringExact(""),
+							"hash":         knownvalue.StringExact(""),
+							"suffixes":     knownvalue.ListExact([]knownvalue.Check{}),
+						}),
+						"hash":      knownvalue.StringExact(""),
+						"truncated": knownvalue.Bool(false),
+						"valid":     knownvalue.Bool(true),
+						"sources":   knownvalue.NotNull(),
+					})),
+				},
+			},
+		},
+	})
+}
+
+func TestNameExResult_Truncated(t *testing.T) {
+	nb := makeTestBuilderForBudget(s.DefaultNamePrecedence[:], &s.BuildNameSettingsModel{TruncateKeepHash: true, Uppercase: true})
+	resp := &function.RunResponse{}
+	nb.buildName(types.StringValue("averyverylongapplicationname"), resp)
+	assert.Nil(t, resp.Error)
+
+	result, diags := nb.nameExResult(true)
+	assert.False(t, diags.HasError())
+
+	attrs := result.Attributes()
+	name := attrs["name"].(types.String).ValueString()
+	hash := attrs["hash"].(types.String).ValueString()
+	assert.Len(t, name, 24)
+	assert.Len(t, hash, 4)
+	assert.Equal(t, name[len(name)-4:], hash)
+	assert.True(t, attrs["truncated"].(types.Bool).ValueBool())
+
+	segments := attrs["segments"].(types.Object).Attributes()
+	assert.Equal(t, "ST", segments["abbreviation"].(types.String).ValueString())
+	assert.Equal(t, hash, segments["hash"].(types.String).ValueString())
+	assert.Len(t, segments["prefixes"].(types.List).Elements(), 1)
+
+	sources := attrs["sources"].(types.Map).Elements()
+	assert.Equal(t, types.StringValue("schema"), sources["hash_length"])
+	assert.Equal(t, types.StringValue("configuration"), sources["separator"])
+	assert.Equal(t, types.StringValue("schema"), sources["name_precedence"])
+}
@@ -0,0 +1,296 @@
+// Copyright glueckkanja AG 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var (
+	_ function.Function = &NameFromFunction{}
+	_ function.Function = &ValidateFromFunction{}
+)
+
+// argumentsObjectKeys are the keys of the arguments object of name_from and
+// validate_from in the order of the positional arguments of name and validate.
+var argumentsObjectKeys = []string{"config", "type", "settings", "name", "mode"}
+
+type NameFromFunction struct{}
+
+func NewNameFromFunction() function.Function {
+	return &NameFromFunction{}
+}
+
+func (f *NameFromFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "name_from"
+}
+
+func (f *NameFromFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Provide a valid resource name from a single arguments object",
+		Description: "Build a resource name like name, with the arguments passed as one object { config, type, name, settings }.",
+		MarkdownDescription: "Build a resource name like `name`, with the arguments passed as one object `{ config, type, name, settings }` " +
+			"instead of positional arguments, e.g. to build argument sets dynamically in HCL.",
+		Parameters: []function.Parameter{
+			argumentsObjectParameter("`name`"),
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *NameFromFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	runWithArgumentsObject(ctx, req, resp, &NameFunction{}, false)
+}
+
+type ValidateFromFunction struct{}
+
+func NewValidateFromFunction() function.Function {
+	return &ValidateFromFunction{}
+}
+
+func (f *ValidateFromFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "validate_from"
+}
+
+func (f *ValidateFromFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	validate := &function.DefinitionResponse{}
+	(&ValidateFunction{}).Definition(ctx, req, validate)
+
+	resp.Definition = function.Definition{
+		Summary:     "Validate a resource name from a single arguments object",
+		Description: "Validate a resource name like validate, with the arguments passed as one object { config, type, name, settings, mode }.",
+		MarkdownDescription: "Validate a resource name like `validate`, with the arguments passed as one object " +
+			"`{ config, type, name, settings, mode }` instead of positional arguments. `mode` is optional and takes " +
+			"the values of the `mode` argument of `validate`.",
+		Parameters: []function.Parameter{
+			argumentsObjectParameter("`validate`"),
+		},
+		Return: validate.Definition.Return,
+	}
+}
+
+func (f *ValidateFromFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	runWithArgumentsObject(ctx, req, resp, &ValidateFunction{}, true)
+}
+
+// argumentsObjectParameter returns the single parameter of name_from and
+// validate_from, documented for the function of the positional arguments.
+func argumentsObjectParameter(positional string) function.DynamicParameter {
+	return function.DynamicParameter{
+		Name:               "arguments",
+		AllowUnknownValues: true,
+		MarkdownDescription: "An object of the arguments of " + positional + ":\n\n" +
+			"| Key | Required | Description |\n" +
+			"|---|---|---|\n" +
+			"| `config` | yes | The `configurations` argument: an object with `configuration`, `locations` and `schema` attributes. Further attributes are ignored. |\n" +
+			"| `type` | yes | The resource type to use for the name. |\n" +
+			"| `name` | no | The name to build or validate. |\n" +
+			"| `settings` | no | The per-call settings, see the `settings` argument of " + positional + ". |\n",
+	}
+}
+
+// runWithArgumentsObject runs fn with the positional arguments taken from the
+// arguments object. Argument errors of fn are reported for the arguments object
+// with the key of the positional argument.
+func runWithArgumentsObject(ctx context.Context, req function.RunRequest, resp *function.RunResponse, fn function.Function, withMode bool) {
+	var arguments types.Dynamic
+	if resp.Error = req.Arguments.Get(ctx, &arguments); resp.Error != nil {
+		return
+	}
+	if arguments.IsUnknown() || arguments.IsUnderlyingValueUnknown() {
+		return
+	}
+
+	positional, err := positionalArguments(ctx, arguments, withMode)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	fn.Run(ctx, function.RunRequest{Arguments: positional}, resp)
+	if resp.Error != nil && resp.Error.FunctionArgument != nil {
+		key := argumentsObjectKeys[min(int(*resp.Error.FunctionArgument), len(argumentsObjectKeys)-1)]
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("%s: %s", key, resp.Error.Text))
+	}
+}
+
+// positionalArguments converts the arguments object into the positional
+// arguments of name, or of validate if withMode is set.
+func positionalArguments(ctx context.Context, arguments types.Dynamic, withMode bool) (function.ArgumentsData, error) {
+	var attrs map[string]attr.Value
+	switch v := arguments.UnderlyingValue().(type) {
+	case types.Object:
+		if !v.IsNull() {
+			attrs = v.Attributes()
+		}
+	case types.Map:
+		if !v.IsNull() {
+			attrs = v.Elements()
+		}
+	}
+	if attrs == nil {
+		return function.ArgumentsData{}, fmt.Errorf("arguments must be an object with the keys config and type")
+	}
+
+	allowed := argumentsObjectKeys[:len(argumentsObjectKeys)-1]
+	if withMode {
+		allowed = argumentsObjectKeys
+	}
+	var unsupported []string
+	for k := range attrs {
+		if !slices.Contains(allowed, k) {
+			unsupported = append(unsupported, k)
+		}
+	}
+	if len(unsupported) > 0 {
+		sort.Strings(unsupported)
+		return function.ArgumentsData{}, fmt.Errorf("unsupported keys: %s, expected: %s", strings.Join(unsupported, ", "), strings.Join(allowed, ", "))
+	}
+
+	config, ok := attrs["config"]
+	if !ok || config.IsNull() {
+		return function.ArgumentsData{}, fmt.Errorf("config is required")
+	}
+	nameType, ok := attrs["type"].(types.String)
+	if !ok || nameType.IsNull() {
+		return function.ArgumentsData{}, fmt.Errorf("type is required and must be a string")
+	}
+
+	name := types.StringNull()
+	if v, ok := attrs["name"]; ok {
+		if name, ok = v.(types.String); !ok {
+			return function.ArgumentsData{}, fmt.Errorf("name must be a string")
+		}
+	}
+
+	settings := types.DynamicNull()
+	if v, ok := attrs["settings"]; ok {
+		settings = types.DynamicValue(v)
+	}
+
+	values := []attr.Value{types.DynamicValue(config), nameType, settings, name}
+	if withMode {
+		var modes []attr.Value
+		if v, ok := attrs["mode"]; ok && !v.IsNull() {
+			mode, ok := v.(types.String)
+			if !ok {
+				return function.ArgumentsData{}, fmt.Errorf("mode must be a string")
+			}
+			modes = append(modes, mode)
+		}
+		modeTypes := make([]attr.Type, len(modes))
+		for i := range modes {
+			modeTypes[i] = types.StringType
+		}
+		values = append(values, types.TupleValueMust(modeTypes, modes))
+	}
+
+	return function.NewArgumentsData(values), nil
+}
+
+// convertValue converts a value of a dynamic argument to target. Terraform
+// passes dynamic values with the types of HCL literals, so objects are
+// converted to maps, tuples and sets to lists, and numbers to the number type
+// of target. Attributes of an object not defined by target are left out, and
+// attributes of target missing in the object are null.
+func convertValue(ctx context.Context, value attr.Value, target attr.Type) (attr.Value, error) {
+	if value.IsNull() || value.IsUnknown() {
+		raw := tftypes.NewValue(target.TerraformType(ctx), nil)
+		if value.IsUnknown() {
+			raw = tftypes.NewValue(target.TerraformType(ctx), tftypes.UnknownValue)
+		}
+		return target.ValueFromTerraform(ctx, raw)
+	}
+
+	switch t := target.(type) {
+	case types.ObjectType:
+		v, ok := value.(types.Object)
+		if !ok {
+			return nil, fmt.Errorf("expected an object, got %s", value.Type(ctx))
+		}
+		attrs := make(map[string]attr.Value, len(t.AttrTypes))
+		for k, attrType := range t.AttrTypes {
+			a, ok := v.Attributes()[k]
+			if !ok {
+				a = types.DynamicNull()
+			}
+			converted, err := convertValue(ctx, a, attrType)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", k, err)
+			}
+			attrs[k] = converted
+		}
+		obj, diags := types.ObjectValue(t.AttrTypes, attrs)
+		if diags.HasError() {
+			return nil, fmt.Errorf("%s", diags.Errors()[0].Detail())
+		}
+		return obj, nil
+	case types.MapType:
+		var elements map[string]attr.Value
+		switch v := value.(type) {
+		case types.Map:
+			elements = v.Elements()
+		case types.Object:
+			elements = v.Attributes()
+		default:
+			return nil, fmt.Errorf("expected a map, got %s", value.Type(ctx))
+		}
+		converted := make(map[string]attr.Value, len(elements))
+		for k, elem := range elements {
+			c, err := convertValue(ctx, elem, t.ElemType)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", k, err)
+			}
+			converted[k] = c
+		}
+		m, diags := types.MapValue(t.ElemType, converted)
+		if diags.HasError() {
+			return nil, fmt.Errorf("%s", diags.Errors()[0].Detail())
+		}
+		return m, nil
+	case types.ListType:
+		var elements []attr.Value
+		switch v := value.(type) {
+		case types.List:
+			elements = v.Elements()
+		case types.Tuple:
+			elements = v.Elements()
+		case types.Set:
+			elements = v.Elements()
+		default:
+			return nil, fmt.Errorf("expected a list, got %s", value.Type(ctx))
+		}
+		converted := make([]attr.Value, len(elements))
+		for i, elem := range elements {
+			c, err := convertValue(ctx, elem, t.ElemType)
+			if err != nil {
+				return nil, fmt.Errorf("[%d]: %w", i, err)
+			}
+			converted[i] = c
+		}
+		l, diags := types.ListValue(t.ElemType, converted)
+		if diags.HasError() {
+			return nil, fmt.Errorf("%s", diags.Errors()[0].Detail())
+		}
+		return l, nil
+	}
+
+	if !value.Type(ctx).TerraformType(ctx).Equal(target.TerraformType(ctx)) {
+		return nil, fmt.Errorf("expected %s, got %s", target, value.Type(ctx))
+	}
+	raw, err := value.ToTerraformValue(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return target.ValueFromTerraform(ctx, raw)
+}
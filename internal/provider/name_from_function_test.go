@@ -0,0 +1,168 @@
+// Copyright glueckkanja AG 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNameFromFunction_Basic(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactoriesUnique(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf("%s %s", default_config_with_no_settings_default_precedence, `output "test" {
+					value = provider::standesamt::name_from({
+						config = local.config
+						type   = "azurerm_resource_group"
+						name   = "test"
+					})
+				}`),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("test", knownvalue.StringExact("rg-test-we")),
+				},
+			},
+		},
+	})
+}
+
+func TestNameFromFunction_UnsupportedKey(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactoriesUnique(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf("%s %s", default_config_with_no_settings_default_precedence, `output "test" {
+					value = provider::standesamt::name_from({
+						config    = local.config
+						type      = "azurerm_resource_group"
+						name_type = "azurerm_resource_group"
+					})
+				}`),
+				ExpectError: regexp.MustCompile(`unsupported keys: name_type`),
+			},
+		},
+	})
+}
+
+func TestValidateFromFunction_Raw(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactoriesUnique(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf("%s %s", default_config_with_no_settings_default_precedence, `output "test" {
+					value = provider::standesamt::validate_from({
+						config = local.config
+						type   = "azurerm_resource_group"
+						name   = "rg-legacy"
+						mode   = "raw"
+					}).name
+				}`),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("test", knownvalue.StringExact("rg-legacy")),
+				},
+			},
+		},
+	})
+}
+
+func TestNameFromFunction_Run(t *testing.T) {
+	ctx := context.Background()
+	model := benchmarkConfigurations(t)
+	configurations, diags := types.ObjectValueFrom(ctx, configurationsTypeAttributes(), model)
+	assert.False(t, diags.HasError())
+
+	arguments := types.DynamicValue(types.ObjectValueMust(
+		map[string]attr.Type{
+			"config":   configurations.Type(ctx),
+			"type":     types.StringType,
+			"name":     types.StringType,
+			"settings": types.ObjectType{AttrTypes: map[string]attr.Type{"hash_length": types.NumberType}},
+		},
+		map[string]attr.Value{
+			"config": configurations,
+			"type":   types.StringValue(benchmarkResourceType),
+			"name":   types.StringValue("billing"),
+			"settings": types.ObjectValueMust(
+				map[string]attr.Type{"hash_length": types.NumberType},
+				map[string]attr.Value{"hash_length": types.NumberValue(big.NewFloat(2))},
+			),
+		},
+	))
+
+	resp := &function.RunResponse{Result: function.NewResultData(types.StringUnknown())}
+	(&NameFromFunction{}).Run(ctx, function.RunRequest{Arguments: function.NewArgumentsData([]attr.Value{arguments})}, resp)
+	assert.Nil(t, resp.Error)
+	name := types.StringValue("t250-app-core-billing-we-prd-ys-001")
+	assert.Equal(t, &name, resp.Result.Value())
+
+	// Argument errors name the key of the arguments object.
+	arguments = types.DynamicValue(types.ObjectValueMust(
+		map[string]attr.Type{"config": configurations.Type(ctx), "type": types.StringType, "settings": types.StringType},
+		map[string]attr.Value{"config": configurations, "type": types.StringValue(benchmarkResourceType), "settings": types.StringValue("x")},
+	))
+	resp = &function.RunResponse{Result: function.NewResultData(types.StringUnknown())}
+	(&NameFromFunction{}).Run(ctx, function.RunRequest{Arguments: function.NewArgumentsData([]attr.Value{arguments})}, resp)
+	assert.NotNil(t, resp.Error)
+	assert.Equal(t, int64(0), *resp.Error.FunctionArgument)
+	assert.Contains(t, resp.Error.Text, "settings: ")
+}
+
+func TestConvertValue(t *testing.T) {
+	ctx := context.Background()
+
+	// HCL literals: an empty object for a map, a tuple for a list and a number
+	// for an int32.
+	target := types.ObjectType{AttrTypes: map[string]attr.Type{
+		"affixes":     types.MapType{ElemType: types.StringType},
+		"prefixes":    types.ListType{ElemType: types.StringType},
+		"hash_length": types.Int32Type,
+	}}
+	value := types.ObjectValueMust(
+		map[string]attr.Type{
+			"affixes":     types.ObjectType{AttrTypes: map[string]attr.Type{}},
+			"prefixes":    types.TupleType{ElemTypes: []attr.Type{types.StringType}},
+			"hash_length": types.NumberType,
+			"ignored":     types.BoolType,
+		},
+		map[string]attr.Value{
+			"affixes":     types.ObjectValueMust(map[string]attr.Type{}, map[string]attr.Value{}),
+			"prefixes":    types.TupleValueMust([]attr.Type{types.StringType}, []attr.Value{types.StringValue("app")}),
+			"hash_length": types.NumberValue(big.NewFloat(4)),
+			"ignored":     types.BoolValue(true),
+		},
+	)
+
+	converted, err := convertValue(ctx, value, target)
+	assert.NoError(t, err)
+	assert.Equal(t, types.ObjectValueMust(target.AttrTypes, map[string]attr.Value{
+		"affixes":     types.MapValueMust(types.StringType, map[string]attr.Value{}),
+		"prefixes":    types.ListValueMust(types.StringType, []attr.Value{types.StringValue("app")}),
+		"hash_length": types.Int32Value(4),
+	}), converted)
+
+	_, err = convertValue(ctx, types.StringValue("x"), types.ListType{ElemType: types.StringType})
+	assert.ErrorContains(t, err, "expected a list")
+
+	// Missing attributes, e.g. of an object built by an older provider version,
+	// are null.
+	converted, err = convertValue(ctx, types.ObjectValueMust(map[string]attr.Type{}, map[string]attr.Value{}), target)
+	assert.NoError(t, err)
+	assert.Equal(t, types.ObjectValueMust(target.AttrTypes, map[string]attr.Value{
+		"affixes":     types.MapNull(types.StringType),
+		"prefixes":    types.ListNull(types.StringType),
+		"hash_length": types.Int32Null(),
+	}), converted)
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 //var namingReturnAttrTypes = map[string]attr.Type{
@@ -36,6 +37,9 @@ type buildNameResultModel struct {
 	Suffixes       types.List
 	NamePrecedence types.List
 	Location       types.String
+	Workspace      types.String
+	Stack          types.String
+	Date           types.String
 	Lowercase      types.Bool
 }
 
@@ -65,97 +69,243 @@ func (f *NameFunction) Metadata(_ context.Context, _ function.MetadataRequest, r
 
 func (f *NameFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
 	resp.Definition = function.Definition{
-		Summary:             "Provide a valid resource name",
-		Description:         "Build a resource name based on the provided configuration and name type.",
-		MarkdownDescription: "Build a resource name based on the provided configuration and name type.",
+		Summary:     "Provide a valid resource name",
+		Description: "Build a resource name based on the provided configuration and name type.",
+		MarkdownDescription: "Build a resource name based on the provided configuration and name type.\n\n" +
+			"If the `name`, prefixes or other arguments are sensitive, Terraform marks the result as sensitive as well, " +
+			"so the name is hidden in the plan output. Error messages and provider logs are not covered by this: set " +
+			"`sensitive = true` in the settings to redact the name and its inputs there.",
 		Parameters: []function.Parameter{
-			function.ObjectParameter{
-				Name:                "configurations",
-				MarkdownDescription: "A configuration object that contains the variables and formats to use for the name.",
-				AttributeTypes: map[string]attr.Type{
-					"configuration": types.ObjectType{
-						AttrTypes: configurationTypeAttributes(),
-					},
-					"locations": types.MapType{
-						ElemType: types.StringType,
-					},
-					"schema": types.MapType{
-						ElemType: types.ObjectType{
-							AttrTypes: s.SchemaTypeAttributes(),
-						},
-					},
-				},
-				Description: "Configuration for the naming object",
-			},
+			configurationsParameter(),
 			function.StringParameter{
 				Name:        "name_type",
 				Description: "The resource type to use for the name.",
 			},
-			function.DynamicParameter{
-				Name: "settings",
-				MarkdownDescription: "An optional map of per-call overrides. All keys are optional and take " +
-					"precedence over the provider-level configuration.\n\n" +
-					"Supported keys:\n\n" +
-					"| Key | Type | Description |\n" +
-					"|---|---|---|\n" +
-					"| `convention` | `string` | Naming convention (`default` or `passthrough`). |\n" +
-					"| `environment` | `string` | Environment abbreviation (e.g. `prd`, `tst`). |\n" +
-					"| `location` | `string` | Azure location key resolved via the `locations` map. |\n" +
-					"| `separator` | `string` | Separator between name parts — overrides the schema default on a per-call basis. |\n" +
-					"| `prefixes` | `list(string)` | Prefix segments to prepend. |\n" +
-					"| `suffixes` | `list(string)` | Suffix segments to append. |\n" +
-					"| `name_precedence` | `list(string)` | Order of name segments. |\n" +
-					"| `hash_length` | `number` | Length of the random hash segment (0 = disabled). |\n" +
-					"| `random_seed` | `number` | Seed for the hash generator (for reproducible names). |\n" +
-					"| `lowercase` | `bool` | Convert the final name to lowercase. |\n" +
-					"| `uppercase` | `bool` | Convert the final name to uppercase. |\n\n" +
-					"Pass `{}` or `null` to use provider defaults for all settings.",
-			},
+			settingsParameter(),
 			function.StringParameter{
-				Name:        "name",
-				Description: "Name to parse",
+				Name:               "name",
+				Description:        "Name to parse",
+				AllowUnknownValues: true,
 			},
 		},
 		Return: function.StringReturn{},
 	}
 }
 
+// configurationsParameter returns the parameter definition of the configurations
+// object shared by all naming functions. The parameter is dynamic, so objects
+// built by hand or by an older provider version are accepted without the
+// attributes added since; see configurationsArgument.
+func configurationsParameter() function.DynamicParameter {
+	return function.DynamicParameter{
+		Name:               "configurations",
+		AllowUnknownValues: true,
+		MarkdownDescription: "A configuration object that contains the variables and formats to use for the name. The `locations` map may be empty, e.g. `locations = {}`, if no location is used or the `location_short` setting is passed. " +
+			"Attributes that are missing, e.g. in an object built by hand, are treated as not set.",
+		Description: "Configuration for the naming object",
+	}
+}
+
+// configurationsTypeAttributes returns the attribute types of the configurations
+// object, e.g. the configuration, locations and schema of standesamt_config.
+func configurationsTypeAttributes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"configuration": types.ObjectType{
+			AttrTypes: configurationTypeAttributes(),
+		},
+		"locations": types.MapType{
+			ElemType: types.StringType,
+		},
+		"schema": types.MapType{
+			ElemType: types.ObjectType{
+				AttrTypes: s.SchemaTypeAttributes(),
+			},
+		},
+	}
+}
+
+// configurationsArgument converts the configurations argument into the
+// configurations object. Missing attributes are null, which the naming
+// functions treat like an attribute that is not set. An unknown argument
+// returns an unknown object.
+func configurationsArgument(ctx context.Context, configurations types.Dynamic) (types.Object, error) {
+	attrTypes := configurationsTypeAttributes()
+	if configurations.IsUnknown() || configurations.IsUnderlyingValueUnknown() {
+		return types.ObjectUnknown(attrTypes), nil
+	}
+	if configurations.IsNull() || configurations.IsUnderlyingValueNull() {
+		return types.ObjectNull(attrTypes), fmt.Errorf("configurations must not be null")
+	}
+
+	converted, err := convertValue(ctx, configurations.UnderlyingValue(), types.ObjectType{AttrTypes: attrTypes})
+	if err != nil {
+		return types.ObjectNull(attrTypes), err
+	}
+	return converted.(types.Object), nil
+}
+
+// settingsParameter returns the parameter definition of the optional per-call
+// settings shared by all naming functions.
+func settingsParameter() function.DynamicParameter {
+	return function.DynamicParameter{
+		Name:               "settings",
+		AllowUnknownValues: true,
+		MarkdownDescription: "An optional map of per-call overrides. All keys are optional and take " +
+			"precedence over the provider-level configuration.\n\n" +
+			"Supported keys:\n\n" +
+			"| Key | Type | Description |\n" +
+			"|---|---|---|\n" +
+			"| `convention` | `string` | Naming convention (`default` or `passthrough`). |\n" +
+			"| `environment` | `string` | Environment abbreviation (e.g. `prd`, `tst`). |\n" +
+			"| `location` | `string` | Location key resolved via the `locations` map, e.g. an Azure region like `westeurope`. Keys are also matched case-insensitively and without white space, so display names like `West Europe` resolve as well. |\n" +
+			"| `workspace` | `string` | Overrides the value of the `workspace` segment. |\n" +
+			"| `stack` | `string` | Overrides the value of the `stack` segment. |\n" +
+			"| `static_date` | `string` | Date of the `date` segment as `YYYY-MM-DD` or RFC 3339 timestamp, e.g. `time_static.example.rfc3339`. Required if the name precedence contains `date`. |\n" +
+			"| `date_format` | `string` | Format of the `date` segment built from `yyyy`, `yy`, `mm` and `dd`. Defaults to `yyyymmdd`. |\n" +
+			"| `separator_conflict` | `string` | How prefixes, suffixes, the environment and the name that contain the separator are handled: `allow` (default), `error` or `sanitize`. |\n" +
+			"| `separator_replacement` | `string` | Replacement of the separator inside segments with `separator_conflict = \"sanitize\"`. Defaults to an empty string, i.e. the separator is removed. |\n" +
+			"| `location_short` | `string` | Location token used as is, e.g. `fra` for an on-prem site. Skips the lookup in the `locations` map and takes precedence over `location`. |\n" +
+			"| `separator` | `string` | Separator between name parts — overrides the schema default on a per-call basis. |\n" +
+			"| `prefixes` | `list(string)` | Prefix segments to prepend. Numbers and bools are converted to strings. |\n" +
+			"| `suffixes` | `list(string)` | Suffix segments to append. Numbers and bools are converted to strings. |\n" +
+			"| `name_precedence` | `list(string)` | Order of name segments. |\n" +
+			"| `hash_length` | `number` | Length of the random hash segment from 0 (disabled) to 64. |\n" +
+			"| `random_seed` | `number` | Seed for the hash generator (for reproducible names). Must not be `0`. |\n" +
+			"| `seed_key` | `string` | Key mixed into the seed, e.g. `\"${var.app}-${var.env}\"`, so module instances sharing a `random_seed` get different hashes. |\n" +
+			"| `collision_domain` | `string` | Scope the name must be unique in as `<kind>:<id>`, e.g. `resource_group:rg-app-prod`. Salts the derived hash, so the same name gets the same hash within the domain and different hashes across domains. Requires `hash_mode = \"derived\"`. |\n" +
+			"| `lowercase` | `bool` | Convert the final name to lowercase. |\n" +
+			"| `uppercase` | `bool` | Convert the final name to uppercase. |\n" +
+			"| `sensitive` | `bool` | Redact the name, the `name` argument and the prefixes and suffixes in error messages and provider logs, e.g. for names derived from sensitive values. |\n" +
+			"| `reserved_words_check` | `bool` | Report Azure reserved words (e.g. `login`, `microsoft`) in the name as violations. Only applies to Azure resource types (`azurerm_`, `azapi_` and `azuread_`). |\n" +
+			"| `strict` | `bool` | Fail on invalid names (`true`) or return them with a warning (`false`). |\n" +
+			"| `use_separator` | `bool` | Set to `false` to join all segments without separator. |\n" +
+			"| `hash_mode` | `string` | `random` (seed only, default) or `derived` (seed combined with all other segments). |\n" +
+			"| `hash_charset` | `string` | `lowercase` (default), `alphanumeric` for lowercase letters and digits, or `auto` for the lowercase letters, uppercase letters and digits the validation regex of the resource type allows at the position of the hash. |\n" +
+			"| `min_length` | `number` | Minimum length of the name. Can only be stricter than the schema. |\n" +
+			"| `max_length` | `number` | Maximum length of the name, e.g. an internal limit below the Azure limit. Can only be stricter than the schema. |\n" +
+			"| `truncate_keep_hash` | `bool` | Shorten the `name` segment to fit the maximum length while keeping the hash. Same as a final `truncate` step of `post_process`. |\n" +
+			"| `collapse_separators` | `bool` | Collapse repeated separators and trim leading and trailing separators of the final name, e.g. of prefixes that already contain the separator. Same as a final `collapse_separators` step of `post_process`. |\n" +
+			"| `post_process` | `list(string)` | Ordered steps applied to the built name: `lowercase`, `sanitize` (remove all characters other than ASCII letters and digits from the segments), `truncate` (like `truncate_keep_hash`) and `collapse_separators` (collapse repeated separators, trim leading and trailing ones), e.g. `[\"sanitize\", \"truncate\"]`. The casing of the configuration is applied afterwards, so `lowercase` fails with upper case configurations or resource types. |\n" +
+			"| `deny_double_hyphens` | `bool` | Deny double hyphens in the name even if the schema allows them. `false` does not allow them if the schema denies them. |\n" +
+			"| `validation_regex` | `string` | Regular expression the name must match in addition to the regex of the schema, e.g. `^[a-z0-9-]+$` for lowercase names only. Reported as `regex` by `validate`. |\n" +
+			"| `min_unique_suffix` | `number` | Minimum number of hash characters the name must keep, e.g. to catch hashes cut off by truncating the name. Reported as `unique_suffix` by `validate`. |\n" +
+			"| `prefix_set` | `string` | Name of an affix set of the schema library (see `standesamt_affixes`). Its prefixes are prepended to `prefixes`. |\n" +
+			"| `suffix_set` | `string` | Name of an affix set of the schema library. Its suffixes are prepended to `suffixes`. |\n" +
+			"| `transliterate` | `string` | Convert the `name` argument to ASCII before building the name. `ascii` strips diacritics and replaces letters like `ß` with `ss`, `de` additionally writes umlauts as `ae`, `oe` and `ue`. |\n" +
+			"| `preset` | `string` | Bundle of defaults for the settings above. `global_unique`: no separator, lowercase, 4 character derived alphanumeric hash, `truncate_keep_hash`. Explicit settings take precedence. |\n\n" +
+			"Unknown keys and values of the wrong type are rejected. Pass `{}` or `null` to use provider defaults for all settings. " +
+			"A `map(string)` is accepted as well, e.g. from a variable: numbers and bools are parsed from their string form and " +
+			"lists are comma-separated, e.g. `{ hash_length = \"4\", prefixes = \"app,core\" }`.",
+	}
+}
+
 func (f *NameFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
 	// Parse and validate input arguments
-	model, _, buildNameSettings, name, typeSchema, err := parseArguments(ctx, req, resp)
+	model, nameType, buildNameSettings, name, typeSchema, err := parseArguments(ctx, req, resp)
 	if err != nil || resp.Error != nil {
 		// Error is already set in resp.Error by parseArguments, just return
 		return
 	}
 
+	resultName := buildCheckedName(ctx, model, nameType, buildNameSettings, name, typeSchema, resp)
+	if resp.Error != nil {
+		return
+	}
+
+	// Set the result
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, &resultName))
+}
+
+// buildCheckedName builds the resource name and validates it against the naming
+// schema constraints. Violations are errors in strict mode and warnings otherwise.
+func buildCheckedName(
+	ctx context.Context,
+	model *configurationsModel,
+	nameType string,
+	buildNameSettings *s.BuildNameSettingsModel,
+	name types.String,
+	typeSchema *s.NamingSchema,
+	resp *function.RunResponse,
+) types.String {
+	builder, _ := buildAndCheckName(ctx, model, nameType, buildNameSettings, name, typeSchema, resp)
+	return builder.result.Name
+}
+
+// buildAndCheckName is buildCheckedName returning the builder, which holds the
+// segments of the name, and whether the name has no violations.
+func buildAndCheckName(
+	ctx context.Context,
+	model *configurationsModel,
+	nameType string,
+	buildNameSettings *s.BuildNameSettingsModel,
+	name types.String,
+	typeSchema *s.NamingSchema,
+	resp *function.RunResponse,
+) (*nameBuilder, bool) {
 	// Build the resource name using the nameBuilder
 	builder := newNameBuilder(ctx, model, typeSchema, buildNameSettings)
+	// Terraform marks the result of sensitive arguments as sensitive, but not
+	// the error messages of the function.
+	defer func() {
+		if resp.Error != nil {
+			resp.Error.Text = builder.redact(resp.Error.Text, name)
+		}
+	}()
 	resultName := builder.buildName(name, resp)
 	if resp.Error != nil {
-		return
+		return builder, false
 	}
 
 	resultNameStr := tools.GetBaseString(resultName)
 
 	// Validate the final name against the naming schema constraints
-	validation := validateName(resultNameStr, typeSchema)
+	validation, err := validateName(resultNameStr, typeSchema, extractStringSlice(model.Configuration.DenyPatterns), builder.result.Separator.ValueString())
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return builder, false
+	}
+	if buildNameSettings.ReservedWordsCheck {
+		validation.checkReservedWords(nameType)
+	}
+	if err := validation.checkSettingsRegex(buildNameSettings.ValidationRegex); err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(2, err.Error()))
+		return builder, false
+	}
+	if buildNameSettings.MinUniqueSuffix > 0 {
+		validation.checkUniqueSuffix(builder.hashSegment(), buildNameSettings.MinUniqueSuffix)
+	}
 
-	if validation.DenyDoubleHyphens && validation.DoubleHyphensFound {
-		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(fmt.Sprintf("Invalid name: '%s' contains double hyphens", resultNameStr)))
+	// Policy violations fail regardless of strict mode, as governance rules
+	// must not be bypassed by a per-call setting.
+	policyViolations, err := builder.policyViolations()
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, err.Error()))
+		return builder, false
+	}
+	for _, violation := range policyViolations {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(violation))
 	}
 
-	if !validation.RegexValid {
-		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError("Name does not match regex"))
-	} else if !validation.LengthValid {
-		if validation.NameLength > validation.MaxLength {
-			resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(fmt.Sprintf("Name has %d characters, but maximum is set to %d", validation.NameLength, validation.MaxLength)))
-		} else if validation.NameLength < validation.MinLength {
-			resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(fmt.Sprintf("Name has %d characters, but minimum is set to %d", validation.NameLength, validation.MinLength)))
+	// In non-strict mode violations are logged as warnings and the best-effort
+	// name is returned, so non-compliant names do not block an apply.
+	strict := builder.isStrict()
+	violations := validation.violations()
+	if conflict := builder.hashLengthConflict(nameType); conflict != "" && !validation.LengthValid {
+		violations = append([]string{conflict}, violations...)
+	}
+	for _, violation := range violations {
+		if strict {
+			resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(violation))
+		} else {
+			warning := builder.redact(violation, name)
+			tflog.Warn(ctx, warning, map[string]interface{}{"name_type": nameType})
+			builder.warnings = append(builder.warnings, warning)
 		}
 	}
 
-	// Set the result
-	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, &resultName))
+	builder.checkCompatibility(nameType, name, resp)
+
+	return builder, len(violations) == 0 && len(policyViolations) == 0
 }
 
 func toLower(s types.String) types.String {
@@ -86,6 +86,22 @@ func TestNameFunction_MaxLength(t *testing.T) {
 	})
 }
 
+func TestNameFunction_MaxLengthNotStrict(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactoriesUnique(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf("%s %s", fmt.Sprintf(default_config, `strict = false`, `"abbreviation", "name", "location"`), `output "test" {
+					value = provider::standesamt::name(local.config, "azurerm_resource_group", local.settings, "12345678901234567890")
+				}`),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("test", knownvalue.StringExact("rg-12345678901234567890-we")),
+				},
+			},
+		},
+	})
+}
+
 func TestNameFunction_DoubleHyphenError(t *testing.T) {
 	resource.UnitTest(t, resource.TestCase{
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactoriesUnique(),
@@ -0,0 +1,60 @@
+// Copyright glueckkanja AG 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	s "terraform-provider-standesamt/internal/schema"
+)
+
+// nameInputsHash returns a hash of everything the name depends on: the
+// configuration, the locations, the naming schema of the resource type, the
+// settings and the name. Only the compatibility schema of the resource type is
+// part of the hash, as the schemas of other types do not affect the name.
+func nameInputsHash(
+	ctx context.Context,
+	model *configurationsModel,
+	nameType string,
+	buildNameSettings *s.BuildNameSettingsModel,
+	name types.String,
+	typeSchema *s.NamingSchema,
+) (string, error) {
+	configuration := model.Configuration
+	elementType := configuration.Compatibility.ElementType(ctx)
+	if entry, ok := configuration.Compatibility.Elements()[nameType]; ok {
+		configuration.Compatibility = types.MapValueMust(elementType, map[string]attr.Value{nameType: entry})
+	} else {
+		configuration.Compatibility = types.MapNull(elementType)
+	}
+
+	locations := make(map[string]string, len(model.Locations))
+	for k, v := range model.Locations {
+		locations[k] = v.ValueString()
+	}
+
+	data, err := json.Marshal(struct {
+		Configuration configExportConfiguration `json:"configuration"`
+		Locations     map[string]string         `json:"locations"`
+		Schema        s.JsonNamingSchema        `json:"schema"`
+		Settings      *s.BuildNameSettingsModel `json:"settings"`
+		NameType      string                    `json:"name_type"`
+		Name          *string                   `json:"name"`
+	}{
+		Configuration: newConfigExportConfiguration(configuration),
+		Locations:     locations,
+		Schema:        typeSchema.ToJsonNamingSchema(),
+		Settings:      buildNameSettings,
+		NameType:      nameType,
+		Name:          name.ValueStringPointer(),
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to hash name inputs: %w", err)
+	}
+
+	return hashStr(string(data)), nil
+}
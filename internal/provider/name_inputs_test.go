@@ -0,0 +1,27 @@
+// Copyright glueckkanja AG 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	s "terraform-provider-standesamt/internal/schema"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNameInputsHash(t *testing.T) {
+	nb := makeTestBuilderForBudget(s.DefaultNamePrecedence[:], &s.BuildNameSettingsModel{})
+	key := func(settings *s.BuildNameSettingsModel, name string) string {
+		k, err := nameInputsHash(context.Background(), nb.model, "azurerm_storage_account", settings, types.StringValue(name), nb.typeSchema)
+		assert.NoError(t, err)
+		return k
+	}
+
+	first := key(&s.BuildNameSettingsModel{}, "app")
+	assert.Equal(t, first, key(&s.BuildNameSettingsModel{}, "app"))
+	assert.NotEqual(t, first, key(&s.BuildNameSettingsModel{}, "web"))
+	assert.NotEqual(t, first, key(&s.BuildNameSettingsModel{HashLength: 6}, "app"))
+}
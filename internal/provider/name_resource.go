@@ -0,0 +1,445 @@
+// Copyright glueckkanja AG 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"strings"
+	s "terraform-provider-standesamt/internal/schema"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &NameResource{}
+	_ resource.ResourceWithConfigure   = &NameResource{}
+	_ resource.ResourceWithModifyPlan  = &NameResource{}
+	_ resource.ResourceWithIdentity    = &NameResource{}
+	_ resource.ResourceWithImportState = &NameResource{}
+)
+
+// uniqueNamePlanSeed is the placeholder seed a unique name is checked with at
+// plan time, before its seed is generated.
+const uniqueNamePlanSeed = 1
+
+// nameResourceArguments are the attributes of the name resources in the order
+// of the arguments of the name function, to report argument errors.
+var nameResourceArguments = []string{"configurations", "type", "settings", "name"}
+
+type nameResourceModel struct {
+	Id             types.String  `tfsdk:"id"`
+	Configurations types.Object  `tfsdk:"configurations"`
+	Type           types.String  `tfsdk:"type"`
+	Name           types.String  `tfsdk:"name"`
+	Settings       types.Dynamic `tfsdk:"settings"`
+	InputsHash     types.String  `tfsdk:"inputs_hash"`
+	Result         types.String  `tfsdk:"result"`
+}
+
+type uniqueNameResourceModel struct {
+	nameResourceModel
+	RandomSeed types.Int64 `tfsdk:"random_seed"`
+}
+
+// nameResourceIdentityModel is the identity of the name resources. The inputs
+// hash changes with the inputs of the name, so the identity is mutable.
+type nameResourceIdentityModel struct {
+	Type       types.String `tfsdk:"type"`
+	InputsHash types.String `tfsdk:"inputs_hash"`
+}
+
+func NewNameResource() resource.Resource {
+	return &NameResource{}
+}
+
+func NewUniqueNameResource() resource.Resource {
+	return &NameResource{unique: true}
+}
+
+// NameResource defines the standesamt_name resource, and the
+// standesamt_unique_name resource if unique is set. Both keep the name built by
+// the name function in the state. The unique name hashes with a random seed
+// generated once per resource instead of the seed of the configuration.
+type NameResource struct {
+	unique         bool
+	providerConfig *ProviderConfig
+}
+
+func (r *NameResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_name"
+	if r.unique {
+		resp.TypeName = req.ProviderTypeName + "_unique_name"
+	}
+	resp.ResourceBehavior.MutableIdentity = true
+}
+
+func (r *NameResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Resource to build a resource name like the name function and keep it in the state.",
+		MarkdownDescription: "Resource to build a resource name like the `name` function and keep it in the state. The name is built at plan time and only replaced if it changes, e.g. after a change of the naming schema. The resource supports import by identity, see `type` and `inputs_hash`.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				Description:         "The built name.",
+				MarkdownDescription: "The built name.",
+			},
+			"configurations": schema.ObjectAttribute{
+				Required:            true,
+				Description:         "The configurations argument of the name function, e.g. data.standesamt_config.default.",
+				MarkdownDescription: "The `configurations` argument of the `name` function, e.g. `data.standesamt_config.default`.",
+				AttributeTypes:      configurationsTypeAttributes(),
+			},
+			"type": schema.StringAttribute{
+				Required:            true,
+				Description:         "The resource type to build the name for, e.g. 'azurerm_storage_account'.",
+				MarkdownDescription: "The resource type to build the name for, e.g. `azurerm_storage_account`.",
+			},
+			"name": schema.StringAttribute{
+				Optional:            true,
+				Description:         "The name segment of the name.",
+				MarkdownDescription: "The name segment of the name.",
+			},
+			"settings": schema.DynamicAttribute{
+				Optional:            true,
+				Description:         "The per-call settings, see the settings argument of the name function.",
+				MarkdownDescription: "The per-call settings, see the `settings` argument of the `name` function.",
+			},
+			"inputs_hash": schema.StringAttribute{
+				Computed:            true,
+				Description:         "A hash of everything the name depends on. Part of the identity of the resource.",
+				MarkdownDescription: "A hash of everything the name depends on. Part of the identity of the resource.",
+			},
+			"result": schema.StringAttribute{
+				Computed:            true,
+				Description:         "The built name.",
+				MarkdownDescription: "The built name.",
+			},
+		},
+	}
+
+	if r.unique {
+		resp.Schema.Description = "Resource to build a unique resource name with a random hash and keep it in the state."
+		resp.Schema.MarkdownDescription = "Resource to build a resource name like `standesamt_name`, with the hash derived from a random seed generated once per resource instead of the `random_seed` of the configuration. Two resources with the same arguments get different names, e.g. for globally unique names. The seed is kept as long as the resource exists; a replacement, e.g. after the name changed, generates a new seed and thus a new hash."
+		resp.Schema.Attributes["random_seed"] = schema.Int64Attribute{
+			Computed:            true,
+			Description:         "The random seed of the hash, generated on create and again when the resource is replaced.",
+			MarkdownDescription: "The random seed of the hash, generated on create and again when the resource is replaced.",
+		}
+	}
+}
+
+func (r *NameResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"type": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "The resource type the name is built for.",
+			},
+			"inputs_hash": identityschema.StringAttribute{
+				OptionalForImport: true,
+				Description:       "The hash of the inputs of the name. If set, the plan warns if the configuration builds the name from other inputs.",
+			},
+		},
+	}
+}
+
+// getModel reads the model of the resource from state or plan, the random
+// seed is null for standesamt_name.
+func (r *NameResource) getModel(ctx context.Context, get func(context.Context, any) diag.Diagnostics) (uniqueNameResourceModel, diag.Diagnostics) {
+	var data uniqueNameResourceModel
+	if r.unique {
+		diags := get(ctx, &data)
+		return data, diags
+	}
+	diags := get(ctx, &data.nameResourceModel)
+	data.RandomSeed = types.Int64Null()
+	return data, diags
+}
+
+// setModel is the counterpart of getModel.
+func (r *NameResource) setModel(ctx context.Context, set func(context.Context, any) diag.Diagnostics, data *uniqueNameResourceModel) diag.Diagnostics {
+	if r.unique {
+		return set(ctx, data)
+	}
+	return set(ctx, &data.nameResourceModel)
+}
+
+func (r *NameResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*ProviderConfig)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ProviderConfig, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.providerConfig = data
+}
+
+func (r *NameResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to plan on destroy.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	plan, diags := r.getModel(ctx, req.Plan.Get)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+	state := uniqueNameResourceModel{}
+	if !req.State.Raw.IsNull() {
+		state, diags = r.getModel(ctx, req.State.Get)
+		if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+			return
+		}
+		// The seed of a unique name is generated once.
+		if r.unique && !state.RandomSeed.IsNull() {
+			plan.RandomSeed = state.RandomSeed
+		}
+	}
+
+	// A unique name is only known after its seed is generated. It is built with
+	// a placeholder seed, so invalid arguments fail the plan instead of the apply.
+	if r.unique && (plan.RandomSeed.IsUnknown() || plan.RandomSeed.IsNull()) {
+		_, _, diags := buildResourceName(ctx, &plan.nameResourceModel, uniqueNamePlanSeed, r.providerConfig)
+		if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+			return
+		}
+		plan.RandomSeed = types.Int64Unknown()
+		plan.Result, plan.Id, plan.InputsHash = types.StringUnknown(), types.StringUnknown(), types.StringUnknown()
+		resp.Diagnostics.Append(r.setModel(ctx, resp.Plan.Set, &plan)...)
+		return
+	}
+
+	result, inputsHash, diags := buildResourceName(ctx, &plan.nameResourceModel, plan.RandomSeed.ValueInt64(), r.providerConfig)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+	plan.Result, plan.Id, plan.InputsHash = result, result, inputsHash
+	// Names are counted when planned, so the usage stats cover them in the
+	// plan and the apply.
+	if r.providerConfig != nil && !result.IsUnknown() {
+		r.providerConfig.usageStats.record(plan.Type.ValueString())
+	}
+
+	if !req.State.Raw.IsNull() {
+		if !state.Result.IsNull() && !result.IsUnknown() && !state.Result.Equal(result) {
+			resp.RequiresReplace = append(resp.RequiresReplace, path.Root("result"))
+		}
+		// An imported name has an inputs hash, but no result yet.
+		if state.Result.IsNull() && !state.InputsHash.IsNull() && !inputsHash.IsUnknown() && !state.InputsHash.Equal(inputsHash) {
+			resp.Diagnostics.AddAttributeWarning(
+				path.Root("inputs_hash"),
+				"Inputs differ from the imported identity",
+				fmt.Sprintf("The name is built from inputs with the hash %s, but the imported identity has the inputs hash %s. The imported name may differ from the name built by the configuration.", inputsHash.ValueString(), state.InputsHash.ValueString()),
+			)
+		}
+	}
+
+	resp.Diagnostics.Append(r.setModel(ctx, resp.Plan.Set, &plan)...)
+}
+
+func (r *NameResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	data, diags := r.getModel(ctx, req.Plan.Get)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.apply(ctx, &data, &resp.State, resp.Identity, &resp.Diagnostics)
+}
+
+func (r *NameResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	data, diags := r.getModel(ctx, req.State.Get)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The name only lives in the state.
+	resp.Diagnostics.Append(r.setModel(ctx, resp.State.Set, &data)...)
+}
+
+func (r *NameResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	data, diags := r.getModel(ctx, req.Plan.Get)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.apply(ctx, &data, &resp.State, resp.Identity, &resp.Diagnostics)
+}
+
+func (r *NameResource) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+	// The name only lives in the state, nothing to delete.
+}
+
+func (r *NameResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	identity := nameResourceIdentityModel{InputsHash: types.StringNull()}
+	if req.ID != "" {
+		// The import id is the resource type, optionally followed by the
+		// inputs hash, e.g. azurerm_storage_account/<inputs_hash>.
+		nameType, inputsHash, found := strings.Cut(req.ID, "/")
+		identity.Type = types.StringValue(nameType)
+		if found {
+			identity.InputsHash = types.StringValue(inputsHash)
+		}
+	} else if resp.Diagnostics.Append(req.Identity.Get(ctx, &identity)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	if identity.Type.ValueString() == "" {
+		resp.Diagnostics.AddError("Invalid import", "the resource type of the name is required, e.g. azurerm_storage_account or azurerm_storage_account/<inputs_hash>")
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("type"), identity.Type)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("inputs_hash"), identity.InputsHash)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, identity)...)
+}
+
+// apply builds the name of data, generating the seed of a unique name if it is
+// not set yet, and stores it in the state with the identity of the resource.
+func (r *NameResource) apply(ctx context.Context, data *uniqueNameResourceModel, state *tfsdk.State, identity *tfsdk.ResourceIdentity, diagnostics *diag.Diagnostics) {
+	if r.unique && (data.RandomSeed.IsUnknown() || data.RandomSeed.IsNull()) {
+		seed, err := rand.Int(rand.Reader, big.NewInt(math.MaxInt64))
+		if err != nil {
+			diagnostics.AddError("Failed to generate random seed", err.Error())
+			return
+		}
+		// Seeds start at 1, as a zero seed falls back to the configuration.
+		data.RandomSeed = types.Int64Value(seed.Int64() + 1)
+	}
+
+	result, inputsHash, diags := buildResourceName(ctx, &data.nameResourceModel, data.RandomSeed.ValueInt64(), r.providerConfig)
+	if diagnostics.Append(diags...); diagnostics.HasError() {
+		return
+	}
+	if result.IsUnknown() {
+		diagnostics.AddError("Unknown name arguments", "the arguments of the name must be known on apply")
+		return
+	}
+	data.Result, data.Id, data.InputsHash = result, result, inputsHash
+
+	if diagnostics.Append(r.setModel(ctx, state.Set, data)...); diagnostics.HasError() {
+		return
+	}
+	diagnostics.Append(identity.Set(ctx, nameResourceIdentityModel{Type: data.Type, InputsHash: inputsHash})...)
+}
+
+// buildResourceName builds the name of a name resource like the name function
+// and returns it with the hash of its inputs. Both are unknown if an argument
+// is unknown. A seed greater than zero replaces the seed of the settings. The
+// compatibility schema of the resource type is looked up in config, which may
+// be nil, if the configurations do not embed it.
+func buildResourceName(ctx context.Context, data *nameResourceModel, seed int64, config *ProviderConfig) (types.String, types.String, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	unknown := types.StringUnknown()
+
+	if data.Type.IsUnknown() || data.Name.IsUnknown() {
+		return unknown, unknown, diags
+	}
+
+	resp := &function.RunResponse{}
+	nameType := data.Type.ValueString()
+	model, settings, typeSchema, err := parseConfigurations(ctx, data.Configurations, nameType, data.Settings, resp)
+	if errors.Is(err, errUnknownArguments) {
+		return unknown, unknown, diags
+	}
+	if err == nil {
+		if seed > 0 {
+			settings.RandomSeed = seed
+		}
+		warnDeprecated(ctx, nameType, typeSchema)
+		if message := deprecationMessage(nameType, typeSchema); message != "" {
+			diags.AddAttributeWarning(path.Root("type"), "Deprecated resource type", message)
+		}
+	}
+	if resp.Error != nil {
+		return unknown, unknown, nameResourceDiagnostics(resp.Error)
+	}
+
+	key, err := nameInputsHash(ctx, model, nameType, settings, data.Name, typeSchema)
+	if err != nil {
+		diags.AddError("Failed to hash name inputs", err.Error())
+		return unknown, unknown, diags
+	}
+
+	if err := lookupCompatibilitySchema(ctx, model, nameType, config); err != nil {
+		diags.AddError("compatibility_ref", err.Error())
+		return unknown, unknown, diags
+	}
+
+	builder, _ := buildAndCheckName(ctx, model, nameType, settings, data.Name, typeSchema, resp)
+	if resp.Error != nil {
+		return unknown, unknown, nameResourceDiagnostics(resp.Error)
+	}
+	diags.Append(nameWarningDiagnostics(builder.warnings)...)
+	return builder.result.Name, types.StringValue(key), diags
+}
+
+// nameWarningDiagnostics converts the warnings of a built name, which the name
+// function can only log, into warning diagnostics.
+func nameWarningDiagnostics(warnings []string) diag.Diagnostics {
+	var diags diag.Diagnostics
+	for _, warning := range warnings {
+		diags.AddWarning("Name warning", warning)
+	}
+	return diags
+}
+
+// lookupCompatibilitySchema adds the compatibility schema of the resource type
+// from the provider configuration to the model. The standesamt_config data
+// source only embeds the compatibility schemas of the resource types whose
+// naming schema differs, and configurations built otherwise may not embed any.
+func lookupCompatibilitySchema(ctx context.Context, model *configurationsModel, nameType string, config *ProviderConfig) error {
+	if config == nil {
+		return nil
+	}
+	if _, ok := model.Configuration.Compatibility.Elements()[nameType]; ok {
+		return nil
+	}
+	compatibilitySchemaMap, err := config.CompatibilityNamingSchemaMap(ctx)
+	if err != nil {
+		return err
+	}
+	typeSchema, ok := compatibilitySchemaMap[nameType]
+	if !ok {
+		return nil
+	}
+	compatibility, diags := types.MapValueFrom(ctx, types.ObjectType{AttrTypes: s.SchemaTypeAttributes()}, s.NamingSchemaMap{nameType: typeSchema})
+	if diags.HasError() {
+		return fmt.Errorf("failed to convert compatibility schema for type '%s'", nameType)
+	}
+	model.Configuration.Compatibility = compatibility
+	return nil
+}
+
+// nameResourceDiagnostics converts an error of the name function into
+// diagnostics, argument errors are reported for the matching attribute.
+func nameResourceDiagnostics(funcErr *function.FuncError) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if funcErr.FunctionArgument != nil && int(*funcErr.FunctionArgument) < len(nameResourceArguments) {
+		diags.AddAttributeError(path.Root(nameResourceArguments[*funcErr.FunctionArgument]), "Invalid name arguments", funcErr.Text)
+		return diags
+	}
+	diags.AddError("Invalid name", funcErr.Text)
+	return diags
+}
@@ -0,0 +1,229 @@
+// Copyright glueckkanja AG 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"maps"
+	"regexp"
+	"slices"
+	"strings"
+	s "terraform-provider-standesamt/internal/schema"
+	"testing"
+
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuildResourceName(t *testing.T) {
+	ctx := context.Background()
+	configurations, diags := types.ObjectValueFrom(ctx, configurationsTypeAttributes(), benchmarkConfigurations(t))
+	assert.False(t, diags.HasError())
+
+	data := &nameResourceModel{
+		Configurations: configurations,
+		Type:           types.StringValue(benchmarkResourceType),
+		Name:           types.StringValue("billing"),
+		Settings:       types.DynamicNull(),
+	}
+
+	result, inputsHash, diags := buildResourceName(ctx, data, 0, nil)
+	assert.False(t, diags.HasError())
+	assert.Regexp(t, `^t250-app-core-billing-we-prd-[a-z0-9]{4}-001$`, result.ValueString())
+	assert.NotEmpty(t, inputsHash.ValueString())
+
+	// The seed changes the hash and the inputs hash, not the rest of the name.
+	seeded, seededHash, diags := buildResourceName(ctx, data, 42, nil)
+	assert.False(t, diags.HasError())
+	assert.NotEqual(t, result, seeded)
+	assert.NotEqual(t, inputsHash, seededHash)
+	assert.Equal(t, result.ValueString()[:len(result.ValueString())-8], seeded.ValueString()[:len(seeded.ValueString())-8])
+
+	data.Name = types.StringUnknown()
+	result, inputsHash, diags = buildResourceName(ctx, data, 0, nil)
+	assert.False(t, diags.HasError())
+	assert.True(t, result.IsUnknown())
+	assert.True(t, inputsHash.IsUnknown())
+
+	data.Name = types.StringValue("billing")
+	data.Type = types.StringValue("azurerm_unknown")
+	_, _, diags = buildResourceName(ctx, data, 0, nil)
+	if assert.True(t, diags.HasError()) {
+		assert.Contains(t, diags.Errors()[0].Detail(), "resource type 'azurerm_unknown' not found in schema")
+	}
+}
+
+func TestBuildResourceNameWarnings(t *testing.T) {
+	ctx := context.Background()
+	model := benchmarkConfigurations(t)
+	model.Configuration.Strict = types.BoolValue(false)
+	configurations, diags := types.ObjectValueFrom(ctx, configurationsTypeAttributes(), model)
+	assert.False(t, diags.HasError())
+
+	data := &nameResourceModel{
+		Configurations: configurations,
+		Type:           types.StringValue(benchmarkResourceType),
+		Name:           types.StringValue("bill_ing"),
+		Settings:       types.DynamicNull(),
+	}
+
+	// A non-strict violation returns the name with a warning diagnostic, the
+	// name function can only log it.
+	result, _, diags := buildResourceName(ctx, data, 0, nil)
+	assert.False(t, diags.HasError())
+	assert.Contains(t, result.ValueString(), "bill_ing")
+	if assert.Len(t, diags.Warnings(), 1) {
+		assert.Equal(t, "Name warning", diags.Warnings()[0].Summary())
+	}
+}
+
+func TestLookupCompatibilitySchema(t *testing.T) {
+	ctx := context.Background()
+	model := &configurationsModel{}
+	model.Configuration.Compatibility = types.MapNull(types.ObjectType{AttrTypes: s.SchemaTypeAttributes()})
+
+	// Without a provider configuration nothing is looked up.
+	assert.NoError(t, lookupCompatibilitySchema(ctx, model, "azurerm_storage_account", nil))
+	assert.True(t, model.Configuration.Compatibility.IsNull())
+
+	config := &ProviderConfig{SourceRef: testLibrary}
+	config.ProviderData.configProviderDefaults()
+	namingSchemaMap, err := config.NamingSchemaMap()
+	assert.NoError(t, err)
+	config.compatibilityOnce.Do(func() {
+		config.compatibilitySchemaMap = namingSchemaMap
+	})
+
+	assert.NoError(t, lookupCompatibilitySchema(ctx, model, "azurerm_key_vault", config))
+	assert.True(t, model.Configuration.Compatibility.IsNull())
+
+	assert.NoError(t, lookupCompatibilitySchema(ctx, model, "azurerm_resource_group", config))
+	assert.Equal(t, []string{"azurerm_resource_group"}, slices.Collect(maps.Keys(model.Configuration.Compatibility.Elements())))
+}
+
+func TestNameResourceUsageStats(t *testing.T) {
+	ctx := context.Background()
+	configurations, diags := types.ObjectValueFrom(ctx, configurationsTypeAttributes(), benchmarkConfigurations(t))
+	assert.False(t, diags.HasError())
+
+	config := &ProviderConfig{}
+	config.usageStats.enable()
+
+	r := &NameResource{}
+	configureResp := &fwresource.ConfigureResponse{}
+	r.Configure(ctx, fwresource.ConfigureRequest{ProviderData: config}, configureResp)
+	assert.False(t, configureResp.Diagnostics.HasError())
+
+	schemaResp := &fwresource.SchemaResponse{}
+	r.Schema(ctx, fwresource.SchemaRequest{}, schemaResp)
+	nullValue := tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)
+
+	plan := tfsdk.Plan{Schema: schemaResp.Schema, Raw: nullValue}
+	assert.False(t, r.setModel(ctx, plan.Set, &uniqueNameResourceModel{nameResourceModel: nameResourceModel{
+		Id:             types.StringUnknown(),
+		Configurations: configurations,
+		Type:           types.StringValue(benchmarkResourceType),
+		Name:           types.StringValue("billing"),
+		Settings:       types.DynamicNull(),
+		InputsHash:     types.StringUnknown(),
+		Result:         types.StringUnknown(),
+	}}).HasError())
+
+	req := fwresource.ModifyPlanRequest{Plan: plan, State: tfsdk.State{Schema: schemaResp.Schema, Raw: nullValue}}
+	resp := &fwresource.ModifyPlanResponse{Plan: plan}
+	r.ModifyPlan(ctx, req, resp)
+	assert.False(t, resp.Diagnostics.HasError())
+
+	// The name planned by the configured resource is counted in the stats of
+	// its provider configuration.
+	enabled, counts := config.usageStats.snapshot()
+	assert.True(t, enabled)
+	assert.Equal(t, map[string]int64{benchmarkResourceType: 1}, counts)
+}
+
+func TestUniqueNameResourcePlan(t *testing.T) {
+	ctx := context.Background()
+	configurations, diags := types.ObjectValueFrom(ctx, configurationsTypeAttributes(), benchmarkConfigurations(t))
+	assert.False(t, diags.HasError())
+
+	r := &NameResource{unique: true}
+	schemaResp := &fwresource.SchemaResponse{}
+	r.Schema(ctx, fwresource.SchemaRequest{}, schemaResp)
+	nullValue := tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)
+
+	modifyPlan := func(name string) *fwresource.ModifyPlanResponse {
+		plan := tfsdk.Plan{Schema: schemaResp.Schema, Raw: nullValue}
+		assert.False(t, r.setModel(ctx, plan.Set, &uniqueNameResourceModel{
+			nameResourceModel: nameResourceModel{
+				Id:             types.StringUnknown(),
+				Configurations: configurations,
+				Type:           types.StringValue(benchmarkResourceType),
+				Name:           types.StringValue(name),
+				Settings:       types.DynamicNull(),
+				InputsHash:     types.StringUnknown(),
+				Result:         types.StringUnknown(),
+			},
+			RandomSeed: types.Int64Unknown(),
+		}).HasError())
+
+		req := fwresource.ModifyPlanRequest{Plan: plan, State: tfsdk.State{Schema: schemaResp.Schema, Raw: nullValue}}
+		resp := &fwresource.ModifyPlanResponse{Plan: plan}
+		r.ModifyPlan(ctx, req, resp)
+		return resp
+	}
+
+	// The name stays unknown until its seed is generated.
+	resp := modifyPlan("billing")
+	assert.False(t, resp.Diagnostics.HasError())
+	planned, diags := r.getModel(ctx, resp.Plan.Get)
+	assert.False(t, diags.HasError())
+	assert.True(t, planned.Result.IsUnknown())
+	assert.True(t, planned.RandomSeed.IsUnknown())
+
+	// Invalid arguments fail the plan.
+	resp = modifyPlan(strings.Repeat("billing", 20))
+	assert.True(t, resp.Diagnostics.HasError())
+}
+
+func TestAccStandesamtName(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactoriesWithLibrary(testLibrary),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "standesamt_config" "default" {
+  environment = "dev"
+}
+
+resource "standesamt_name" "rg" {
+  configurations = data.standesamt_config.default
+  type           = "azurerm_resource_group"
+  name           = "app"
+  settings       = { name_precedence = ["abbreviation", "name", "environment"] }
+}
+
+resource "standesamt_unique_name" "rg" {
+  count = 2
+
+  configurations = data.standesamt_config.default
+  type           = "azurerm_resource_group"
+  name           = "app"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("standesamt_name.rg", "result", "rg-app-dev"),
+					resource.TestCheckResourceAttrPair("standesamt_name.rg", "id", "standesamt_name.rg", "result"),
+					resource.TestCheckResourceAttrSet("standesamt_name.rg", "inputs_hash"),
+					resource.TestCheckResourceAttrSet("standesamt_unique_name.rg.0", "random_seed"),
+					resource.TestMatchResourceAttr("standesamt_unique_name.rg.0", "result", regexp.MustCompile(`^rg-app-dev-`)),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,134 @@
+// Copyright glueckkanja AG 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	s "terraform-provider-standesamt/internal/schema"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &NamingSchemaDataSource{}
+
+func NewNamingSchemaDataSource() datasource.DataSource {
+	return &NamingSchemaDataSource{}
+}
+
+// NamingSchemaDataSource defines the data source implementation.
+type NamingSchemaDataSource struct {
+	providerConfig *ProviderConfig
+}
+
+func (d *NamingSchemaDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_naming_schema"
+}
+
+func (d *NamingSchemaDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Data source to read the naming schema of a single resource type from the schema library.",
+		MarkdownDescription: "Data source to read the naming schema of a single resource type from the schema library. Use it to drive your own validation or variable constraints without pulling the entire schema map of `standesamt_config` into state.",
+		Attributes: map[string]schema.Attribute{
+			"resource_type": schema.StringAttribute{
+				Required:            true,
+				Description:         "The resource type to read the naming schema for, e.g. 'azurerm_resource_group'.",
+				MarkdownDescription: "The resource type to read the naming schema for, e.g. `azurerm_resource_group`.",
+			},
+			"abbreviation": schema.StringAttribute{
+				Computed:            true,
+				Description:         "The abbreviation of the resource type.",
+				MarkdownDescription: "The abbreviation of the resource type.",
+			},
+			"min_length": schema.Int64Attribute{
+				Computed:            true,
+				Description:         "The minimum length of a name for the resource type.",
+				MarkdownDescription: "The minimum length of a name for the resource type.",
+			},
+			"max_length": schema.Int64Attribute{
+				Computed:            true,
+				Description:         "The maximum length of a name for the resource type.",
+				MarkdownDescription: "The maximum length of a name for the resource type.",
+			},
+			"validation_regex": schema.StringAttribute{
+				Computed:            true,
+				Description:         "The regular expression a name for the resource type has to match.",
+				MarkdownDescription: "The regular expression a name for the resource type has to match.",
+			},
+			"configuration": schema.ObjectAttribute{
+				Computed:            true,
+				Description:         "The naming configuration of the resource type, e.g. the name precedence and casing rules.",
+				MarkdownDescription: "The naming configuration of the resource type, e.g. the name precedence and casing rules.",
+				AttributeTypes:      s.SchemaTypeAttributes()["configuration"].(types.ObjectType).AttrTypes,
+			},
+			"deprecated": schema.BoolAttribute{
+				Computed:            true,
+				Description:         "True if the resource type is deprecated in the schema library.",
+				MarkdownDescription: "True if the resource type is deprecated in the schema library.",
+			},
+			"replaced_by": schema.StringAttribute{
+				Computed:            true,
+				Description:         "The resource type that replaces a deprecated resource type, e.g. 'azurerm_linux_web_app'.",
+				MarkdownDescription: "The resource type that replaces a deprecated resource type, e.g. `azurerm_linux_web_app`.",
+			},
+			"scope": schema.StringAttribute{
+				Computed:            true,
+				Description:         "The scope in which a name has to be unique: 'global', 'resourceGroup' or 'parent'. Empty if the schema library does not define it.",
+				MarkdownDescription: "The scope in which a name has to be unique: `global`, `resourceGroup` or `parent`. Empty if the schema library does not define it.",
+			},
+		},
+	}
+}
+
+func (d *NamingSchemaDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*ProviderConfig)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *ProviderConfig, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.providerConfig = data
+}
+
+func (d *NamingSchemaDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var resourceType types.String
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("resource_type"), &resourceType)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	namingSchemaMap, err := d.providerConfig.NamingSchemaMap()
+	if err != nil {
+		resp.Diagnostics.AddError("source_reference", err.Error())
+		return
+	}
+
+	namingSchema, ok := namingSchemaMap[resourceType.ValueString()]
+	if !ok {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("resource_type"),
+			"Unknown resource type",
+			fmt.Sprintf("resource type '%s' not found in schema library", resourceType.ValueString()),
+		)
+		return
+	}
+	if message := deprecationMessage(resourceType.ValueString(), &namingSchema); message != "" {
+		resp.Diagnostics.AddAttributeWarning(path.Root("resource_type"), "Deprecated resource type", message)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &namingSchema)...)
+}
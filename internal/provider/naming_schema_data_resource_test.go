@@ -0,0 +1,54 @@
+// Copyright glueckkanja AG 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccStandesamtNamingSchema(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactoriesUnique(),
+		ExternalProviders:        map[string]resource.ExternalProvider{},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNamingSchemaDataSourceConfig("azurerm_resource_group"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.standesamt_naming_schema.test", "resource_type", "azurerm_resource_group"),
+					resource.TestCheckResourceAttr("data.standesamt_naming_schema.test", "abbreviation", "rg"),
+					resource.TestCheckResourceAttrSet("data.standesamt_naming_schema.test", "max_length"),
+					resource.TestCheckResourceAttrSet("data.standesamt_naming_schema.test", "validation_regex"),
+					resource.TestCheckResourceAttrSet("data.standesamt_naming_schema.test", "configuration.use_separator"),
+					resource.TestCheckResourceAttr("data.standesamt_naming_schema.test", "deprecated", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccStandesamtNamingSchemaUnknownType(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactoriesUnique(),
+		ExternalProviders:        map[string]resource.ExternalProvider{},
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccNamingSchemaDataSourceConfig("invalid_resource_type"),
+				ExpectError: regexp.MustCompile(`resource type 'invalid_resource_type' not found in schema library`),
+			},
+		},
+	})
+}
+
+func testAccNamingSchemaDataSourceConfig(resourceType string) string {
+	return `
+data "standesamt_naming_schema" "test" {
+	resource_type = "` + resourceType + `"
+}
+`
+}
@@ -0,0 +1,120 @@
+// Copyright glueckkanja AG 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+const (
+	postProcessLowercase          = "lowercase"
+	postProcessSanitize           = "sanitize"
+	postProcessTruncate           = "truncate"
+	postProcessCollapseSeparators = "collapse_separators"
+)
+
+// postProcessor is a step of the post-processing pipeline of the name builder.
+// It transforms the segments of a built name, including the hash.
+type postProcessor func(nb *nameBuilder, segments []nameSegment) []nameSegment
+
+// postProcessors are the built-in steps of the post_process setting. New
+// transforms of the built name are added here instead of as further settings.
+var postProcessors = map[string]postProcessor{
+	postProcessLowercase:          lowercaseSegments,
+	postProcessSanitize:           sanitizeSegments,
+	postProcessTruncate:           (*nameBuilder).truncateKeepHash,
+	postProcessCollapseSeparators: collapseSeparators,
+}
+
+// validatePostProcess reports unknown and repeated steps of the post_process
+// setting.
+func validatePostProcess(steps []string) error {
+	for i, step := range steps {
+		if _, ok := postProcessors[step]; !ok {
+			names := make([]string, 0, len(postProcessors))
+			for name := range postProcessors {
+				names = append(names, name)
+			}
+			slices.Sort(names)
+			return fmt.Errorf("invalid post_process step '%s', expected one of: %s", step, strings.Join(names, ", "))
+		}
+		if slices.Contains(steps[:i], step) {
+			return fmt.Errorf("post_process step '%s' is listed more than once", step)
+		}
+	}
+	return nil
+}
+
+// postProcessSteps returns the steps of the pipeline in order. The
+// truncate_keep_hash setting adds a truncate step if post_process does not
+// truncate itself, the collapse_separators setting a final collapse_separators
+// step.
+func (nb *nameBuilder) postProcessSteps() []string {
+	steps := slices.Clone(nb.buildNameSettings.PostProcess)
+	if nb.buildNameSettings.TruncateKeepHash && !slices.Contains(steps, postProcessTruncate) {
+		steps = append(steps, postProcessTruncate)
+	}
+	if nb.buildNameSettings.CollapseSeparators {
+		steps = slices.DeleteFunc(steps, func(step string) bool { return step == postProcessCollapseSeparators })
+		steps = append(steps, postProcessCollapseSeparators)
+	}
+	return steps
+}
+
+// postProcess runs the steps of the pipeline on the segments of the name.
+func (nb *nameBuilder) postProcess(segments []nameSegment) []nameSegment {
+	for _, step := range nb.postProcessSteps() {
+		segments = postProcessors[step](nb, segments)
+	}
+	return segments
+}
+
+// lowercaseSegments converts all segments to lower case at this position of
+// the pipeline, independent of the casing of the configuration.
+func lowercaseSegments(_ *nameBuilder, segments []nameSegment) []nameSegment {
+	for i := range segments {
+		segments[i].Value = strings.ToLower(segments[i].Value)
+	}
+	return segments
+}
+
+// sanitizeSegments removes all characters other than ASCII letters and digits
+// from the segments. Segments left empty are dropped with their separator.
+func sanitizeSegments(_ *nameBuilder, segments []nameSegment) []nameSegment {
+	sanitized := segments[:0]
+	for _, segment := range segments {
+		segment.Value = strings.Map(func(r rune) rune {
+			if r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' {
+				return r
+			}
+			return -1
+		}, segment.Value)
+		if segment.Value != "" {
+			sanitized = append(sanitized, segment)
+		}
+	}
+	return sanitized
+}
+
+// collapseSeparators collapses repeated separators inside the segments and
+// trims separators at their ends, so the joined name has neither repeated nor
+// leading or trailing separators. Segments left empty are dropped.
+func collapseSeparators(nb *nameBuilder, segments []nameSegment) []nameSegment {
+	separator := nb.result.Separator.ValueString()
+	if separator == "" {
+		return segments
+	}
+	collapsed := segments[:0]
+	for _, segment := range segments {
+		parts := strings.Split(segment.Value, separator)
+		parts = slices.DeleteFunc(parts, func(part string) bool { return part == "" })
+		segment.Value = strings.Join(parts, separator)
+		if segment.Value != "" {
+			collapsed = append(collapsed, segment)
+		}
+	}
+	return collapsed
+}
@@ -0,0 +1,56 @@
+// Copyright glueckkanja AG 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	s "terraform-provider-standesamt/internal/schema"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidatePostProcess(t *testing.T) {
+	assert.NoError(t, validatePostProcess(nil))
+	assert.NoError(t, validatePostProcess([]string{"sanitize", "lowercase", "collapse_separators", "truncate"}))
+	assert.ErrorContains(t, validatePostProcess([]string{"upper"}), "invalid post_process step 'upper', expected one of: collapse_separators, lowercase, sanitize, truncate")
+	assert.ErrorContains(t, validatePostProcess([]string{"truncate", "truncate"}), "listed more than once")
+}
+
+func TestBuildName_PostProcess(t *testing.T) {
+	build := func(name string, settings *s.BuildNameSettingsModel) string {
+		nb := makeTestBuilderForBudget(s.DefaultNamePrecedence[:], settings)
+		resp := &function.RunResponse{}
+		result := nb.buildName(types.StringValue(name), resp)
+		assert.Nil(t, resp.Error)
+		return result.ValueString()
+	}
+
+	assert.Regexp(t, `^st-app-MyApp-we-tst-[a-z]{4}$`, build("My App!", &s.BuildNameSettingsModel{PostProcess: []string{"sanitize"}}))
+	assert.Regexp(t, `^st-app-myapp-we-tst-[a-z]{4}$`, build("My App!", &s.BuildNameSettingsModel{PostProcess: []string{"sanitize", "lowercase"}}))
+	assert.Regexp(t, `^st-app-st-we-tst-[a-z]{4}$`, build("-st--", &s.BuildNameSettingsModel{PostProcess: []string{"collapse_separators"}}))
+
+	// Sanitizing first keeps the characters the truncation would otherwise
+	// spend on spaces.
+	assert.Regexp(t, `^st-app-abcde-we-tst-[a-z]{4}$`, build("a b c d e f g h", &s.BuildNameSettingsModel{PostProcess: []string{"sanitize", "truncate"}}))
+	assert.Regexp(t, `^st-app-abcde-we-tst-[a-z]{4}$`, build("abcdefgh", &s.BuildNameSettingsModel{TruncateKeepHash: true}))
+
+	// The collapse_separators setting runs after the steps of post_process.
+	assert.Regexp(t, `^st-app-a-b-we-tst-[a-z]{4}$`, build("-a--b-", &s.BuildNameSettingsModel{CollapseSeparators: true}))
+	nb := makeTestBuilderForBudget(s.DefaultNamePrecedence[:], &s.BuildNameSettingsModel{PostProcess: []string{"collapse_separators", "sanitize"}, TruncateKeepHash: true, CollapseSeparators: true})
+	assert.Equal(t, []string{"sanitize", "truncate", "collapse_separators"}, nb.postProcessSteps())
+
+	// A segment left empty is dropped with its separator.
+	assert.Regexp(t, `^st-app-we-tst-[a-z]{4}$`, build("!!", &s.BuildNameSettingsModel{PostProcess: []string{"sanitize"}}))
+
+	// Upper case would undo a lowercase step.
+	nb = makeTestBuilderForBudget(s.DefaultNamePrecedence[:], &s.BuildNameSettingsModel{PostProcess: []string{"lowercase"}, Uppercase: true})
+	resp := &function.RunResponse{}
+	nb.buildName(types.StringValue("app"), resp)
+	if assert.NotNil(t, resp.Error) {
+		assert.Contains(t, resp.Error.Error(), "post_process step 'lowercase' conflicts with the upper case")
+	}
+}
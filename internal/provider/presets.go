@@ -0,0 +1,108 @@
+// Copyright glueckkanja AG 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+	s "terraform-provider-standesamt/internal/schema"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Hash modes and charsets supported by the hash_mode and hash_charset settings.
+const (
+	hashModeRandom          = "random"
+	hashModeDerived         = "derived"
+	hashCharsetLowercase    = "lowercase"
+	hashCharsetAlphanumeric = "alphanumeric"
+	hashCharsetAuto         = "auto"
+)
+
+// namingPresets bundle settings for common naming requirements. Settings that are
+// passed explicitly take precedence over the preset.
+var namingPresets = map[string]s.BuildNameSettingsModel{
+	// global_unique is tuned for globally unique Azure resources such as storage
+	// accounts and key vaults.
+	"global_unique": {
+		UseSeparator:     types.BoolValue(false).ValueBoolPointer(),
+		Lowercase:        true,
+		HashLength:       4,
+		HashMode:         hashModeDerived,
+		HashCharset:      hashCharsetAlphanumeric,
+		TruncateKeepHash: true,
+	},
+}
+
+// applyPreset fills the settings that are absent from attrs, the settings
+// passed explicitly, with the values of the preset.
+func applyPreset(settings *s.BuildNameSettingsModel, attrs map[string]attr.Value) error {
+	if settings.Preset == "" {
+		return nil
+	}
+
+	preset, ok := namingPresets[settings.Preset]
+	if !ok {
+		names := make([]string, 0, len(namingPresets))
+		for name := range namingPresets {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		return fmt.Errorf("unknown preset '%s', expected one of: %s", settings.Preset, strings.Join(names, ", "))
+	}
+
+	isSet := func(key string) bool {
+		v, ok := attrs[key]
+		return ok && !v.IsNull()
+	}
+
+	if !isSet("use_separator") && !isSet("separator") {
+		settings.UseSeparator = preset.UseSeparator
+	}
+	if !isSet("lowercase") && !settings.Uppercase {
+		settings.Lowercase = preset.Lowercase
+	}
+	if !isSet("hash_length") {
+		settings.HashLength = preset.HashLength
+	}
+	if !isSet("hash_mode") {
+		settings.HashMode = preset.HashMode
+	}
+	if !isSet("hash_charset") {
+		settings.HashCharset = preset.HashCharset
+	}
+	if !isSet("truncate_keep_hash") {
+		settings.TruncateKeepHash = preset.TruncateKeepHash
+	}
+
+	return nil
+}
+
+// validateHashSettings checks the values of hash_mode and hash_charset.
+func validateHashSettings(settings *s.BuildNameSettingsModel) error {
+	if settings.HashMode != "" && !slices.Contains([]string{hashModeRandom, hashModeDerived}, settings.HashMode) {
+		return fmt.Errorf("invalid hash_mode '%s', expected one of: %s, %s", settings.HashMode, hashModeRandom, hashModeDerived)
+	}
+	if settings.HashCharset != "" && !slices.Contains([]string{hashCharsetLowercase, hashCharsetAlphanumeric, hashCharsetAuto}, settings.HashCharset) {
+		return fmt.Errorf("invalid hash_charset '%s', expected one of: %s, %s, %s", settings.HashCharset, hashCharsetLowercase, hashCharsetAlphanumeric, hashCharsetAuto)
+	}
+	if settings.CollisionDomain != "" {
+		if !collisionDomainPattern.MatchString(settings.CollisionDomain) {
+			return fmt.Errorf("invalid collision_domain '%s', expected <kind>:<id>, e.g. resource_group:rg-app-prod", settings.CollisionDomain)
+		}
+		if settings.HashMode != hashModeDerived {
+			return fmt.Errorf("collision_domain requires hash_mode '%s'", hashModeDerived)
+		}
+	}
+	return nil
+}
+
+// collisionDomainPattern is the format of the collision_domain setting: the
+// kind of the scope a name must be unique in, e.g. resource_group or
+// subscription, and the id of the scope.
+var collisionDomainPattern = regexp.MustCompile(`^[a-z][a-z_]*:.+$`)
@@ -7,6 +7,8 @@ import (
 	"context"
 	"crypto/sha256"
 	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
@@ -21,15 +23,41 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"io/fs"
-	"math"
+	"maps"
 	"os"
+	"path/filepath"
 	"strconv"
+	"sync"
 	s "terraform-provider-standesamt/internal/schema"
+	"terraform-provider-standesamt/internal/tools"
+	"time"
 )
 
 const (
 	standesamtLibRef  = "2026.01"
 	standesamtLibPath = "azure/caf"
+
+	// defaultDownloadTimeout bounds the schema library download so an
+	// unreachable source does not block a plan indefinitely.
+	defaultDownloadTimeout = "5m"
+	// defaultCacheMaxAgeDays is the number of days unused schema library
+	// downloads are kept in the cache directory.
+	defaultCacheMaxAgeDays = 30
+
+	// defaultMinGlobalHashLength is the minimum hash length of globally unique
+	// resource types, so a hash_length of 0 in the schema does not produce
+	// collision-prone names.
+	defaultMinGlobalHashLength = 4
+
+	locationMergeStrategyMerge   = "merge"
+	locationMergeStrategyReplace = "replace"
+
+	// locationSourceLibrary reads the locations from the schema library, the
+	// other location sources are the catalogs bundled in the schema package.
+	locationSourceLibrary = "library"
+
+	compatibilityModeError = "error"
+	compatibilityModeWarn  = "warn"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
@@ -47,9 +75,217 @@ func New(version string) func() provider.Provider {
 	}
 }
 
+// NewWithSchemaSource returns a provider that reads its schema library from
+// source instead of schema_reference, e.g. an in-memory s.FSSource. It allows
+// hermetic tests of the functions and data sources without network access.
+func NewWithSchemaSource(version string, source s.Source) func() provider.Provider {
+	return func() provider.Provider {
+		return &StandesamtProvider{
+			version:      version,
+			schemaSource: source,
+		}
+	}
+}
+
 type ProviderConfig struct {
 	SourceRef    fs.FS
 	ProviderData providerData
+
+	// FilePatterns are the file names of the naming and locations files of
+	// the schema library.
+	FilePatterns s.FilePatterns
+
+	// InlineSchemas are the inline_schema entries of the provider
+	// configuration, merged over the schema library.
+	InlineSchemas []s.JsonNamingSchema
+
+	// SchemaOverrides are the schema_overrides of the provider configuration,
+	// applied after the inline schemas.
+	SchemaOverrides map[string]s.LengthOverride
+
+	// Locations are the locations of the provider configuration. They are
+	// merged over or replace the library locations depending on
+	// LocationMergeStrategy.
+	Locations             map[string]string
+	LocationMergeStrategy string
+
+	// Environments are the environments of the provider configuration, merged
+	// over the environment catalog of the library.
+	Environments map[string]string
+
+	// LocationSource is the location_source of the provider configuration. A
+	// bundled location catalog replaces the library locations before Locations
+	// are applied.
+	LocationSource string
+
+	// CompatibilityRef is the compatibility_ref of the provider configuration,
+	// nil if it is not set.
+	CompatibilityRef *s.SourceValue
+
+	// usageStats counts the names built by the name resources if usage_stats
+	// is enabled.
+	usageStats usageStats
+
+	compatibilityOnce      sync.Once
+	compatibilitySchemaMap s.NamingSchemaMap
+	compatibilityErr       error
+
+	// The parsed schema library is memoized per provider configuration so
+	// repeated data source reads do not walk and unmarshal the library again.
+	processOnce      sync.Once
+	result           *s.Result
+	namingSchemaMap  s.NamingSchemaMap
+	libraryLocations s.LocationsMapSchema
+	processErr       error
+}
+
+// Result returns the parsed schema library of the provider configuration.
+// The library is processed on first use and the result is shared by all
+// subsequent callers. It is safe for concurrent use.
+func (c *ProviderConfig) Result() (*s.Result, error) {
+	c.process()
+	return c.result, c.processErr
+}
+
+// NamingSchemaMap returns the naming schemas of the parsed schema library
+// keyed by resource type. It is safe for concurrent use.
+func (c *ProviderConfig) NamingSchemaMap() (s.NamingSchemaMap, error) {
+	c.process()
+	return c.namingSchemaMap, c.processErr
+}
+
+// LocationsFromSource returns the locations of a location source other than the
+// one of the provider configuration, e.g. for the source argument of the
+// standesamt_locations data source. The locations of the provider configuration
+// are applied the same way. It is safe for concurrent use.
+func (c *ProviderConfig) LocationsFromSource(source string) (s.LocationsMapSchema, error) {
+	c.process()
+	if c.processErr != nil {
+		return nil, c.processErr
+	}
+	if source == "" || source == c.LocationSource {
+		return c.result.Locations, nil
+	}
+
+	locations := c.libraryLocations
+	if catalog, ok := s.LocationCatalog(source); ok {
+		locations = catalog
+	} else if source != locationSourceLibrary {
+		return nil, fmt.Errorf("unknown location source '%s'", source)
+	}
+	return mergeLocations(locations, c.Locations, c.LocationMergeStrategy), nil
+}
+
+// CompatibilityNamingSchemaMap returns the naming schemas of the compatibility
+// library keyed by resource type, nil if compatibility_ref is not set. The
+// library is downloaded on first use. It is safe for concurrent use.
+func (c *ProviderConfig) CompatibilityNamingSchemaMap(ctx context.Context) (s.NamingSchemaMap, error) {
+	c.compatibilityOnce.Do(func() {
+		if c.CompatibilityRef == nil {
+			return
+		}
+		timeout, err := parseDownloadTimeout(c.ProviderData.DownloadTimeout.ValueString())
+		if err != nil {
+			c.compatibilityErr = err
+			return
+		}
+		result, err := loadSchemaLibrary(ctx, *c.CompatibilityRef, timeout, c.ProviderData.CacheDir.ValueString())
+		if err != nil {
+			c.compatibilityErr = fmt.Errorf("compatibility_ref: %w", err)
+			return
+		}
+		schemas, err := s.ApplyLengthOverrides(s.MergeNamingSchemas(result.NamingSchemas, c.InlineSchemas), c.SchemaOverrides)
+		if err != nil {
+			c.compatibilityErr = fmt.Errorf("compatibility_ref: %w", err)
+			return
+		}
+		c.compatibilitySchemaMap = s.NewNamingSchemaMap(schemas)
+	})
+	return c.compatibilitySchemaMap, c.compatibilityErr
+}
+
+func (c *ProviderConfig) process() {
+	c.processOnce.Do(func() {
+		result := s.Result{}
+		process := s.NewProcessorClient(c.SourceRef).WithFilePatterns(c.FilePatterns)
+		if err := process.Process(&result); err != nil {
+			c.processErr = err
+			return
+		}
+		schemas, err := s.ApplyLengthOverrides(s.MergeNamingSchemas(result.NamingSchemas, c.InlineSchemas), c.SchemaOverrides)
+		if err != nil {
+			c.processErr = err
+			return
+		}
+		result.NamingSchemas = schemas
+		c.libraryLocations = result.Locations
+		if catalog, ok := s.LocationCatalog(c.LocationSource); ok {
+			result.Locations = catalog
+		}
+		result.Locations = mergeLocations(result.Locations, c.Locations, c.LocationMergeStrategy)
+		if len(c.Environments) > 0 {
+			environments := make(map[string]string, len(result.Environments.Environments)+len(c.Environments))
+			maps.Copy(environments, result.Environments.Environments)
+			maps.Copy(environments, c.Environments)
+			result.Environments.Environments = environments
+		}
+		c.result = &result
+		c.namingSchemaMap = s.NewNamingSchemaMap(result.NamingSchemas)
+	})
+}
+
+// loadSchemaLibrary downloads and processes the schema library of a reference
+// independently of the schema library of the provider configuration.
+func loadSchemaLibrary(ctx context.Context, sourceValue s.SourceValue, timeout time.Duration, cacheDir string) (*s.Result, error) {
+	if sourceValue.CustomUrl.IsNull() && sourceValue.BundleUrl.IsNull() && (sourceValue.Path.ValueString() == "" || sourceValue.Ref.ValueString() == "") {
+		return nil, fmt.Errorf("either custom_url, bundle_url or path and ref must be set")
+	}
+
+	patterns := sourceValue.FilePatterns()
+	if err := patterns.Validate(); err != nil {
+		return nil, err
+	}
+
+	downloadCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	source := newSource(sourceValue)
+	dst := cacheDestination(cacheDir, source)
+	f, err := source.Download(downloadCtx, dst)
+	if err != nil {
+		return nil, err
+	}
+	// The library is downloaded after the cache cleanup of Configure, which
+	// must not remove it while it is in use.
+	if err := s.TouchCacheEntry(dst); err != nil {
+		tflog.Warn(ctx, "Failed to update the cache entry", map[string]interface{}{"error": err.Error()})
+	}
+
+	result := s.Result{}
+	if err := s.NewProcessorClient(f).WithFilePatterns(patterns).Process(&result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// mergeLocations applies the provider locations to the library locations. With
+// the replace strategy the provider locations are used as they are, otherwise
+// they are added to the library locations and win on conflicts.
+func mergeLocations(library s.LocationsMapSchema, locations map[string]string, strategy string) s.LocationsMapSchema {
+	if locations == nil {
+		return library
+	}
+
+	merged := make(s.LocationsMapSchema, len(library)+len(locations))
+	if strategy != locationMergeStrategyReplace {
+		for k, v := range library {
+			merged[k] = v
+		}
+	}
+	for k, v := range locations {
+		merged[k] = v
+	}
+	return merged
 }
 
 // StandesamtProvider is the provider implementation.
@@ -59,17 +295,41 @@ type StandesamtProvider struct {
 	// testing.
 	version string
 	config  *ProviderConfig
+
+	// schemaSource replaces the schema_reference of the provider configuration
+	// if set. See NewWithSchemaSource.
+	schemaSource s.Source
 }
 
 type providerData struct {
-	Convention      types.String `tfsdk:"convention"`
-	Environment     types.String `tfsdk:"environment"`
-	Separator       types.String `tfsdk:"separator"`
-	HashLength      types.Int32  `tfsdk:"hash_length"`
-	Lowercase       types.Bool   `tfsdk:"lowercase"`
-	Uppercase       types.Bool   `tfsdk:"uppercase"`
-	RandomSeed      types.Int64  `tfsdk:"random_seed"`
-	SchemaReference types.Object `tfsdk:"schema_reference"`
+	Convention            types.String `tfsdk:"convention"`
+	Environment           types.String `tfsdk:"environment"`
+	Workspace             types.String `tfsdk:"workspace"`
+	Stack                 types.String `tfsdk:"stack"`
+	Separator             types.String `tfsdk:"separator"`
+	HashLength            types.Int32  `tfsdk:"hash_length"`
+	Lowercase             types.Bool   `tfsdk:"lowercase"`
+	Uppercase             types.Bool   `tfsdk:"uppercase"`
+	Strict                types.Bool   `tfsdk:"strict"`
+	DenyPatterns          types.List   `tfsdk:"deny_patterns"`
+	RequiredSegments      types.List   `tfsdk:"required_segments"`
+	RequiredPrefixRegex   types.String `tfsdk:"required_prefix_regex"`
+	MinGlobalHashLength   types.Int32  `tfsdk:"min_global_hash_length"`
+	RandomSeed            types.Int64  `tfsdk:"random_seed"`
+	DownloadTimeout       types.String `tfsdk:"download_timeout"`
+	CacheDir              types.String `tfsdk:"cache_dir"`
+	CacheMaxAgeDays       types.Int32  `tfsdk:"cache_max_age_days"`
+	InlineSchema          types.List   `tfsdk:"inline_schema"`
+	SchemaOverrides       types.Map    `tfsdk:"schema_overrides"`
+	Locations             types.Map    `tfsdk:"locations"`
+	Environments          types.Map    `tfsdk:"environments"`
+	LocationMergeStrategy types.String `tfsdk:"location_merge_strategy"`
+	LocationSource        types.String `tfsdk:"location_source"`
+	SchemaReference       types.Object `tfsdk:"schema_reference"`
+	CompatibilityRef      types.Object `tfsdk:"compatibility_ref"`
+	CompatibilityMode     types.String `tfsdk:"compatibility_mode"`
+	UsageStats            types.Bool   `tfsdk:"usage_stats"`
+	AllowMutableRef       types.Bool   `tfsdk:"allow_mutable_ref"`
 }
 
 // Metadata returns the provider type name.
@@ -90,12 +350,71 @@ func (d providerData) getSourceRef(ctx context.Context) (s.Source, diag.Diagnost
 		return nil, diags
 	}
 
+	return newSource(sourceValue), nil
+}
+
+// mutableRefWarning warns if the schema reference downloads a ref of the
+// default library that is not an immutable release tag, unless
+// allow_mutable_ref is set.
+func (d providerData) mutableRefWarning(ctx context.Context) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if d.AllowMutableRef.ValueBool() {
+		return diags
+	}
+
+	var sourceValue s.SourceValue
+	if diags.Append(d.SchemaReference.As(ctx, &sourceValue, basetypes.ObjectAsOptions{})...); diags.HasError() {
+		return diags
+	}
+	if !sourceValue.CustomUrl.IsNull() || !sourceValue.BundleUrl.IsNull() {
+		return diags
+	}
+
+	ref := sourceValue.Ref.ValueString()
+	if immutable, err := s.IsImmutableRef(ref); err != nil || immutable {
+		// An invalid pattern fails the download itself.
+		return diags
+	}
+	diags.AddAttributeWarning(path.Root("schema_reference").AtName("ref"), "Mutable schema library ref",
+		fmt.Sprintf("The ref '%s' is not a release tag, e.g. '%s'. The schema library of the ref may change between plans "+
+			"and with it the generated names. Pin a release tag or set allow_mutable_ref = true to accept changing names.", ref, standesamtLibRef))
+	return diags
+}
+
+// newSource returns the source of a schema reference, the default library if
+// neither custom_url nor bundle_url is set.
+func newSource(sourceValue s.SourceValue) s.Source {
+	baseDir := sourceValue.BaseDir.ValueString()
+	if !sourceValue.BundleUrl.IsNull() {
+		return s.NewBundleSource(s.ResolveRelativeSource(sourceValue.BundleUrl.ValueString(), baseDir))
+	}
 	if sourceValue.CustomUrl.IsNull() {
-		return s.NewDefaultSource(sourceValue.Path.ValueString(), sourceValue.Ref.ValueString()), nil
+		return s.NewDefaultSource(sourceValue.Path.ValueString(), sourceValue.Ref.ValueString())
+	}
+
+	return s.NewCustomSource(s.ResolveRelativeSource(sourceValue.CustomUrl.ValueString(), baseDir))
+}
+
+// bundleUrlValidators returns the validators of the bundle_url attribute of a
+// schema reference.
+func bundleUrlValidators() []validator.String {
+	return []validator.String{
+		stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("custom_url")),
+		stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("path")),
+		stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("ref")),
 	}
+}
+
+// filePatterns returns the naming_file and locations_file patterns of the schema reference.
+func (d providerData) filePatterns(ctx context.Context) (s.FilePatterns, diag.Diagnostics) {
+	var sourceValue s.SourceValue
 
-	return s.NewCustomSource(sourceValue.CustomUrl.ValueString()), nil
+	diags := d.SchemaReference.As(ctx, &sourceValue, basetypes.ObjectAsOptions{})
+	if diags.HasError() {
+		return s.FilePatterns{}, diags
+	}
 
+	return sourceValue.FilePatterns(), nil
 }
 
 // Schema defines the provider-level schema for configuration data.
@@ -115,6 +434,16 @@ func (p *StandesamtProvider) Schema(_ context.Context, _ provider.SchemaRequest,
 				Description:         "Define the environment for the naming schema. Normally this is the name of the environment, e.g. 'prod', 'dev', 'test'.",
 				MarkdownDescription: "Define the environment for the naming schema. Normally this is the name of the environment, e.g. 'prod', 'dev', 'test'.",
 			},
+			"workspace": schema.StringAttribute{
+				Optional:            true,
+				Description:         "The Terraform workspace rendered by the workspace name precedence token. Can also be set with the SA_WORKSPACE or TF_WORKSPACE environment variables.",
+				MarkdownDescription: "The Terraform workspace rendered by the `workspace` name precedence token. Can also be set with the `SA_WORKSPACE` or `TF_WORKSPACE` environment variables.",
+			},
+			"stack": schema.StringAttribute{
+				Optional:            true,
+				Description:         "The stack, e.g. the name of the root module, rendered by the stack name precedence token. Can also be set with the SA_STACK environment variable.",
+				MarkdownDescription: "The stack, e.g. the name of the root module, rendered by the `stack` name precedence token. Can also be set with the `SA_STACK` environment variable.",
+			},
 			"separator": schema.StringAttribute{
 				Optional:            true,
 				Description:         "The separator to use for generating the resulting name. Default '-'",
@@ -124,11 +453,25 @@ func (p *StandesamtProvider) Schema(_ context.Context, _ provider.SchemaRequest,
 				Optional:            true,
 				Description:         "A random seed used by the random number generator. This is used to generate a random name for the naming schema. The default value is 1337. Make sure to update this value to avoid collisions for globally unique names.",
 				MarkdownDescription: "A random seed used by the random number generator. This is used to generate a random name for the naming schema. The default value is 1337. Make sure to update this value to avoid collisions for globally unique names.",
+				Validators: []validator.Int64{
+					int64validator.NoneOf(0),
+				},
 			},
 			"hash_length": schema.Int32Attribute{
 				Optional:            true,
-				Description:         "Default hash length. Overrides all schema configurations.",
-				MarkdownDescription: "Default hash length. Overrides all schema configurations.",
+				Description:         "Default hash length. Overrides all schema configurations. The standesamt_config data source warns about resource types whose maximum length cannot fit the abbreviation and the hash.",
+				MarkdownDescription: "Default hash length. Overrides all schema configurations. The `standesamt_config` data source warns about resource types whose maximum length cannot fit the abbreviation and the hash.",
+				Validators: []validator.Int32{
+					int32validator.Between(0, maxHashLength),
+				},
+			},
+			"min_global_hash_length": schema.Int32Attribute{
+				Optional:            true,
+				Description:         "Minimum hash length of resource types with scope 'global', e.g. storage accounts. Applied when the hash length comes from the naming schema, an explicit hash_length in the provider, standesamt_config or the settings wins. Set to 0 to disable. Default '4'",
+				MarkdownDescription: "Minimum hash length of resource types with scope `global`, e.g. storage accounts. Applied when the hash length comes from the naming schema, an explicit `hash_length` in the provider, `standesamt_config` or the settings wins. Set to `0` to disable. Default '4'",
+				Validators: []validator.Int32{
+					int32validator.Between(0, maxHashLength),
+				},
 			},
 			"lowercase": schema.BoolAttribute{
 				Optional:            true,
@@ -140,6 +483,152 @@ func (p *StandesamtProvider) Schema(_ context.Context, _ provider.SchemaRequest,
 				Description:         "Control if the resulting name should be upper case. Default 'false'",
 				MarkdownDescription: "Control if the resulting name should be upper case. Default 'false'",
 			},
+			"usage_stats": schema.BoolAttribute{
+				Optional:            true,
+				Description:         "Count the names built per resource type by the standesamt_name and standesamt_unique_name resources and expose them with the standesamt_usage_stats data source. Names built by provider functions are not counted, as functions run without the provider configuration. The counts stay local and are never sent anywhere. Default 'false'",
+				MarkdownDescription: "Count the names built per resource type by the `standesamt_name` and `standesamt_unique_name` resources and expose them with the `standesamt_usage_stats` data source. Names built by provider functions are not counted, as functions run without the provider configuration. The counts stay local and are never sent anywhere. Default 'false'",
+			},
+			"allow_mutable_ref": schema.BoolAttribute{
+				Optional:            true,
+				Description:         "Allow a schema_reference ref that is a branch like 'main' instead of a release tag without a warning. The names of a mutable ref may change between plans when the library changes. Default 'false'",
+				MarkdownDescription: "Allow a `schema_reference` `ref` that is a branch like `main` instead of a release tag without a warning. The names of a mutable ref may change between plans when the library changes. Default 'false'",
+			},
+			"strict": schema.BoolAttribute{
+				Optional:            true,
+				Description:         "Control if names violating the naming schema (length, regex, double hyphens) fail the name function. When false, the name is returned as is with a warning. The name function can only write the warning to the provider log (TF_LOG=WARN); the standesamt_name and standesamt_unique_name resources report it as a warning diagnostic. Default 'true'",
+				MarkdownDescription: "Control if names violating the naming schema (length, regex, double hyphens) fail the `name` function. When `false`, the name is returned as is with a warning. The `name` function can only write the warning to the provider log (`TF_LOG=WARN`); the `standesamt_name` and `standesamt_unique_name` resources report it as a warning diagnostic. Default 'true'",
+			},
+			"deny_patterns": schema.ListAttribute{
+				Optional:            true,
+				Description:         "A list of regular expressions the resulting name must not match, e.g. '^[0-9]' or '(?i)microsoft'. Checked in addition to the deny patterns of the naming schema. Default '[]'",
+				MarkdownDescription: "A list of regular expressions the resulting name must not match, e.g. `^[0-9]` or `(?i)microsoft`. Checked in addition to the deny patterns of the naming schema. Default '[]'",
+				ElementType:         types.StringType,
+			},
+			"required_segments": schema.ListAttribute{
+				Optional:            true,
+				Description:         "Name precedence segments every generated name must contain, e.g. ['environment', 'location']. The name function fails if the resolved settings omit a segment. Default '[]'",
+				MarkdownDescription: "Name precedence segments every generated name must contain, e.g. `[\"environment\", \"location\"]`. The `name` function fails if the resolved settings omit a segment. Default '[]'",
+				ElementType:         types.StringType,
+			},
+			"required_prefix_regex": schema.StringAttribute{
+				Optional:            true,
+				Description:         "A regular expression at least one prefix of every generated name must match, e.g. an owner prefix like '^(fin|hr|ops)$'. The name function fails if no prefix matches. Default '' (no requirement)",
+				MarkdownDescription: "A regular expression at least one prefix of every generated name must match, e.g. an owner prefix like `^(fin|hr|ops)$`. The `name` function fails if no prefix matches. Default '' (no requirement)",
+			},
+			"download_timeout": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Maximum duration of the schema library download, e.g. '30s' or '5m'. The download is also stopped when Terraform is interrupted. Default '5m'",
+				MarkdownDescription: "Maximum duration of the schema library download, e.g. `30s` or `5m`. The download is also stopped when Terraform is interrupted. Default '5m'",
+			},
+			"cache_dir": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Directory the schema libraries are downloaded to, one subdirectory per source hash. Overrides the SA_NAMING_DIR environment variable. Default '.standesamt'",
+				MarkdownDescription: "Directory the schema libraries are downloaded to, one subdirectory per source hash. Overrides the `SA_NAMING_DIR` environment variable. Default '.standesamt'",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"cache_max_age_days": schema.Int32Attribute{
+				Optional:            true,
+				Description:         "Number of days after which unused schema library downloads are removed from the cache directory, to keep it from growing on long-lived build agents. 0 disables the cleanup. Default 30",
+				MarkdownDescription: "Number of days after which unused schema library downloads are removed from the cache directory, to keep it from growing on long-lived build agents. `0` disables the cleanup. Default 30",
+				Validators: []validator.Int32{
+					int32validator.AtLeast(0),
+				},
+			},
+			"inline_schema":    inlineSchemaAttribute(),
+			"schema_overrides": schemaOverridesAttribute(),
+			"locations": schema.MapAttribute{
+				Optional:            true,
+				Description:         "A map of location names to location tokens, e.g. { dc-frankfurt = \"fra\" }, for custom site codes like on-prem data centers or edge sites. Combined with the schema library locations according to location_merge_strategy.",
+				MarkdownDescription: "A map of location names to location tokens, e.g. `{ dc-frankfurt = \"fra\" }`, for custom site codes like on-prem data centers or edge sites. Combined with the schema library locations according to `location_merge_strategy`.",
+				ElementType:         types.StringType,
+			},
+			"environments": schema.MapAttribute{
+				Optional:            true,
+				Description:         "A map of long environment names to short tokens, e.g. { production = \"prd\" }, merged over the environment catalog of the schema library. The naming functions and the env function replace a long environment name by its token.",
+				MarkdownDescription: "A map of long environment names to short tokens, e.g. `{ production = \"prd\" }`, merged over the `schema.environments.json` catalog of the schema library. The naming functions and the `env` function replace a long environment name by its token.",
+				ElementType:         types.StringType,
+			},
+			"compatibility_ref": schema.SingleNestedAttribute{
+				Optional:            true,
+				Description:         "A second schema library reference, e.g. the library version in use before an upgrade. When set, the naming functions build every name under both libraries and report names that differ, so a changed abbreviation or rule does not silently rename resources. Resource types not defined in the compatibility library are not checked. The standesamt_config data source passes the compatibility schemas of the resource types whose naming schema differs to the naming functions.",
+				MarkdownDescription: "A second schema library reference with the attributes of `schema_reference`, e.g. the library version in use before an upgrade. When set, the naming functions build every name under both libraries and report names that differ, so a changed abbreviation or rule does not silently rename resources. Resource types not defined in the compatibility library are not checked. The `standesamt_config` data source passes the compatibility schemas of the resource types whose naming schema differs to the naming functions.",
+				Attributes: map[string]schema.Attribute{
+					"custom_url": schema.StringAttribute{
+						Optional:            true,
+						Sensitive:           true,
+						Description:         "A custom path/URL to the compatibility library. Conflicts with `path` and `ref`.",
+						MarkdownDescription: "A custom path/URL to the compatibility library. Conflicts with `path` and `ref`.",
+						Validators: []validator.String{
+							stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("path")),
+							stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("ref")),
+						},
+					},
+					"bundle_url": schema.StringAttribute{
+						Optional:            true,
+						Sensitive:           true,
+						Description:         "A path/URL to a zip bundle of the compatibility library. Conflicts with `custom_url`, `path` and `ref`.",
+						MarkdownDescription: "A path/URL to a zip bundle of the compatibility library. Conflicts with `custom_url`, `path` and `ref`.",
+						Validators:          bundleUrlValidators(),
+					},
+					"path": schema.StringAttribute{
+						Optional:            true,
+						Description:         "The path in the default schema library, e.g. `azure/caf`. Also requires `ref`.",
+						MarkdownDescription: "The path in the default schema library, e.g. `azure/caf`. Also requires `ref`.",
+						Validators: []validator.String{
+							stringvalidator.AlsoRequires(path.MatchRelative().AtParent().AtName("ref")),
+						},
+					},
+					"ref": schema.StringAttribute{
+						Optional:            true,
+						Description:         "The version of the compatibility library, e.g. `2025.04`. Also requires `path`.",
+						MarkdownDescription: "The version of the compatibility library, e.g. `2025.04`. Also requires `path`.",
+						Validators: []validator.String{
+							stringvalidator.AlsoRequires(path.MatchRelative().AtParent().AtName("path")),
+						},
+					},
+					"naming_file": schema.StringAttribute{
+						Optional:            true,
+						Description:         "File name or glob pattern of the naming schema files in the compatibility library.",
+						MarkdownDescription: "File name or glob pattern of the naming schema files in the compatibility library.",
+					},
+					"locations_file": schema.StringAttribute{
+						Optional:            true,
+						Description:         "File name or glob pattern of the locations files in the compatibility library.",
+						MarkdownDescription: "File name or glob pattern of the locations files in the compatibility library.",
+					},
+					"base_dir": schema.StringAttribute{
+						Optional:            true,
+						Description:         "Directory that relative local paths of custom_url and bundle_url, i.e. paths starting with ./ or ../, are resolved against, e.g. abspath(path.module). Defaults to the working directory of Terraform, which differs from the module directory with -chdir or in child modules.",
+						MarkdownDescription: "Directory that relative local paths of `custom_url` and `bundle_url`, i.e. paths starting with `./` or `../`, are resolved against, e.g. `abspath(path.module)`. Defaults to the working directory of Terraform, which differs from the module directory with `-chdir` or in child modules.",
+					},
+				},
+			},
+			"compatibility_mode": schema.StringAttribute{
+				Optional:            true,
+				Description:         "How names that differ under the compatibility_ref library are reported. Possible values are 'error' and 'warn'. Warnings of the naming functions only appear in the provider log (TF_LOG=WARN); the standesamt_name and standesamt_unique_name resources report them as warning diagnostics. Default 'error'.",
+				MarkdownDescription: "How names that differ under the `compatibility_ref` library are reported. Possible values are `error` and `warn`. Warnings of the naming functions only appear in the provider log (`TF_LOG=WARN`); the `standesamt_name` and `standesamt_unique_name` resources report them as warning diagnostics. Default `error`.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(compatibilityModeError, compatibilityModeWarn),
+				},
+			},
+			"location_merge_strategy": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Control how locations are combined with the schema library locations. 'merge' adds them and overrides library entries with the same key, 'replace' uses only the provider locations. Default 'merge'",
+				MarkdownDescription: "Control how `locations` are combined with the schema library locations. `merge` adds them and overrides library entries with the same key, `replace` uses only the provider locations. Default 'merge'",
+				Validators: []validator.String{
+					stringvalidator.OneOf(locationMergeStrategyMerge, locationMergeStrategyReplace),
+				},
+			},
+			"location_source": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Where the locations come from. 'library' uses the locations of the schema library, 'aws' and 'gcp' use the region short codes bundled with the provider, e.g. eu-central-1 = euc1 or europe-west3 = euw3, without any cloud credentials. locations are combined with the selected source according to location_merge_strategy. Default 'library'",
+				MarkdownDescription: "Where the locations come from. `library` uses the locations of the schema library, `aws` and `gcp` use the region short codes bundled with the provider, e.g. `eu-central-1 = euc1` or `europe-west3 = euw3`, without any cloud credentials. `locations` are combined with the selected source according to `location_merge_strategy`. Default 'library'",
+				Validators: []validator.String{
+					stringvalidator.OneOf(append([]string{locationSourceLibrary}, s.LocationCatalogNames()...)...),
+				},
+			},
 			"schema_reference": schema.SingleNestedAttribute{
 				Attributes: map[string]schema.Attribute{
 					"custom_url": schema.StringAttribute{
@@ -152,6 +641,13 @@ func (p *StandesamtProvider) Schema(_ context.Context, _ provider.SchemaRequest,
 							stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("ref")),
 						},
 					},
+					"bundle_url": schema.StringAttribute{
+						Optional:            true,
+						Sensitive:           true,
+						Description:         "A path/URL to a packaged schema library, a zip archive with a `manifest.json` (`name`, `version` and the SHA256 `checksums` of all files) and the schema files. The bundle is validated against the manifest before it is used. Conflicts with `custom_url`, `path` and `ref`. Value is marked sensitive as may contain secrets.",
+						MarkdownDescription: "A path/URL to a packaged schema library, a zip archive with a `manifest.json` (`name`, `version` and the SHA256 `checksums` of all files) and the schema files. The bundle is validated against the manifest before it is used. Conflicts with `custom_url`, `path` and `ref`. Value is marked sensitive as may contain secrets.",
+						Validators:          bundleUrlValidators(),
+					},
 					"path": schema.StringAttribute{
 						Optional:            true,
 						Description:         "The path in the default schema library, e.g. `azure/caf`. Also requires `ref`. Conflicts with `custom_url`.",
@@ -161,10 +657,25 @@ func (p *StandesamtProvider) Schema(_ context.Context, _ provider.SchemaRequest,
 							stringvalidator.AlsoRequires(path.MatchRelative().AtParent().AtName("ref")),
 						},
 					},
+					"naming_file": schema.StringAttribute{
+						Optional:            true,
+						Description:         "File name or glob pattern of the naming schema files in the library, e.g. `*.naming.json`. A pattern containing a slash is matched against the path relative to the library root, e.g. `services/*/naming.json`. All matching files are merged; a resource type must be defined in one file only. Default `schema.naming.json` and `*.naming.json` anywhere in the library.",
+						MarkdownDescription: "File name or glob pattern of the naming schema files in the library, e.g. `*.naming.json`. A pattern containing a slash is matched against the path relative to the library root, e.g. `services/*/naming.json`. All matching files are merged; a resource type must be defined in one file only. Default `schema.naming.json` and `*.naming.json` anywhere in the library.",
+					},
+					"locations_file": schema.StringAttribute{
+						Optional:            true,
+						Description:         "File name or glob pattern of the locations files in the library. All matching files are merged. Default `schema.locations.json`.",
+						MarkdownDescription: "File name or glob pattern of the locations files in the library. All matching files are merged. Default `schema.locations.json`.",
+					},
+					"base_dir": schema.StringAttribute{
+						Optional:            true,
+						Description:         "Directory that relative local paths of custom_url and bundle_url, i.e. paths starting with ./ or ../, are resolved against, e.g. abspath(path.module). Defaults to the working directory of Terraform, which differs from the module directory with -chdir or in child modules.",
+						MarkdownDescription: "Directory that relative local paths of `custom_url` and `bundle_url`, i.e. paths starting with `./` or `../`, are resolved against, e.g. `abspath(path.module)`. Defaults to the working directory of Terraform, which differs from the module directory with `-chdir` or in child modules.",
+					},
 					"ref": schema.StringAttribute{
 						Optional:            true,
-						Description:         "This is the version of the schema reference to use, e.g. `2025.04`. Also requires `path`. Conflicts with `custom_url`.",
-						MarkdownDescription: "This is the version of the schema reference to use, e.g. `2025.04`. Also requires `path`. Conflicts with `custom_url`.",
+						Description:         "This is the version of the schema reference to use, e.g. `2025.04`. Also requires `path`. Conflicts with `custom_url`. Release tags like `2025.04` or `v1.2.0` are downloaded once and reused from the cache, other refs like `main` are downloaded on every run.",
+						MarkdownDescription: "This is the version of the schema reference to use, e.g. `2025.04`. Also requires `path`. Conflicts with `custom_url`. Release tags like `2025.04` or `v1.2.0` are downloaded once and reused from the cache, other refs like `main` are downloaded on every run.",
 						Validators: []validator.String{
 							stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("custom_url")),
 							stringvalidator.AlsoRequires(path.MatchRelative().AtParent().AtName("path")),
@@ -172,8 +683,8 @@ func (p *StandesamtProvider) Schema(_ context.Context, _ provider.SchemaRequest,
 					},
 				},
 				Optional:            true,
-				Description:         "A reference to a naming schema library to use. The reference should either contain a `path` (e.g. `azure/caf`) and the `ref` (e.g. `2026.01`), or a `custom_url` to be supplied to go-getter.\n    If this value is not specified, the default value will be used, which is:\n\n    ```terraform\n\n    schema_reference = {\n      path = \"azure/caf\",\n      ref = \"2026.01\"\n    }\n\n    ```\n\n    The reference is using the [default standesamt library](https://github.com/glueckkanja/standesamt-schema-library).\n    See the [Schema v2 Format](../guides/schema-v2) guide for details on the versioned schema file format.",
-				MarkdownDescription: "A reference to a Naming schema library to use. The reference should either contain a `path` (e.g. `azure/caf`) and the `ref` (e.g. `2026.01`), or a `custom_url` to be supplied to go-getter.\n    If this value is not specified, the default value will be used, which is:\n\n    ```terraform\n\n    schema_reference = {\n      path = \"azure/caf\",\n      ref = \"2026.01\"\n    }\n\n    ```\n\n    The reference is using the [default standesamt library](https://github.com/glueckkanja/standesamt-schema-library).\n    See the [Schema v2 Format](../guides/schema-v2) guide for details on the versioned schema file format.",
+				Description:         "A reference to a naming schema library to use. The reference should either contain a `path` (e.g. `azure/caf`) and the `ref` (e.g. `2026.01`), or a `custom_url` to be supplied to go-getter, or the `bundle_url` of a packaged library.\n    If this value is not specified, the default value will be used, which is:\n\n    ```terraform\n\n    schema_reference = {\n      path = \"azure/caf\",\n      ref = \"2026.01\"\n    }\n\n    ```\n\n    The reference is using the [default standesamt library](https://github.com/glueckkanja/standesamt-schema-library).\n    See the [Schema v2 Format](../guides/schema-v2) guide for details on the versioned schema file format.",
+				MarkdownDescription: "A reference to a Naming schema library to use. The reference should either contain a `path` (e.g. `azure/caf`) and the `ref` (e.g. `2026.01`), or a `custom_url` to be supplied to go-getter, or the `bundle_url` of a packaged library.\n    If this value is not specified, the default value will be used, which is:\n\n    ```terraform\n\n    schema_reference = {\n      path = \"azure/caf\",\n      ref = \"2026.01\"\n    }\n\n    ```\n\n    The reference is using the [default standesamt library](https://github.com/glueckkanja/standesamt-schema-library).\n    See the [Schema v2 Format](../guides/schema-v2) guide for details on the versioned schema file format.",
 			},
 		},
 	}
@@ -186,6 +697,16 @@ func (d *providerData) configProviderFromEnvironment() diag.Diagnostics {
 		d.Environment = types.StringValue(val)
 	}
 
+	for _, key := range []string{"SA_WORKSPACE", "TF_WORKSPACE"} {
+		if val := os.Getenv(key); val != "" && d.Workspace.IsNull() {
+			d.Workspace = types.StringValue(val)
+		}
+	}
+
+	if val := os.Getenv("SA_STACK"); val != "" && d.Stack.IsNull() {
+		d.Stack = types.StringValue(val)
+	}
+
 	if val := os.Getenv("SA_CONVENTION"); val != "" && d.Convention.IsNull() {
 		if val != "default" && val != "passthrough" {
 			diags.AddError("Invalid Environment Variable", fmt.Sprintf("Invalid value for SA_CONVENTION: %s", val))
@@ -204,6 +725,10 @@ func (d *providerData) configProviderFromEnvironment() diag.Diagnostics {
 			diags.AddError("Invalid Environment Variable", fmt.Sprintf("Invalid value for SA_RANDOM_SEED: %s", err))
 			return diags
 		}
+		if i == 0 {
+			diags.AddError("Invalid Environment Variable", "Invalid value for SA_RANDOM_SEED: must not be 0")
+			return diags
+		}
 		d.RandomSeed = types.Int64Value(i)
 	}
 
@@ -213,10 +738,10 @@ func (d *providerData) configProviderFromEnvironment() diag.Diagnostics {
 			diags.AddError("Invalid Environment Variable", fmt.Sprintf("Invalid value for SA_HASH_LENGTH: %s", err))
 			return diags
 		}
-		if i > 0 && i <= math.MaxInt32 {
+		if i > 0 && i <= maxHashLength {
 			d.HashLength = types.Int32Value(int32(i))
 		} else {
-			diags.AddError("Invalid Environment Variable", fmt.Sprintf("Invalid value for SA_HASH_LENGTH: %s (parsed as %d), must be between 1 and %d", val, i, math.MaxInt32))
+			diags.AddError("Invalid Environment Variable", fmt.Sprintf("Invalid value for SA_HASH_LENGTH: %s (parsed as %d), must be between 1 and %d", val, i, maxHashLength))
 			return diags
 		}
 	}
@@ -229,6 +754,18 @@ func (d *providerData) configProviderFromEnvironment() diag.Diagnostics {
 		d.Uppercase = types.BoolValue(val == "true")
 	}
 
+	if val := os.Getenv("SA_STRICT"); val != "" && d.Strict.IsNull() {
+		d.Strict = types.BoolValue(val == "true")
+	}
+
+	if val := os.Getenv("SA_DOWNLOAD_TIMEOUT"); val != "" && d.DownloadTimeout.IsNull() {
+		if _, err := parseDownloadTimeout(val); err != nil {
+			diags.AddError("Invalid Environment Variable", fmt.Sprintf("Invalid value for SA_DOWNLOAD_TIMEOUT: %s", err))
+			return diags
+		}
+		d.DownloadTimeout = types.StringValue(val)
+	}
+
 	return nil
 }
 
@@ -241,6 +778,14 @@ func (d *providerData) configProviderDefaults() {
 		d.Environment = types.StringValue("")
 	}
 
+	if d.Workspace.IsNull() {
+		d.Workspace = types.StringValue("")
+	}
+
+	if d.Stack.IsNull() {
+		d.Stack = types.StringValue("")
+	}
+
 	if d.Separator.IsNull() {
 		d.Separator = types.StringValue("-")
 	}
@@ -253,6 +798,10 @@ func (d *providerData) configProviderDefaults() {
 		d.HashLength = types.Int32Value(0)
 	}
 
+	if d.MinGlobalHashLength.IsNull() {
+		d.MinGlobalHashLength = types.Int32Value(defaultMinGlobalHashLength)
+	}
+
 	if d.Lowercase.IsNull() {
 		d.Lowercase = types.BoolValue(false)
 	}
@@ -261,17 +810,73 @@ func (d *providerData) configProviderDefaults() {
 		d.Uppercase = types.BoolValue(false)
 	}
 
+	if d.Strict.IsNull() {
+		d.Strict = types.BoolValue(true)
+	}
+
+	if d.DenyPatterns.IsNull() {
+		d.DenyPatterns = types.ListValueMust(types.StringType, []attr.Value{})
+	}
+
+	if d.RequiredSegments.IsNull() {
+		d.RequiredSegments = types.ListValueMust(types.StringType, []attr.Value{})
+	}
+
+	if d.RequiredPrefixRegex.IsNull() {
+		d.RequiredPrefixRegex = types.StringValue("")
+	}
+
+	if d.LocationMergeStrategy.IsNull() {
+		d.LocationMergeStrategy = types.StringValue(locationMergeStrategyMerge)
+	}
+
+	if d.LocationSource.IsNull() {
+		d.LocationSource = types.StringValue(locationSourceLibrary)
+	}
+
+	if d.UsageStats.IsNull() {
+		d.UsageStats = types.BoolValue(false)
+	}
+
+	if d.AllowMutableRef.IsNull() {
+		d.AllowMutableRef = types.BoolValue(false)
+	}
+
+	if d.CompatibilityMode.IsNull() {
+		d.CompatibilityMode = types.StringValue(compatibilityModeError)
+	}
+
+	if d.DownloadTimeout.IsNull() {
+		d.DownloadTimeout = types.StringValue(defaultDownloadTimeout)
+	}
+
+	if d.CacheDir.IsNull() {
+		d.CacheDir = types.StringValue(tools.NamingSchemaCacheDir())
+	}
+
+	if d.CacheMaxAgeDays.IsNull() {
+		d.CacheMaxAgeDays = types.Int32Value(defaultCacheMaxAgeDays)
+	}
+
 	if d.SchemaReference.IsNull() {
 		d.SchemaReference, _ = types.ObjectValue(
 			map[string]attr.Type{
-				"ref":        types.StringType,
-				"path":       types.StringType,
-				"custom_url": types.StringType,
+				"ref":            types.StringType,
+				"path":           types.StringType,
+				"custom_url":     types.StringType,
+				"bundle_url":     types.StringType,
+				"naming_file":    types.StringType,
+				"locations_file": types.StringType,
+				"base_dir":       types.StringType,
 			},
 			map[string]attr.Value{
-				"ref":        types.StringValue(standesamtLibRef),
-				"path":       types.StringValue(standesamtLibPath),
-				"custom_url": types.StringNull(),
+				"ref":            types.StringValue(standesamtLibRef),
+				"path":           types.StringValue(standesamtLibPath),
+				"custom_url":     types.StringNull(),
+				"bundle_url":     types.StringNull(),
+				"naming_file":    types.StringNull(),
+				"locations_file": types.StringNull(),
+				"base_dir":       types.StringNull(),
 			})
 	}
 }
@@ -284,6 +889,7 @@ func (p *StandesamtProvider) Configure(ctx context.Context, req provider.Configu
 	if p.config != nil {
 		tflog.Debug(ctx, "Provider configuration is already present, skipping configuration part.")
 		resp.DataSourceData = p.config
+		resp.ResourceData = p.config
 		return
 	}
 
@@ -302,20 +908,141 @@ func (p *StandesamtProvider) Configure(ctx context.Context, req provider.Configu
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	if p.schemaSource != nil {
+		sourceRef = p.schemaSource
+	} else {
+		resp.Diagnostics.Append(data.mutableRefWarning(ctx)...)
+	}
+
+	filePatterns, diags := data.filePatterns(ctx)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+	if err := filePatterns.Validate(); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("schema_reference"), "Invalid schema file pattern", err.Error())
+		return
+	}
+
+	var compatibilityRef *s.SourceValue
+	if !data.CompatibilityRef.IsNull() && !data.CompatibilityRef.IsUnknown() {
+		var sourceValue s.SourceValue
+		if resp.Diagnostics.Append(data.CompatibilityRef.As(ctx, &sourceValue, basetypes.ObjectAsOptions{})...); resp.Diagnostics.HasError() {
+			return
+		}
+		compatibilityRef = &sourceValue
+	}
+
+	if err := validatePolicy(extractStringSlice(data.RequiredSegments), data.RequiredPrefixRegex.ValueString()); err != nil {
+		resp.Diagnostics.AddError("Invalid naming policy", err.Error())
+		return
+	}
+
+	inlineSchemas, diags := data.inlineSchemas(ctx)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	schemaOverrides, diags := data.schemaOverrides(ctx)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	var locations map[string]string
+	if !data.Locations.IsNull() {
+		if resp.Diagnostics.Append(data.Locations.ElementsAs(ctx, &locations, false)...); resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	var environments map[string]string
+	if !data.Environments.IsNull() {
+		if resp.Diagnostics.Append(data.Environments.ElementsAs(ctx, &environments, false)...); resp.Diagnostics.HasError() {
+			return
+		}
+	}
 
-	// Download the schema reference
-	f, err := sourceRef.Download(ctx, hash(sourceRef))
+	timeout, err := parseDownloadTimeout(data.DownloadTimeout.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("download_timeout"), "Invalid download timeout", err.Error())
+		return
+	}
+
+	// Download the schema reference. The request context is cancelled when
+	// Terraform is interrupted, the timeout bounds slow or hanging sources.
+	downloadCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	f, err := sourceRef.Download(downloadCtx, cacheDestination(data.CacheDir.ValueString(), sourceRef))
 	if err != nil {
 		resp.Diagnostics.AddError("source_reference", err.Error())
 		return
 	}
 
+	maxAge := time.Duration(data.CacheMaxAgeDays.ValueInt32()) * 24 * time.Hour
+	keep := []string{hash(sourceRef)}
+	if compatibilityRef != nil {
+		keep = append(keep, hash(newSource(*compatibilityRef)))
+	}
+	if removed, err := s.PruneCache(data.CacheDir.ValueString(), keep, maxAge); err != nil {
+		tflog.Warn(ctx, "Failed to clean up the cache directory", map[string]interface{}{"error": err.Error()})
+	} else if len(removed) > 0 {
+		tflog.Info(ctx, "Removed unused schema libraries from the cache directory", map[string]interface{}{"entries": removed})
+	}
+
 	p.config = &ProviderConfig{
-		SourceRef:    f,
-		ProviderData: data,
+		SourceRef:             f,
+		ProviderData:          data,
+		FilePatterns:          filePatterns,
+		CompatibilityRef:      compatibilityRef,
+		InlineSchemas:         inlineSchemas,
+		SchemaOverrides:       schemaOverrides,
+		Locations:             locations,
+		LocationMergeStrategy: data.LocationMergeStrategy.ValueString(),
+		LocationSource:        data.LocationSource.ValueString(),
+		Environments:          environments,
+	}
+
+	if data.UsageStats.ValueBool() {
+		p.config.usageStats.enable()
+	}
+
+	if port := os.Getenv(debugServerEnv); port != "" {
+		if err := startDebugServer(p.config, port); err != nil {
+			resp.Diagnostics.AddWarning("Debug server", err.Error())
+		} else {
+			tflog.Info(ctx, "Debug server started", map[string]interface{}{"address": "127.0.0.1:" + port})
+		}
 	}
 
 	resp.DataSourceData = p.config
+	resp.ResourceData = p.config
+}
+
+// cacheDestination returns the directory source is downloaded to, named after
+// the hash of the source below cacheDir. An empty cacheDir is the cache
+// directory of the environment.
+func cacheDestination(cacheDir string, source s.Source) string {
+	if cacheDir == "" {
+		return hash(source)
+	}
+	if abs, err := filepath.Abs(cacheDir); err == nil {
+		cacheDir = abs
+	}
+	return filepath.Join(cacheDir, hash(source))
+}
+
+// parseDownloadTimeout parses a download timeout duration, e.g. "30s" or "5m".
+func parseDownloadTimeout(val string) (time.Duration, error) {
+	timeout, err := time.ParseDuration(val)
+	if err != nil {
+		return 0, err
+	}
+	if timeout <= 0 {
+		return 0, fmt.Errorf("%s must be a positive duration", val)
+	}
+	return timeout, nil
 }
 
 func hash(s fmt.Stringer) string {
@@ -332,18 +1059,45 @@ func (p *StandesamtProvider) DataSources(_ context.Context) []func() datasource.
 	return []func() datasource.DataSource{
 		NewSchemaDataSource,
 		NewLocationDataSource,
+		NewAffixesDataSource,
+		NewSchemaDiffDataSource,
+		NewUsageStatsDataSource,
+		NewNamingSchemaDataSource,
+		NewSchemaLintDataSource,
+		NewAzureRulesDataSource,
+		NewAzurecafMigrationDataSource,
+		NewConventionsDocDataSource,
 	}
 }
 
 // Resources defines the resources implemented in the provider.
 func (p *StandesamtProvider) Resources(_ context.Context) []func() resource.Resource {
-	return nil
+	return []func() resource.Resource{
+		NewConventionResource,
+		NewRandomSuffixResource,
+		NewNameResource,
+		NewUniqueNameResource,
+	}
 }
 
 // Functions defines the functions implemented in the provider.
 func (p *StandesamtProvider) Functions(_ context.Context) []func() function.Function {
 	return []func() function.Function{
 		NewNameFunction,
+		NewNameExFunction,
 		NewValidateFunction,
+		NewValidateJSONFunction,
+		NewNameFromFunction,
+		NewValidateFromFunction,
+		NewBudgetFunction,
+		NewRulesFunction,
+		NewSlugFunction,
+		NewDNSLabelFunction,
+		NewEnvironmentNamesFunction,
+		NewEnvFunction,
+		NewLocationShortFunction,
+		NewLocationLongFunction,
+		NewConfigExportFunction,
+		NewTagsFunction,
 	}
 }
@@ -0,0 +1,65 @@
+// Copyright glueckkanja AG 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/provider/metaschema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ provider.ProviderWithMetaSchema = &StandesamtProvider{}
+
+// providerMetaModel holds the naming defaults a module declares in its
+// provider_meta "standesamt" block.
+type providerMetaModel struct {
+	Prefixes    types.List   `tfsdk:"prefixes"`
+	Suffixes    types.List   `tfsdk:"suffixes"`
+	Environment types.String `tfsdk:"environment"`
+}
+
+// MetaSchema defines the provider_meta block of modules. Terraform only passes
+// provider_meta to data sources and resources, so the defaults reach the naming
+// functions through the configuration of the standesamt_config data source.
+func (p *StandesamtProvider) MetaSchema(_ context.Context, _ provider.MetaSchemaRequest, resp *provider.MetaSchemaResponse) {
+	resp.Schema = metaschema.Schema{
+		Attributes: map[string]metaschema.Attribute{
+			"prefixes": metaschema.ListAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				Description:         "Default prefixes of the module. Used by standesamt_config data sources of the module if neither the data source nor a configuration document sets prefixes.",
+				MarkdownDescription: "Default prefixes of the module. Used by `standesamt_config` data sources of the module if neither the data source nor a configuration document sets prefixes.",
+			},
+			"suffixes": metaschema.ListAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				Description:         "Default suffixes of the module. Used by standesamt_config data sources of the module if neither the data source nor a configuration document sets suffixes.",
+				MarkdownDescription: "Default suffixes of the module. Used by `standesamt_config` data sources of the module if neither the data source nor a configuration document sets suffixes.",
+			},
+			"environment": metaschema.StringAttribute{
+				Optional:            true,
+				Description:         "Default environment of the module. Used by standesamt_config data sources of the module if neither the data source nor the provider sets an environment.",
+				MarkdownDescription: "Default environment of the module. Used by `standesamt_config` data sources of the module if neither the data source nor the provider sets an environment.",
+			},
+		},
+	}
+}
+
+// getProviderMeta returns the provider_meta of the module of a data source or
+// resource. The model is null if the module has no provider_meta block.
+func getProviderMeta(ctx context.Context, meta tfsdk.Config) (providerMetaModel, diag.Diagnostics) {
+	model := providerMetaModel{
+		Prefixes:    types.ListNull(types.StringType),
+		Suffixes:    types.ListNull(types.StringType),
+		Environment: types.StringNull(),
+	}
+	if meta.Raw.IsNull() {
+		return model, nil
+	}
+	diags := meta.Get(ctx, &model)
+	return model, diags
+}
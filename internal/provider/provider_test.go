@@ -4,12 +4,19 @@
 package provider
 
 import (
+	"io/fs"
 	"os"
+	"path/filepath"
+	"sync"
 	s "terraform-provider-standesamt/internal/schema"
 	"testing"
+	"testing/fstest"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 	"github.com/stretchr/testify/assert"
@@ -28,6 +35,22 @@ func testAccProtoV6ProviderFactoriesUnique() map[string]func() (tfprotov6.Provid
 	}
 }
 
+// testAccProtoV6ProviderFactoriesWithLibrary returns provider factories reading
+// the schema library from library instead of downloading it.
+func testAccProtoV6ProviderFactoriesWithLibrary(library fs.FS) map[string]func() (tfprotov6.ProviderServer, error) {
+	return map[string]func() (tfprotov6.ProviderServer, error){
+		"standesamt": providerserver.NewProtocol6WithError(NewWithSchemaSource("test", s.NewFSSource("test", library))()),
+	}
+}
+
+// testLibrary is a minimal schema library for hermetic tests.
+var testLibrary = fstest.MapFS{
+	"schema.naming.json":    {Data: []byte(`{"version":2,"resources":[{"resourceType":"azurerm_resource_group","abbreviation":"rg","minLength":1,"maxLength":90,"validationRegex":"^[a-zA-Z0-9-._()]{0,89}[a-zA-Z0-9-_()]$","configuration":{"useEnvironment":true,"useSeparator":true,"namePrecedence":["abbreviation","prefixes","name","location","environment","hash","suffixes"]}}]}`)},
+	"schema.locations.json": {Data: []byte(`{"version":2,"locations":{"westeurope":"weu"},"details":{"westeurope":{"displayName":"West Europe","paired":"northeurope","geographyGroup":"Europe"}}}`)},
+	"schema.affixes.json":   {Data: []byte(`{"version":2,"affixes":{"platform":{"prefixes":["plt"],"suffixes":[]}}}`)},
+	"library.json":          {Data: []byte(`{"name":"test","version":"1.0.0","authors":["glueckkanja"]}`)},
+}
+
 // testAccProtoV6ProviderFactoriesWithEcho includes the echo provider alongside the scaffolding provider.
 // It allows for testing assertions on data returned by an ephemeral resource during Open.
 // The echoprovider is used to arrange tests by echoing ephemeral data into the Terraform state.
@@ -49,6 +72,9 @@ func TestProviderDefaults(t *testing.T) {
 	// It does not check that the values are actually used in the provider logic.
 	// The actual logic is tested in the other tests.
 	_ = os.Unsetenv("SA_ENVIRONMENT")
+	_ = os.Unsetenv("SA_WORKSPACE")
+	_ = os.Unsetenv("TF_WORKSPACE")
+	_ = os.Unsetenv("SA_STACK")
 	_ = os.Unsetenv("SA_CONVENTION")
 	_ = os.Unsetenv("SA_SEPARATOR")
 	_ = os.Unsetenv("SA_RANDOM_SEED")
@@ -65,13 +91,22 @@ func TestProviderDefaults(t *testing.T) {
 
 	assert.Equal(t, "default", data.Convention.ValueString())
 	assert.Equal(t, "", data.Environment.ValueString())
+	assert.Equal(t, "", data.Workspace.ValueString())
+	assert.Equal(t, "", data.Stack.ValueString())
 	assert.Equal(t, "-", data.Separator.ValueString())
 	assert.Equal(t, int64(1337), data.RandomSeed.ValueInt64())
 	assert.Equal(t, int32(0), data.HashLength.ValueInt32())
 	assert.Equal(t, false, data.Lowercase.ValueBool())
+	assert.Equal(t, "5m", data.DownloadTimeout.ValueString())
+	assert.Equal(t, ".standesamt", data.CacheDir.ValueString())
+	assert.Equal(t, int32(defaultCacheMaxAgeDays), data.CacheMaxAgeDays.ValueInt32())
+	assert.Equal(t, int32(defaultMinGlobalHashLength), data.MinGlobalHashLength.ValueInt32())
+	assert.Equal(t, "merge", data.LocationMergeStrategy.ValueString())
+	assert.Equal(t, "error", data.CompatibilityMode.ValueString())
 	assert.Equal(t, "2026.01", sourceRef.Ref.ValueString())
 	assert.Equal(t, "azure/caf", sourceRef.Path.ValueString())
 	assert.Equal(t, "", sourceRef.CustomUrl.ValueString())
+	assert.Equal(t, s.FilePatterns{}, sourceRef.FilePatterns())
 
 }
 
@@ -96,10 +131,15 @@ func TestConfigureFromEnvironment(t *testing.T) {
 	t.Setenv("SA_RANDOM_SEED", "1234")
 	t.Setenv("SA_HASH_LENGTH", "8")
 	t.Setenv("SA_LOWERCASE", "true")
+	t.Setenv("TF_WORKSPACE", "feature1")
+	t.Setenv("SA_STACK", "network")
 
 	data = &providerData{}
 	diags = data.configProviderFromEnvironment()
 
+	assert.Equal(t, "feature1", data.Workspace.ValueString())
+	assert.Equal(t, "network", data.Stack.ValueString())
+
 	assert.Equal(t, "tst", data.Environment.ValueString())
 	assert.Equal(t, "default", data.Convention.ValueString())
 	assert.Equal(t, "-", data.Separator.ValueString())
@@ -124,3 +164,264 @@ func TestConfigureFromEnvironment(t *testing.T) {
 	assert.True(t, data.Convention.IsNull())
 	assert.True(t, diags.HasError())
 }
+
+func TestParseDownloadTimeout(t *testing.T) {
+	timeout, err := parseDownloadTimeout("90s")
+	assert.NoError(t, err)
+	assert.Equal(t, 90*time.Second, timeout)
+
+	_, err = parseDownloadTimeout("0s")
+	assert.Error(t, err)
+
+	_, err = parseDownloadTimeout("five minutes")
+	assert.Error(t, err)
+
+	t.Setenv("SA_DOWNLOAD_TIMEOUT", "-1m")
+	data := &providerData{}
+	diags := data.configProviderFromEnvironment()
+	assert.True(t, diags.HasError())
+	assert.True(t, data.DownloadTimeout.IsNull())
+}
+
+func TestMutableRefWarning(t *testing.T) {
+	withRef := func(ref string) *providerData {
+		data := &providerData{}
+		data.configProviderDefaults()
+		attrs := data.SchemaReference.Attributes()
+		attrs["ref"] = types.StringValue(ref)
+		data.SchemaReference = types.ObjectValueMust(data.SchemaReference.AttributeTypes(t.Context()), attrs)
+		return data
+	}
+
+	assert.Empty(t, withRef("2025.04").mutableRefWarning(t.Context()))
+	assert.Empty(t, withRef("v1.2.0").mutableRefWarning(t.Context()))
+
+	diags := withRef("main").mutableRefWarning(t.Context())
+	if assert.Len(t, diags, 1) {
+		assert.Equal(t, diag.SeverityWarning, diags[0].Severity())
+		assert.Contains(t, diags[0].Detail(), "The ref 'main' is not a release tag")
+	}
+
+	data := withRef("main")
+	data.AllowMutableRef = types.BoolValue(true)
+	assert.Empty(t, data.mutableRefWarning(t.Context()))
+}
+
+func TestCacheDestination(t *testing.T) {
+	source := newSource(s.SourceValue{Path: types.StringValue("azure/caf"), Ref: types.StringValue("2025.04")})
+
+	assert.Equal(t, hash(source), cacheDestination("", source))
+
+	cacheDir := t.TempDir()
+	assert.Equal(t, filepath.Join(cacheDir, hash(source)), cacheDestination(cacheDir, source))
+
+	// A relative cache_dir is resolved against the working directory.
+	wd, err := os.Getwd()
+	assert.NoError(t, err)
+	assert.Equal(t, filepath.Join(wd, "cache", hash(source)), cacheDestination("cache", source))
+}
+
+func testSchemaLibraryFS() fstest.MapFS {
+	return fstest.MapFS{
+		"schema.naming.json": &fstest.MapFile{Data: []byte(`[
+			{
+				"resourceType": "azurerm_resource_group",
+				"abbreviation": "rg",
+				"minLength": 1,
+				"maxLength": 90,
+				"validationRegex": "^[a-zA-Z0-9-._()]{0,89}[a-zA-Z0-9-_()]$",
+				"configuration": {
+					"useEnvironment": true,
+					"useLowerCase": false,
+					"useUpperCase": false,
+					"useSeparator": true,
+					"denyDoubleHyphens": false,
+					"namePrecedence": [],
+					"hashLength": 0
+				}
+			}
+		]`)},
+		"schema.locations.json": &fstest.MapFile{Data: []byte(`{"westeurope":"we"}`)},
+	}
+}
+
+func TestProviderConfigResultIsMemoized(t *testing.T) {
+	config := &ProviderConfig{SourceRef: testSchemaLibraryFS()}
+
+	var wg sync.WaitGroup
+	results := make([]*s.Result, 8)
+	for i := range results {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			r, err := config.Result()
+			assert.NoError(t, err)
+			results[i] = r
+		}(i)
+	}
+	wg.Wait()
+
+	for _, r := range results {
+		assert.Same(t, results[0], r)
+	}
+	assert.Len(t, results[0].NamingSchemas, 1)
+	assert.Equal(t, "we", results[0].Locations["westeurope"])
+
+	m, err := config.NamingSchemaMap()
+	assert.NoError(t, err)
+	assert.Equal(t, "rg", m["azurerm_resource_group"].Abbreviation.ValueString())
+}
+
+func TestProviderConfigResultError(t *testing.T) {
+	config := &ProviderConfig{SourceRef: fstest.MapFS{
+		"schema.naming.json": &fstest.MapFile{Data: []byte(`not json`)},
+	}}
+
+	_, err := config.Result()
+	assert.Error(t, err)
+
+	_, err = config.NamingSchemaMap()
+	assert.Error(t, err)
+}
+
+func TestProviderConfigInlineSchemas(t *testing.T) {
+	elemType := inlineSchemaAttribute().GetType().(types.ListType).ElemType.(types.ObjectType)
+	configurationType := elemType.AttrTypes["configuration"].(types.ObjectType)
+
+	data := providerData{
+		InlineSchema: types.ListValueMust(elemType, []attr.Value{
+			types.ObjectValueMust(elemType.AttrTypes, map[string]attr.Value{
+				"resource_type":    types.StringValue("azurerm_resource_group"),
+				"abbreviation":     types.StringValue("rsg"),
+				"min_length":       types.Int64Null(),
+				"max_length":       types.Int64Value(90),
+				"validation_regex": types.StringValue("^[a-z-]+$"),
+				"scope":            types.StringNull(),
+				"configuration":    types.ObjectNull(configurationType.AttrTypes),
+			}),
+			types.ObjectValueMust(elemType.AttrTypes, map[string]attr.Value{
+				"resource_type":    types.StringValue("azapi_container_app"),
+				"abbreviation":     types.StringValue("ca"),
+				"min_length":       types.Int64Value(2),
+				"max_length":       types.Int64Value(32),
+				"validation_regex": types.StringValue("^[a-z0-9-]+$"),
+				"scope":            types.StringValue("global"),
+				"configuration": types.ObjectValueMust(configurationType.AttrTypes, map[string]attr.Value{
+					"use_environment":         types.BoolValue(true),
+					"use_lower_case":          types.BoolValue(true),
+					"use_upper_case":          types.BoolNull(),
+					"use_separator":           types.BoolValue(true),
+					"separator":               types.StringNull(),
+					"deny_double_hyphens":     types.BoolValue(true),
+					"deny_repeated_separator": types.BoolNull(),
+					"deny_leading":            types.StringNull(),
+					"deny_trailing":           types.StringNull(),
+					"name_precedence":         types.ListValueMust(types.StringType, []attr.Value{types.StringValue("abbreviation"), types.StringValue("name")}),
+					"hash_length":             types.Int32Null(),
+					"deny_patterns":           types.ListNull(types.StringType),
+				}),
+			}),
+		}),
+	}
+
+	inlineSchemas, diags := data.inlineSchemas(t.Context())
+	assert.False(t, diags.HasError())
+	assert.Len(t, inlineSchemas, 2)
+	assert.Equal(t, 1, inlineSchemas[0].MinLength)
+	assert.Equal(t, []string{"abbreviation", "name"}, inlineSchemas[1].Configuration.NamePrecedence)
+	assert.Equal(t, s.ScopeGlobal, inlineSchemas[1].Scope)
+
+	config := &ProviderConfig{SourceRef: testSchemaLibraryFS(), InlineSchemas: inlineSchemas}
+
+	result, err := config.Result()
+	assert.NoError(t, err)
+	assert.Len(t, result.NamingSchemas, 2)
+
+	m, err := config.NamingSchemaMap()
+	assert.NoError(t, err)
+	assert.Equal(t, "rsg", m["azurerm_resource_group"].Abbreviation.ValueString())
+	assert.Equal(t, int64(32), m["azapi_container_app"].MaxLength.ValueInt64())
+	assert.True(t, m["azapi_container_app"].Configuration.UseLowerCase.ValueBool())
+}
+
+func TestProviderConfigLocations(t *testing.T) {
+	locations := map[string]string{"westeurope": "weu", "dc-frankfurt": "fra"}
+
+	config := &ProviderConfig{SourceRef: testSchemaLibraryFS(), Locations: locations, LocationMergeStrategy: locationMergeStrategyMerge}
+	result, err := config.Result()
+	assert.NoError(t, err)
+	assert.Equal(t, s.LocationsMapSchema{"westeurope": "weu", "dc-frankfurt": "fra"}, result.Locations)
+
+	library := s.LocationsMapSchema{"westeurope": "we", "northeurope": "ne"}
+	assert.Equal(t, s.LocationsMapSchema{"westeurope": "weu", "northeurope": "ne", "dc-frankfurt": "fra"}, mergeLocations(library, locations, locationMergeStrategyMerge))
+	assert.Equal(t, s.LocationsMapSchema{"westeurope": "weu", "dc-frankfurt": "fra"}, mergeLocations(library, locations, locationMergeStrategyReplace))
+	assert.Equal(t, library, mergeLocations(library, nil, locationMergeStrategyReplace))
+}
+
+func TestProviderConfigLocationSource(t *testing.T) {
+	config := &ProviderConfig{SourceRef: testSchemaLibraryFS(), LocationSource: s.LocationCatalogAWS, LocationMergeStrategy: locationMergeStrategyMerge}
+	result, err := config.Result()
+	assert.NoError(t, err)
+	assert.Equal(t, s.AWSLocations, result.Locations)
+
+	// Provider locations are merged over the catalog.
+	config = &ProviderConfig{
+		SourceRef:             testSchemaLibraryFS(),
+		Locations:             map[string]string{"europe-west3": "fra"},
+		LocationSource:        s.LocationCatalogGCP,
+		LocationMergeStrategy: locationMergeStrategyMerge,
+	}
+	result, err = config.Result()
+	assert.NoError(t, err)
+	assert.Equal(t, "fra", result.Locations["europe-west3"])
+	assert.Equal(t, "usc1", result.Locations["us-central1"])
+	assert.Equal(t, "euw3", s.GCPLocations["europe-west3"])
+
+	// The library source keeps the library locations.
+	config = &ProviderConfig{SourceRef: testSchemaLibraryFS(), LocationSource: locationSourceLibrary}
+	result, err = config.Result()
+	assert.NoError(t, err)
+	assert.NotContains(t, result.Locations, "eu-central-1")
+}
+
+func TestProviderConfigLocationsFromSource(t *testing.T) {
+	config := &ProviderConfig{
+		SourceRef:             testSchemaLibraryFS(),
+		Locations:             map[string]string{"dc-frankfurt": "fra"},
+		LocationSource:        s.LocationCatalogAWS,
+		LocationMergeStrategy: locationMergeStrategyMerge,
+	}
+	result, err := config.Result()
+	assert.NoError(t, err)
+
+	locations, err := config.LocationsFromSource(s.LocationCatalogAWS)
+	assert.NoError(t, err)
+	assert.Equal(t, result.Locations, locations)
+
+	// The library locations are kept for other sources and the provider
+	// locations are applied to every source.
+	locations, err = config.LocationsFromSource(locationSourceLibrary)
+	assert.NoError(t, err)
+	assert.NotContains(t, locations, "eu-central-1")
+	assert.Equal(t, "fra", locations["dc-frankfurt"])
+
+	locations, err = config.LocationsFromSource(s.LocationCatalogGCP)
+	assert.NoError(t, err)
+	assert.Equal(t, "euw3", locations["europe-west3"])
+	assert.Equal(t, "fra", locations["dc-frankfurt"])
+
+	_, err = config.LocationsFromSource("azure")
+	assert.EqualError(t, err, "unknown location source 'azure'")
+}
+
+func TestProviderConfigEnvironments(t *testing.T) {
+	library := fstest.MapFS{
+		"schema.naming.json":       {Data: []byte(`[{"resourceType":"azurerm_resource_group"}]`)},
+		"schema.environments.json": {Data: []byte(`{"version":2,"environments":{"production":"prd","development":"dev"},"allowed":["prd","dev","stg"]}`)},
+	}
+	config := &ProviderConfig{SourceRef: library, Environments: map[string]string{"staging": "stg", "production": "prod"}}
+	result, err := config.Result()
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"production": "prod", "development": "dev", "staging": "stg"}, result.Environments.Environments)
+	assert.Equal(t, []string{"prd", "dev", "stg"}, result.Environments.Allowed)
+}
@@ -0,0 +1,347 @@
+// Copyright glueckkanja AG 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"strings"
+	"terraform-provider-standesamt/internal/random"
+	s "terraform-provider-standesamt/internal/schema"
+	"terraform-provider-standesamt/internal/tools"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                   = &RandomSuffixResource{}
+	_ resource.ResourceWithConfigure      = &RandomSuffixResource{}
+	_ resource.ResourceWithValidateConfig = &RandomSuffixResource{}
+	_ resource.ResourceWithModifyPlan     = &RandomSuffixResource{}
+)
+
+// randomSuffixDefaultLength is used if neither the length argument nor the
+// hash length of the resource type is set.
+const randomSuffixDefaultLength = 4
+
+type randomSuffixResourceModel struct {
+	Id           types.String `tfsdk:"id"`
+	ResourceType types.String `tfsdk:"resource_type"`
+	Length       types.Int64  `tfsdk:"length"`
+	Charset      types.String `tfsdk:"charset"`
+	Keepers      types.Map    `tfsdk:"keepers"`
+	Result       types.String `tfsdk:"result"`
+}
+
+func NewRandomSuffixResource() resource.Resource {
+	return &RandomSuffixResource{}
+}
+
+// RandomSuffixResource defines the resource implementation.
+type RandomSuffixResource struct {
+	providerConfig *ProviderConfig
+}
+
+func (r *RandomSuffixResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_random_suffix"
+}
+
+func (r *RandomSuffixResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Resource to generate a cryptographically random suffix for a resource type once and keep it in the state.",
+		MarkdownDescription: "Resource to generate a cryptographically random suffix for a resource type once and keep it in the state, like `random_string` but limited to the characters and length the naming schema of the resource type allows. Use it as a suffix instead of the deterministic hash of the naming functions if the hash must not be derived from a seed. The suffix is only regenerated if an argument changes.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				Description:         "The generated suffix.",
+				MarkdownDescription: "The generated suffix.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"resource_type": schema.StringAttribute{
+				Required:            true,
+				Description:         "The resource type of the schema library the suffix is generated for, e.g. 'azurerm_storage_account'.",
+				MarkdownDescription: "The resource type of the schema library the suffix is generated for, e.g. `azurerm_storage_account`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"length": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "The length of the suffix. Default: the hash length of the resource type, or 4 if the resource type has no hash. Must not exceed the maximum length of the resource type.",
+				MarkdownDescription: "The length of the suffix. Default: the hash length of the resource type, or `4` if the resource type has no hash. Must not exceed the maximum length of the resource type.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"charset": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "The characters of the suffix: 'lowercase' (default) or 'alphanumeric' for lowercase letters and digits. Characters the validation regex of the resource type rejects are left out, resource types with upper case names get upper case letters.",
+				MarkdownDescription: "The characters of the suffix: `lowercase` (default) or `alphanumeric` for lowercase letters and digits. Characters the validation regex of the resource type rejects are left out, resource types with upper case names get upper case letters.",
+				Default:             stringdefault.StaticString(hashCharsetLowercase),
+				Validators: []validator.String{
+					stringvalidator.OneOf(hashCharsetLowercase, hashCharsetAlphanumeric),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"keepers": schema.MapAttribute{
+				Optional:            true,
+				Description:         "Arbitrary values that trigger a new suffix when they change.",
+				MarkdownDescription: "Arbitrary values that trigger a new suffix when they change.",
+				ElementType:         types.StringType,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"result": schema.StringAttribute{
+				Computed:            true,
+				Description:         "The generated suffix.",
+				MarkdownDescription: "The generated suffix.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *RandomSuffixResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*ProviderConfig)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ProviderConfig, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.providerConfig = data
+}
+
+func (r *RandomSuffixResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	// The naming schema is only available once the provider is configured, the
+	// checks are repeated by ModifyPlan otherwise.
+	if r.providerConfig == nil {
+		return
+	}
+
+	var data randomSuffixResourceModel
+	if resp.Diagnostics.Append(req.Config.Get(ctx, &data)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, diags := data.resolve(r.providerConfig)
+	resp.Diagnostics.Append(diags...)
+}
+
+// ModifyPlan checks the arguments against the naming schema and resolves the
+// default length at plan time, so an unknown resource type or a suffix the
+// schema does not allow fails the plan instead of the apply.
+func (r *RandomSuffixResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check on destroy or before the provider is configured.
+	if req.Plan.Raw.IsNull() || r.providerConfig == nil {
+		return
+	}
+
+	var plan randomSuffixResourceModel
+	if resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	// A kept suffix is not checked again, e.g. after a schema library upgrade.
+	if !req.State.Raw.IsNull() {
+		var state randomSuffixResourceModel
+		if resp.Diagnostics.Append(req.State.Get(ctx, &state)...); resp.Diagnostics.HasError() {
+			return
+		}
+		if plan.ResourceType.Equal(state.ResourceType) && plan.Length.Equal(state.Length) && plan.Charset.Equal(state.Charset) {
+			return
+		}
+	}
+
+	// The length is computed if it is not configured.
+	var length types.Int64
+	if resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("length"), &length)...); resp.Diagnostics.HasError() {
+		return
+	}
+	if length.IsNull() && plan.Length.IsUnknown() {
+		plan.Length = types.Int64Null()
+	}
+
+	_, diags := plan.resolve(r.providerConfig)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
+
+func (r *RandomSuffixResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data randomSuffixResourceModel
+
+	if resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	charset, diags := data.resolve(r.providerConfig)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, err := random.CryptoString(int(data.Length.ValueInt64()), charset)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to generate random suffix", err.Error())
+		return
+	}
+
+	data.Id = types.StringValue(result)
+	data.Result = types.StringValue(result)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RandomSuffixResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data randomSuffixResourceModel
+
+	if resp.Diagnostics.Append(req.State.Get(ctx, &data)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The suffix only lives in the state.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RandomSuffixResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data randomSuffixResourceModel
+
+	// All arguments require a replacement, the suffix is carried over as is.
+	if resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RandomSuffixResource) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+	// The suffix only lives in the state, nothing to delete.
+}
+
+// resolve looks up the naming schema of the resource type, sets the default
+// length if none is given and returns the characters of the suffix. Unknown
+// arguments are skipped, they are checked once they are known.
+func (m *randomSuffixResourceModel) resolve(providerConfig *ProviderConfig) (string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if m.ResourceType.IsUnknown() {
+		return "", diags
+	}
+
+	namingSchemaMap, err := providerConfig.NamingSchemaMap()
+	if err != nil {
+		diags.AddError("source_reference", err.Error())
+		return "", diags
+	}
+
+	namingSchema, ok := namingSchemaMap[m.ResourceType.ValueString()]
+	if !ok {
+		diags.AddAttributeError(
+			path.Root("resource_type"),
+			"Unknown resource type",
+			fmt.Sprintf("resource type '%s' not found in schema library", m.ResourceType.ValueString()),
+		)
+		return "", diags
+	}
+
+	if m.Length.IsNull() {
+		length := int64(namingSchema.Configuration.HashLength.ValueInt32())
+		if length <= 0 {
+			length = randomSuffixDefaultLength
+		}
+		m.Length = types.Int64Value(length)
+	}
+	if m.Length.IsUnknown() || m.Charset.IsUnknown() {
+		return "", diags
+	}
+	if maxLength := namingSchema.MaxLength.ValueInt64(); maxLength > 0 && m.Length.ValueInt64() > maxLength {
+		diags.AddAttributeError(
+			path.Root("length"),
+			"Invalid length",
+			fmt.Sprintf("length %d exceeds the maximum length %d of resource type '%s'", m.Length.ValueInt64(), maxLength, m.ResourceType.ValueString()),
+		)
+		return "", diags
+	}
+
+	charset, err := randomSuffixCharset(&namingSchema, m.Charset.ValueString(), m.Length.ValueInt64())
+	if err != nil {
+		diags.AddAttributeError(path.Root("charset"), "Invalid charset", err.Error())
+		return "", diags
+	}
+	return charset, diags
+}
+
+// randomSuffixCharset returns the characters of the named charset the naming
+// schema allows. A character is allowed if a name consisting only of that
+// character matches the validation regex, which keeps the check conservative
+// for regexes with rules for the first or last character.
+func randomSuffixCharset(namingSchema *s.NamingSchema, name string, length int64) (string, error) {
+	charset := random.Lowercase
+	if name == hashCharsetAlphanumeric {
+		charset = random.LowercaseAlphanumeric
+	}
+	if namingSchema.Configuration.UseUpperCase.ValueBool() {
+		charset = strings.ToUpper(charset)
+	}
+
+	pattern := tools.GetBaseString(namingSchema.ValidationRegex)
+	if pattern == "" {
+		return charset, nil
+	}
+	re, err := compileValidationRegex(pattern)
+	if err != nil {
+		return "", err
+	}
+
+	// The probe has the length of the suffix within the limits of the schema.
+	probeLength := max(length, namingSchema.MinLength.ValueInt64(), 1)
+	if maxLength := namingSchema.MaxLength.ValueInt64(); maxLength > 0 {
+		probeLength = min(probeLength, maxLength)
+	}
+
+	var allowed strings.Builder
+	for _, c := range charset {
+		if re.MatchString(strings.Repeat(string(c), int(probeLength))) {
+			allowed.WriteRune(c)
+		}
+	}
+	if allowed.Len() == 0 {
+		return "", fmt.Errorf("the validation regex '%s' does not allow any character of the charset '%s'", pattern, name)
+	}
+	return allowed.String(), nil
+}
@@ -0,0 +1,111 @@
+// Copyright glueckkanja AG 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"regexp"
+	"terraform-provider-standesamt/internal/random"
+	s "terraform-provider-standesamt/internal/schema"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRandomSuffixCharset(t *testing.T) {
+	namingSchema := &s.NamingSchema{
+		ValidationRegex: types.StringValue("^[a-z0-9]{3,24}$"),
+		MinLength:       types.Int64Value(3),
+		MaxLength:       types.Int64Value(24),
+	}
+
+	charset, err := randomSuffixCharset(namingSchema, hashCharsetAlphanumeric, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, random.LowercaseAlphanumeric, charset)
+
+	// Digits are left out if a name must start with a letter.
+	namingSchema.ValidationRegex = types.StringValue("^[a-z][a-z0-9]{2,23}$")
+	charset, err = randomSuffixCharset(namingSchema, hashCharsetAlphanumeric, 4)
+	assert.NoError(t, err)
+	assert.Equal(t, random.Lowercase, charset)
+
+	namingSchema.Configuration.UseUpperCase = types.BoolValue(true)
+	namingSchema.ValidationRegex = types.StringValue("^[A-Z]+$")
+	charset, err = randomSuffixCharset(namingSchema, hashCharsetLowercase, 4)
+	assert.NoError(t, err)
+	assert.Equal(t, random.Uppercase, charset)
+
+	namingSchema.ValidationRegex = types.StringValue("^[0-9]+$")
+	_, err = randomSuffixCharset(namingSchema, hashCharsetLowercase, 4)
+	assert.ErrorContains(t, err, "does not allow any character of the charset 'lowercase'")
+}
+
+func TestRandomSuffixResolve(t *testing.T) {
+	config := &ProviderConfig{SourceRef: testLibrary}
+	config.ProviderData.configProviderDefaults()
+
+	data := randomSuffixResourceModel{
+		ResourceType: types.StringValue("azurerm_resource_group"),
+		Length:       types.Int64Null(),
+		Charset:      types.StringValue(hashCharsetAlphanumeric),
+	}
+	charset, diags := data.resolve(config)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, random.LowercaseAlphanumeric, charset)
+	assert.False(t, data.Length.IsNull())
+
+	// Unknown arguments are checked once they are known.
+	data.Length = types.Int64Unknown()
+	_, diags = data.resolve(config)
+	assert.False(t, diags.HasError())
+
+	data.Length = types.Int64Value(100)
+	_, diags = data.resolve(config)
+	assert.True(t, diags.HasError())
+	assert.Contains(t, diags.Errors()[0].Detail(), "exceeds the maximum length 90")
+
+	data.ResourceType = types.StringValue("azurerm_unknown")
+	_, diags = data.resolve(config)
+	assert.True(t, diags.HasError())
+	assert.Contains(t, diags.Errors()[0].Detail(), "not found in schema library")
+}
+
+func TestAccStandesamtRandomSuffix(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactoriesUnique(),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "standesamt_random_suffix" "storage" {
+  resource_type = "azurerm_storage_account"
+  length        = 6
+  charset       = "alphanumeric"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("standesamt_random_suffix.storage", "length", "6"),
+					resource.TestMatchResourceAttr("standesamt_random_suffix.storage", "result", regexp.MustCompile(`^[a-z0-9]{6}$`)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccStandesamtRandomSuffixTooLong(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactoriesUnique(),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "standesamt_random_suffix" "storage" {
+  resource_type = "azurerm_storage_account"
+  length        = 30
+}
+`,
+				ExpectError: regexp.MustCompile(`exceeds the maximum length 24`),
+			},
+		},
+	})
+}
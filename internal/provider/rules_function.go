@@ -0,0 +1,98 @@
+// Copyright glueckkanja AG 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	s "terraform-provider-standesamt/internal/schema"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = &RulesFunction{}
+
+type RulesFunction struct{}
+
+func NewRulesFunction() function.Function {
+	return &RulesFunction{}
+}
+
+func rulesTypeAttributes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"regex":               types.StringType,
+		"min_length":          types.Int64Type,
+		"max_length":          types.Int64Type,
+		"deny_double_hyphens": types.BoolType,
+		"scope":               types.StringType,
+	}
+}
+
+func (f *RulesFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "rules"
+}
+
+func (f *RulesFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Return the naming rules of a resource type",
+		Description: "Return the validation regex, the minimum and maximum length, whether double hyphens are denied and the scope of names of a resource type from the naming schema.",
+		MarkdownDescription: "Return the naming rules of a resource type from the naming schema: the validation `regex`, the " +
+			"`min_length` and `max_length`, whether double hyphens are denied (`deny_double_hyphens`) and the `scope` " +
+			"the name has to be unique in. Use it in `validation` blocks of module variables, e.g. " +
+			"`can(regex(provider::standesamt::rules(local.config, \"azurerm_storage_account\").regex, var.name))`, " +
+			"so the checks follow the schema library. The `scope` is empty if the schema library does not define it.",
+		Parameters: []function.Parameter{
+			configurationsParameter(),
+			function.StringParameter{
+				Name:        "name_type",
+				Description: "The resource type to return the rules for.",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: rulesTypeAttributes(),
+		},
+	}
+}
+
+func (f *RulesFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var (
+		nameType              string
+		configurationsDynamic types.Dynamic
+	)
+
+	if resp.Error = req.Arguments.Get(ctx, &configurationsDynamic, &nameType); resp.Error != nil {
+		return
+	}
+
+	configurations, err := configurationsArgument(ctx, configurationsDynamic)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	_, _, typeSchema, err := parseConfigurations(ctx, configurations, nameType, types.DynamicNull(), resp)
+	if err != nil || resp.Error != nil {
+		return
+	}
+
+	rules, diags := rulesValue(typeSchema)
+	if resp.Error = function.FuncErrorFromDiags(ctx, diags); resp.Error != nil {
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, rules))
+}
+
+// rulesValue returns the rules of the naming schema of a resource type.
+func rulesValue(typeSchema *s.NamingSchema) (types.Object, diag.Diagnostics) {
+	return types.ObjectValue(rulesTypeAttributes(), map[string]attr.Value{
+		"regex":               types.StringValue(typeSchema.ValidationRegex.ValueString()),
+		"min_length":          types.Int64Value(typeSchema.MinLength.ValueInt64()),
+		"max_length":          types.Int64Value(typeSchema.MaxLength.ValueInt64()),
+		"deny_double_hyphens": types.BoolValue(typeSchema.Configuration.DenyDoubleHyphens.ValueBool()),
+		"scope":               types.StringValue(typeSchema.Scope.ValueString()),
+	})
+}
@@ -0,0 +1,76 @@
+// Copyright glueckkanja AG 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	s "terraform-provider-standesamt/internal/schema"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRulesValue(t *testing.T) {
+	rules, diags := rulesValue(&s.NamingSchema{
+		ValidationRegex: types.StringValue("^[a-z0-9]{3,24}$"),
+		MinLength:       types.Int64Value(3),
+		MaxLength:       types.Int64Value(24),
+		Configuration:   s.Configuration{DenyDoubleHyphens: types.BoolValue(true)},
+		Scope:           types.StringValue(s.ScopeGlobal),
+	})
+	assert.False(t, diags.HasError())
+	attrs := rules.Attributes()
+	assert.Equal(t, types.StringValue("^[a-z0-9]{3,24}$"), attrs["regex"])
+	assert.Equal(t, types.Int64Value(3), attrs["min_length"])
+	assert.Equal(t, types.Int64Value(24), attrs["max_length"])
+	assert.Equal(t, types.BoolValue(true), attrs["deny_double_hyphens"])
+	assert.Equal(t, types.StringValue(s.ScopeGlobal), attrs["scope"])
+
+	// Unset values of the schema are returned as empty values, not null.
+	rules, diags = rulesValue(&s.NamingSchema{})
+	assert.False(t, diags.HasError())
+	assert.Equal(t, types.StringValue(""), rules.Attributes()["scope"])
+}
+
+func TestRulesFunction_ResourceGroup(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactoriesUnique(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf("%s %s", default_config_with_no_settings_default_precedence, `output "test" {
+					value = provider::standesamt::rules(local.config, "azurerm_resource_group")
+				}`),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("test", knownvalue.ObjectExact(map[string]knownvalue.Check{
+						"regex":               knownvalue.StringExact("^[a-zA-Z0-9-._()]{0,89}[a-zA-Z0-9-_()]$"),
+						"min_length":          knownvalue.Int64Exact(8),
+						"max_length":          knownvalue.Int64Exact(20),
+						"deny_double_hyphens": knownvalue.Bool(true),
+						"scope":               knownvalue.StringExact(""),
+					})),
+				},
+			},
+		},
+	})
+}
+
+func TestRulesFunction_UnknownResourceType(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactoriesUnique(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf("%s %s", default_config_with_no_settings_default_precedence, `output "test" {
+					value = provider::standesamt::rules(local.config, "azurerm_unknown")
+				}`),
+				ExpectError: regexp.MustCompile(`resource type 'azurerm_unknown' not found in schema`),
+			},
+		},
+	})
+}
@@ -0,0 +1,216 @@
+// Copyright glueckkanja AG 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	s "terraform-provider-standesamt/internal/schema"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &SchemaDiffDataSource{}
+
+type schemaDiffDataSourceModel struct {
+	From               types.Object `tfsdk:"from"`
+	To                 types.Object `tfsdk:"to"`
+	Added              types.List   `tfsdk:"added"`
+	Removed            types.List   `tfsdk:"removed"`
+	Changes            types.List   `tfsdk:"changes"`
+	HasBreakingChanges types.Bool   `tfsdk:"has_breaking_changes"`
+}
+
+func schemaChangeTypeAttributes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"resource_type": types.StringType,
+		"field":         types.StringType,
+		"from":          types.StringType,
+		"to":            types.StringType,
+		"breaking":      types.BoolType,
+	}
+}
+
+// schemaReferenceAttribute returns the attribute of a schema reference to compare.
+func schemaReferenceAttribute(description string) schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Required:            true,
+		Description:         description,
+		MarkdownDescription: description,
+		Attributes: map[string]schema.Attribute{
+			"path": schema.StringAttribute{
+				Optional:            true,
+				Description:         "The path in the default schema library, e.g. `azure/caf`. Also requires `ref`.",
+				MarkdownDescription: "The path in the default schema library, e.g. `azure/caf`. Also requires `ref`.",
+			},
+			"ref": schema.StringAttribute{
+				Optional:            true,
+				Description:         "The version of the schema library, e.g. `2026.01`. Also requires `path`.",
+				MarkdownDescription: "The version of the schema library, e.g. `2026.01`. Also requires `path`.",
+			},
+			"custom_url": schema.StringAttribute{
+				Optional:            true,
+				Sensitive:           true,
+				Description:         "A custom path/URL to the schema library, supplied to go-getter. Conflicts with `path` and `ref`.",
+				MarkdownDescription: "A custom path/URL to the schema library, supplied to go-getter. Conflicts with `path` and `ref`.",
+			},
+			"bundle_url": schema.StringAttribute{
+				Optional:            true,
+				Sensitive:           true,
+				Description:         "A path/URL to a zip bundle of the schema library with a `manifest.json`. Conflicts with `custom_url`, `path` and `ref`.",
+				MarkdownDescription: "A path/URL to a zip bundle of the schema library with a `manifest.json`. Conflicts with `custom_url`, `path` and `ref`.",
+			},
+			"naming_file": schema.StringAttribute{
+				Optional:            true,
+				Description:         "File name or glob pattern of the naming schema files in the library.",
+				MarkdownDescription: "File name or glob pattern of the naming schema files in the library.",
+			},
+			"locations_file": schema.StringAttribute{
+				Optional:            true,
+				Description:         "File name or glob pattern of the locations files in the library.",
+				MarkdownDescription: "File name or glob pattern of the locations files in the library.",
+			},
+			"base_dir": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Directory that relative local paths of `custom_url` and `bundle_url` are resolved against, e.g. `abspath(path.module)`.",
+				MarkdownDescription: "Directory that relative local paths of `custom_url` and `bundle_url` are resolved against, e.g. `abspath(path.module)`.",
+			},
+		},
+	}
+}
+
+func NewSchemaDiffDataSource() datasource.DataSource {
+	return &SchemaDiffDataSource{}
+}
+
+// SchemaDiffDataSource defines the data source implementation.
+type SchemaDiffDataSource struct {
+	providerConfig *ProviderConfig
+}
+
+func (d *SchemaDiffDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_schema_diff"
+}
+
+func (d *SchemaDiffDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Data source to compare the naming schemas of two schema library references.",
+		MarkdownDescription: "Data source to compare the naming schemas of two schema library references, e.g. the current and the next `ref`. It reports added and removed resource types and changed fields like the abbreviation, `maxLength` or `validationRegex`. Use it to review breaking changes before bumping the library version.",
+		Attributes: map[string]schema.Attribute{
+			"from": schemaReferenceAttribute("The schema reference to compare from, e.g. the library version in use."),
+			"to":   schemaReferenceAttribute("The schema reference to compare to, e.g. the next library version."),
+			"added": schema.ListAttribute{
+				Computed:            true,
+				ElementType:         types.StringType,
+				Description:         "The resource types that are only defined in 'to'.",
+				MarkdownDescription: "The resource types that are only defined in `to`.",
+			},
+			"removed": schema.ListAttribute{
+				Computed:            true,
+				ElementType:         types.StringType,
+				Description:         "The resource types that are only defined in 'from'.",
+				MarkdownDescription: "The resource types that are only defined in `from`.",
+			},
+			"changes": schema.ListAttribute{
+				Computed:            true,
+				Description:         "The changed fields sorted by resource type. Each change contains the resource_type, the field, the from and to values and whether the change is breaking, i.e. names change or become invalid.",
+				MarkdownDescription: "The changed fields sorted by resource type. Each change contains the `resource_type`, the `field`, the `from` and `to` values and whether the change is `breaking`, i.e. names change or become invalid.",
+				ElementType: types.ObjectType{
+					AttrTypes: schemaChangeTypeAttributes(),
+				},
+			},
+			"has_breaking_changes": schema.BoolAttribute{
+				Computed:            true,
+				Description:         "True if resource types were removed or changed in a breaking way.",
+				MarkdownDescription: "True if resource types were removed or changed in a breaking way.",
+			},
+		},
+	}
+}
+
+func (d *SchemaDiffDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*ProviderConfig)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *ProviderConfig, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.providerConfig = data
+}
+
+func (d *SchemaDiffDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var model schemaDiffDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, err := parseDownloadTimeout(d.providerConfig.ProviderData.DownloadTimeout.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("download_timeout", err.Error())
+		return
+	}
+
+	libraries := make([][]s.JsonNamingSchema, 0, 2)
+	for _, ref := range []struct {
+		name  string
+		value types.Object
+	}{{"from", model.From}, {"to", model.To}} {
+		var sourceValue s.SourceValue
+		if resp.Diagnostics.Append(ref.value.As(ctx, &sourceValue, basetypes.ObjectAsOptions{})...); resp.Diagnostics.HasError() {
+			return
+		}
+
+		result, err := loadSchemaLibrary(ctx, sourceValue, timeout, d.providerConfig.ProviderData.CacheDir.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root(ref.name), "Failed to load schema library", err.Error())
+			return
+		}
+		libraries = append(libraries, result.NamingSchemas)
+	}
+
+	diff := s.DiffNamingSchemas(libraries[0], libraries[1])
+
+	elements := make([]attr.Value, 0, len(diff.Changed))
+	for _, c := range diff.Changed {
+		element, diags := types.ObjectValue(schemaChangeTypeAttributes(), map[string]attr.Value{
+			"resource_type": types.StringValue(c.ResourceType),
+			"field":         types.StringValue(c.Field),
+			"from":          types.StringValue(c.From),
+			"to":            types.StringValue(c.To),
+			"breaking":      types.BoolValue(c.Breaking),
+		})
+		if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+			return
+		}
+		elements = append(elements, element)
+	}
+
+	changes, diags := types.ListValue(types.ObjectType{AttrTypes: schemaChangeTypeAttributes()}, elements)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	model.Added = stringSliceToList(diff.Added)
+	model.Removed = stringSliceToList(diff.Removed)
+	model.Changes = changes
+	model.HasBreakingChanges = types.BoolValue(diff.HasBreakingChanges())
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
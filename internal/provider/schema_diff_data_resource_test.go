@@ -0,0 +1,32 @@
+// Copyright glueckkanja AG 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccStandesamtSchemaDiff(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactoriesUnique(),
+		ExternalProviders:        map[string]resource.ExternalProvider{},
+		Steps: []resource.TestStep{
+			{
+				Config: `data "standesamt_schema_diff" "test" {
+					from = { path = "azure/caf", ref = "2026.01" }
+					to   = { path = "azure/caf", ref = "2026.01" }
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.standesamt_schema_diff.test", "added.#", "0"),
+					resource.TestCheckResourceAttr("data.standesamt_schema_diff.test", "removed.#", "0"),
+					resource.TestCheckResourceAttr("data.standesamt_schema_diff.test", "changes.#", "0"),
+					resource.TestCheckResourceAttr("data.standesamt_schema_diff.test", "has_breaking_changes", "false"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,148 @@
+// Copyright glueckkanja AG 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	s "terraform-provider-standesamt/internal/schema"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &SchemaLintDataSource{}
+
+type schemaLintDataSourceModel struct {
+	Findings     types.List  `tfsdk:"findings"`
+	ErrorCount   types.Int64 `tfsdk:"error_count"`
+	WarningCount types.Int64 `tfsdk:"warning_count"`
+	Valid        types.Bool  `tfsdk:"valid"`
+}
+
+func lintFindingTypeAttributes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"resource_type": types.StringType,
+		"severity":      types.StringType,
+		"check":         types.StringType,
+		"message":       types.StringType,
+	}
+}
+
+func NewSchemaLintDataSource() datasource.DataSource {
+	return &SchemaLintDataSource{}
+}
+
+// SchemaLintDataSource defines the data source implementation.
+type SchemaLintDataSource struct {
+	providerConfig *ProviderConfig
+}
+
+func (d *SchemaLintDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_schema_lint"
+}
+
+func (d *SchemaLintDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Data source to run structural checks over the loaded schema library.",
+		MarkdownDescription: "Data source to run structural checks over the loaded schema library, e.g. duplicate resource types, invalid regexes, `minLength` greater than `maxLength` or unknown name precedence tokens. Use it to get feedback on a custom library before its schemas break the `name` function in consumer plans.",
+		Attributes: map[string]schema.Attribute{
+			"findings": schema.ListAttribute{
+				Computed:            true,
+				Description:         "The findings sorted by resource type. Each finding contains the resource_type, the severity ('error' or 'warning'), the check and a message.",
+				MarkdownDescription: "The findings sorted by resource type. Each finding contains the `resource_type`, the `severity` (`error` or `warning`), the `check` and a `message`.",
+				ElementType: types.ObjectType{
+					AttrTypes: lintFindingTypeAttributes(),
+				},
+			},
+			"error_count": schema.Int64Attribute{
+				Computed:            true,
+				Description:         "The number of findings with severity 'error'.",
+				MarkdownDescription: "The number of findings with severity `error`.",
+			},
+			"warning_count": schema.Int64Attribute{
+				Computed:            true,
+				Description:         "The number of findings with severity 'warning'.",
+				MarkdownDescription: "The number of findings with severity `warning`.",
+			},
+			"valid": schema.BoolAttribute{
+				Computed:            true,
+				Description:         "True if the library has no findings with severity 'error'.",
+				MarkdownDescription: "True if the library has no findings with severity `error`.",
+			},
+		},
+	}
+}
+
+func (d *SchemaLintDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*ProviderConfig)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *ProviderConfig, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.providerConfig = data
+}
+
+func (d *SchemaLintDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var model schemaLintDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, err := d.providerConfig.Result()
+	if err != nil {
+		resp.Diagnostics.AddError("source_reference", err.Error())
+		return
+	}
+
+	findings := s.Lint(result.NamingSchemas)
+
+	var errorCount, warningCount int64
+	elements := make([]attr.Value, 0, len(findings))
+	for _, f := range findings {
+		switch f.Severity {
+		case s.LintSeverityError:
+			errorCount++
+		case s.LintSeverityWarning:
+			warningCount++
+		}
+
+		element, diags := types.ObjectValue(lintFindingTypeAttributes(), map[string]attr.Value{
+			"resource_type": types.StringValue(f.ResourceType),
+			"severity":      types.StringValue(f.Severity),
+			"check":         types.StringValue(f.Check),
+			"message":       types.StringValue(f.Message),
+		})
+		if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+			return
+		}
+		elements = append(elements, element)
+	}
+
+	findingsList, diags := types.ListValue(types.ObjectType{AttrTypes: lintFindingTypeAttributes()}, elements)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	model.Findings = findingsList
+	model.ErrorCount = types.Int64Value(errorCount)
+	model.WarningCount = types.Int64Value(warningCount)
+	model.Valid = types.BoolValue(errorCount == 0)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
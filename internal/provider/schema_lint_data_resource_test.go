@@ -0,0 +1,28 @@
+// Copyright glueckkanja AG 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccStandesamtSchemaLint(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactoriesUnique(),
+		ExternalProviders:        map[string]resource.ExternalProvider{},
+		Steps: []resource.TestStep{
+			{
+				Config: `data "standesamt_schema_lint" "test" {}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.standesamt_schema_lint.test", "valid", "true"),
+					resource.TestCheckResourceAttr("data.standesamt_schema_lint.test", "error_count", "0"),
+					resource.TestCheckResourceAttrSet("data.standesamt_schema_lint.test", "warning_count"),
+				),
+			},
+		},
+	})
+}
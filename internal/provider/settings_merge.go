@@ -0,0 +1,84 @@
+// Copyright glueckkanja AG 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+// settingLayer is a level of the settings hierarchy a value of the name
+// builder is taken from.
+type settingLayer string
+
+const (
+	// settingLayerDefault is the built-in default of the provider.
+	settingLayerDefault settingLayer = "default"
+	// settingLayerConfiguration is the configuration object, i.e. the provider
+	// configuration merged with the arguments of the standesamt_config data
+	// source, which win over the provider.
+	settingLayerConfiguration settingLayer = "configuration"
+	// settingLayerSchema is the per-type configuration of the naming schema.
+	settingLayerSchema settingLayer = "schema"
+	// settingLayerSettings is the settings argument of the function call.
+	settingLayerSettings settingLayer = "settings"
+)
+
+// settingsMergeOrder is the merge order of the name builder from highest to
+// lowest precedence: the call settings win over the naming schema, which wins
+// over the configuration and the defaults.
+var settingsMergeOrder = []settingLayer{settingLayerSettings, settingLayerSchema, settingLayerConfiguration, settingLayerDefault}
+
+// configurationOverridesSchemaOrder is the merge order of settings whose
+// configuration value overrides all naming schemas, e.g. the provider
+// hash_length or the prefixes of standesamt_config over the default prefixes of
+// a resource type.
+var configurationOverridesSchemaOrder = []settingLayer{settingLayerSettings, settingLayerConfiguration, settingLayerSchema, settingLayerDefault}
+
+// settingMergeOrders are the merge orders of the settings that do not follow
+// settingsMergeOrder. Keep it in sync with the documentation of the settings.
+var settingMergeOrders = map[string][]settingLayer{
+	"hash_length": configurationOverridesSchemaOrder,
+	"prefixes":    configurationOverridesSchemaOrder,
+	"suffixes":    configurationOverridesSchemaOrder,
+}
+
+// layerValue is the value of a setting in one layer. Values that are not set
+// do not take part in the merge.
+type layerValue[T any] struct {
+	layer settingLayer
+	value T
+	set   bool
+}
+
+// inLayer returns the value of a setting in a layer, set reports whether the
+// layer defines the setting.
+func inLayer[T any](layer settingLayer, value T, set bool) layerValue[T] {
+	return layerValue[T]{layer: layer, value: value, set: set}
+}
+
+// mergeSetting returns the value of the setting key from the layer with the
+// highest precedence that sets it, together with that layer. The zero value
+// and the default layer are returned if no layer sets the setting.
+func mergeSetting[T any](key string, values ...layerValue[T]) (T, settingLayer) {
+	order, ok := settingMergeOrders[key]
+	if !ok {
+		order = settingsMergeOrder
+	}
+	for _, layer := range order {
+		for _, v := range values {
+			if v.layer == layer && v.set {
+				return v.value, layer
+			}
+		}
+	}
+	var zero T
+	return zero, settingLayerDefault
+}
+
+// mergeBuilderSetting is mergeSetting recording the layer of the result in the
+// sources of the name builder.
+func mergeBuilderSetting[T any](nb *nameBuilder, key string, values ...layerValue[T]) T {
+	value, layer := mergeSetting(key, values...)
+	if nb.sources == nil {
+		nb.sources = map[string]settingLayer{}
+	}
+	nb.sources[key] = layer
+	return value
+}
@@ -0,0 +1,97 @@
+// Copyright glueckkanja AG 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	s "terraform-provider-standesamt/internal/schema"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMergeSetting(t *testing.T) {
+	values := []layerValue[string]{
+		inLayer(settingLayerConfiguration, "configuration", true),
+		inLayer(settingLayerSchema, "schema", true),
+		inLayer(settingLayerSettings, "settings", false),
+	}
+
+	value, layer := mergeSetting("separator", values...)
+	assert.Equal(t, "schema", value)
+	assert.Equal(t, settingLayerSchema, layer)
+
+	// The configuration overrides the schema for hash_length.
+	value, layer = mergeSetting("hash_length", values...)
+	assert.Equal(t, "configuration", value)
+	assert.Equal(t, settingLayerConfiguration, layer)
+
+	values[2].set = true
+	value, layer = mergeSetting("hash_length", values...)
+	assert.Equal(t, "settings", value)
+	assert.Equal(t, settingLayerSettings, layer)
+
+	value, layer = mergeSetting("separator", inLayer(settingLayerSettings, "x", false))
+	assert.Equal(t, "", value)
+	assert.Equal(t, settingLayerDefault, layer)
+}
+
+func TestNameBuilderSources(t *testing.T) {
+	tests := []struct {
+		name     string
+		settings *s.BuildNameSettingsModel
+		want     map[string]settingLayer
+	}{
+		{
+			name:     "configuration and schema",
+			settings: &s.BuildNameSettingsModel{},
+			want: map[string]settingLayer{
+				"name_precedence": settingLayerSchema,
+				"location":        settingLayerConfiguration,
+				"environment":     settingLayerSchema,
+				"separator":       settingLayerConfiguration,
+				"prefixes":        settingLayerConfiguration,
+				"suffixes":        settingLayerConfiguration,
+				"hash_length":     settingLayerSchema,
+				"random_seed":     settingLayerConfiguration,
+			},
+		},
+		{
+			name: "settings",
+			settings: &s.BuildNameSettingsModel{
+				NamePrecedence: []string{"abbreviation", "name", "location", "hash"},
+				Location:       "westeurope",
+				Environment:    "prd",
+				Separator:      "_",
+				Prefixes:       []string{"p"},
+				Suffixes:       []string{"s"},
+				HashLength:     2,
+				RandomSeed:     1,
+			},
+			want: map[string]settingLayer{
+				"name_precedence": settingLayerSettings,
+				"location":        settingLayerSettings,
+				"environment":     settingLayerSettings,
+				"separator":       settingLayerSettings,
+				"prefixes":        settingLayerSettings,
+				"suffixes":        settingLayerSettings,
+				"hash_length":     settingLayerSettings,
+				"random_seed":     settingLayerSettings,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			nb := makeTestBuilderForBudget(s.DefaultNamePrecedence[:], tt.settings)
+			nb.typeSchema.Configuration.UseEnvironment = types.BoolValue(false)
+			resp := &function.RunResponse{}
+			nb.buildName(types.StringValue("app"), resp)
+			assert.Nil(t, resp.Error)
+			assert.Equal(t, tt.want, nb.sources)
+		})
+	}
+}
@@ -0,0 +1,124 @@
+// Copyright glueckkanja AG 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-standesamt/internal/tools"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = &SlugFunction{}
+
+type SlugFunction struct{}
+
+func NewSlugFunction() function.Function {
+	return &SlugFunction{}
+}
+
+func (f *SlugFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "slug"
+}
+
+func (f *SlugFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Normalize an arbitrary string into a naming-safe slug",
+		Description: "Normalize an arbitrary string, e.g. a human readable project name, into a slug that only contains ASCII letters, digits and the separator.",
+		MarkdownDescription: "Normalize an arbitrary string, e.g. a human readable project name, into a slug that only contains ASCII " +
+			"letters, digits and the separator. Letters are transliterated (`Café` becomes `cafe`, `Straße` becomes `strasse`), every run of other characters " +
+			"is collapsed into a single separator and leading or trailing separators are removed. The result can be used as " +
+			"the `name` argument of the `name` function.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "input",
+				Description: "The string to normalize.",
+			},
+			function.DynamicParameter{
+				Name: "settings",
+				MarkdownDescription: "An optional map of settings. All keys are optional.\n\n" +
+					"Supported keys:\n\n" +
+					"| Key | Type | Description |\n" +
+					"|---|---|---|\n" +
+					"| `separator` | `string` | Replacement for runs of invalid characters. Default `-`. |\n" +
+					"| `max_length` | `number` | Maximum length of the slug (0 = unlimited). Default `0`. |\n" +
+					"| `lowercase` | `bool` | Convert the slug to lowercase. Default `true`. |\n" +
+					"| `transliterate` | `string` | `ascii` (default) strips diacritics and replaces letters like `ß` with `ss`, `de` additionally writes umlauts as `ae`, `oe` and `ue`. |\n\n" +
+					"Unknown keys and values of the wrong type are rejected. Pass `{}` or `null` to use the defaults.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *SlugFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var (
+		input           string
+		settingsDynamic types.Dynamic
+	)
+
+	if resp.Error = req.Arguments.Get(ctx, &input, &settingsDynamic); resp.Error != nil {
+		return
+	}
+
+	opts, err := parseSlugSettings(settingsDynamic)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(1, fmt.Sprintf("Invalid settings: %s", err.Error()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, tools.Slugify(input, opts)))
+}
+
+// slugSettingsKeys are the settings keys of the slug function.
+var slugSettingsKeys = map[string]settingKind{
+	"separator":     settingKindString,
+	"max_length":    settingKindNumber,
+	"lowercase":     settingKindBool,
+	"transliterate": settingKindString,
+}
+
+// parseSlugSettings extracts the slug options from the dynamic settings parameter.
+func parseSlugSettings(settingsDynamic types.Dynamic) (tools.SlugOptions, error) {
+	opts := tools.SlugOptions{
+		Separator: "-",
+		Lowercase: true,
+	}
+
+	attrs, err := settingsAttributes(settingsDynamic, slugSettingsKeys)
+	if err != nil {
+		return opts, err
+	}
+
+	if v, ok := attrs["separator"].(types.String); ok && !v.IsNull() && !v.IsUnknown() {
+		opts.Separator = v.ValueString()
+	}
+
+	if v, ok := attrs["lowercase"].(types.Bool); ok && !v.IsNull() && !v.IsUnknown() {
+		opts.Lowercase = v.ValueBool()
+	}
+
+	if v, ok := attrs["transliterate"].(types.String); ok && !v.IsNull() && !v.IsUnknown() {
+		opts.Transliterate = v.ValueString()
+	}
+	if err := validateTransliterate(opts.Transliterate); err != nil {
+		return opts, err
+	}
+
+	// Handle max_length - can be types.Int64 or types.Number
+	if v, ok := attrs["max_length"].(types.Int64); ok && !v.IsNull() && !v.IsUnknown() {
+		opts.MaxLength = int(v.ValueInt64())
+	} else if v, ok := attrs["max_length"].(types.Number); ok && !v.IsNull() && !v.IsUnknown() {
+		val, _ := v.ValueBigFloat().Int64()
+		opts.MaxLength = int(val)
+	}
+
+	if opts.MaxLength < 0 {
+		return opts, fmt.Errorf("max_length must not be negative")
+	}
+
+	return opts, nil
+}
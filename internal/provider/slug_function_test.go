@@ -0,0 +1,59 @@
+// Copyright glueckkanja AG 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+)
+
+func TestSlugFunction_Defaults(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactoriesUnique(),
+		Steps: []resource.TestStep{
+			{
+				Config: `output "test" {
+					value = provider::standesamt::slug("Café München / Platform", {})
+				}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("test", knownvalue.StringExact("cafe-munchen-platform")),
+				},
+			},
+		},
+	})
+}
+
+func TestSlugFunction_Settings(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactoriesUnique(),
+		Steps: []resource.TestStep{
+			{
+				Config: `output "test" {
+					value = provider::standesamt::slug("Data Lake (Prod)", { separator = "_", max_length = 12, lowercase = false })
+				}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("test", knownvalue.StringExact("Data_Lake_Pr")),
+				},
+			},
+		},
+	})
+}
+
+func TestSlugFunction_NegativeMaxLength(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactoriesUnique(),
+		Steps: []resource.TestStep{
+			{
+				Config: `output "test" {
+					value = provider::standesamt::slug("test", { max_length = -1 })
+				}`,
+				ExpectError: regexp.MustCompile(`max_length must not be negative`),
+			},
+		},
+	})
+}
@@ -0,0 +1,159 @@
+// Copyright glueckkanja AG 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// tagsHashLength is the length of the hashes in the tags, short enough to read
+// in the portal and long enough to tell configurations apart.
+const tagsHashLength = 12
+
+var _ function.Function = &TagsFunction{}
+
+type TagsFunction struct{}
+
+func NewTagsFunction() function.Function {
+	return &TagsFunction{}
+}
+
+func (f *TagsFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "tags"
+}
+
+func (f *TagsFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Return a tag map describing the naming inputs",
+		Description: "Return a map of tags with the environment, location, convention, naming schema hash and configuration hash the names of a configuration are built with.",
+		MarkdownDescription: "Return a map of tags with the inputs the names of a configuration are built with, so resources carry " +
+			"metadata about how they were named:\n\n" +
+			"| Tag | Description |\n" +
+			"|---|---|\n" +
+			"| `environment` | The environment. Left out if empty. |\n" +
+			"| `location` | The location. Left out if empty. |\n" +
+			"| `naming-convention` | The convention, e.g. `default`. |\n" +
+			"| `naming-schema-hash` | A hash of the naming schemas in `schema`. It changes with every change of the schema library that reaches the configuration and with the `resource_types` of `standesamt_config`. |\n" +
+			"| `naming-config-hash` | A hash of the whole configurations object, i.e. of the document rendered by `config_export`. It differs from the `configuration_fingerprint` of `standesamt_config`, which also covers the schema reference and all naming schemas of the library. |\n\n" +
+			"Merge the result with the tags of a resource, e.g. `tags = merge(var.tags, provider::standesamt::tags(local.config, {}))`.",
+		Parameters: []function.Parameter{
+			configurationsParameter(),
+			function.DynamicParameter{
+				Name: "settings",
+				MarkdownDescription: "An optional map of settings. All keys are optional.\n\n" +
+					"Supported keys:\n\n" +
+					"| Key | Type | Description |\n" +
+					"|---|---|---|\n" +
+					"| `environment` | `string` | Overrides the environment of the configuration, like the `environment` setting of `name`. |\n" +
+					"| `location` | `string` | Overrides the location of the configuration, like the `location` setting of `name`. |\n" +
+					"| `key_prefix` | `string` | Prefix of all tag keys, e.g. `naming:`. Default empty. |\n\n" +
+					"Unknown keys and values of the wrong type are rejected. Pass `{}` or `null` to use the defaults.",
+			},
+		},
+		Return: function.MapReturn{ElementType: types.StringType},
+	}
+}
+
+// tagsSettingsKeys are the settings keys of the tags function.
+var tagsSettingsKeys = map[string]settingKind{
+	"environment": settingKindString,
+	"location":    settingKindString,
+	"key_prefix":  settingKindString,
+}
+
+func (f *TagsFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var (
+		configurationsDynamic types.Dynamic
+		settingsDynamic       types.Dynamic
+	)
+
+	if resp.Error = req.Arguments.Get(ctx, &configurationsDynamic, &settingsDynamic); resp.Error != nil {
+		return
+	}
+
+	configurations, err := configurationsArgument(ctx, configurationsDynamic)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	if !isWhollyKnown(ctx, configurations) {
+		// The result is left unknown until the configuration is known.
+		return
+	}
+
+	attrs, err := settingsAttributes(settingsDynamic, tagsSettingsKeys)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(1, fmt.Sprintf("Invalid settings: %s", err.Error()))
+		return
+	}
+	settings := map[string]string{}
+	for k, v := range attrs {
+		if v, ok := v.(types.String); ok && !v.IsNull() && !v.IsUnknown() {
+			settings[k] = v.ValueString()
+		}
+	}
+
+	model := configurationsModel{}
+	diags := configurations.As(ctx, &model, basetypes.ObjectAsOptions{})
+	if resp.Error = function.FuncErrorFromDiags(ctx, diags); resp.Error != nil {
+		return
+	}
+
+	tags, err := namingTags(ctx, &model, settings)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, tags))
+}
+
+// namingTags returns the tags of the tags function for the configurations and
+// the string settings.
+func namingTags(ctx context.Context, model *configurationsModel, settings map[string]string) (map[string]string, error) {
+	document, err := newConfigExportDocument(ctx, model)
+	if err != nil {
+		return nil, err
+	}
+	schemaData, err := json.Marshal(document.Schema)
+	if err != nil {
+		return nil, fmt.Errorf("failed to hash naming schemas: %w", err)
+	}
+	documentData, err := json.Marshal(document)
+	if err != nil {
+		return nil, fmt.Errorf("failed to hash configuration: %w", err)
+	}
+
+	prefix := settings["key_prefix"]
+	tags := map[string]string{
+		prefix + "naming-convention":  model.Configuration.Convention.ValueString(),
+		prefix + "naming-schema-hash": hashStr(string(schemaData))[:tagsHashLength],
+		prefix + "naming-config-hash": hashStr(string(documentData))[:tagsHashLength],
+	}
+
+	environment, ok := settings["environment"]
+	if !ok {
+		environment = model.Configuration.Environment.ValueString()
+	}
+	if environment != "" {
+		tags[prefix+"environment"] = environment
+	}
+
+	location, ok := settings["location"]
+	if !ok {
+		location = model.Configuration.Location.ValueString()
+	}
+	if location != "" {
+		tags[prefix+"location"] = location
+	}
+
+	return tags, nil
+}
@@ -0,0 +1,73 @@
+// Copyright glueckkanja AG 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTagsFunction_Defaults(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactoriesUnique(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf("%s %s", default_config_with_no_settings_default_precedence, `output "test" {
+					value = provider::standesamt::tags(local.config, { environment = "prd" })
+				}`),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("test", knownvalue.MapPartial(map[string]knownvalue.Check{
+						"environment":        knownvalue.StringExact("prd"),
+						"location":           knownvalue.StringExact("westeurope"),
+						"naming-convention":  knownvalue.StringExact("default"),
+						"naming-schema-hash": knownvalue.StringRegexp(regexp.MustCompile(`^[0-9a-f]{12}$`)),
+						"naming-config-hash": knownvalue.StringRegexp(regexp.MustCompile(`^[0-9a-f]{12}$`)),
+					})),
+				},
+			},
+		},
+	})
+}
+
+func TestTagsFunction_InvalidSettings(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactoriesUnique(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf("%s %s", default_config_with_no_settings_default_precedence, `output "test" {
+					value = provider::standesamt::tags(local.config, { hash_length = 4 })
+				}`),
+				ExpectError: regexp.MustCompile(`Invalid settings`),
+			},
+		},
+	})
+}
+
+func TestNamingTags(t *testing.T) {
+	ctx := context.Background()
+	model := benchmarkConfigurations(t)
+
+	tags, err := namingTags(ctx, model, map[string]string{"key_prefix": "naming:"})
+	assert.NoError(t, err)
+	assert.Equal(t, "prd", tags["naming:environment"])
+	assert.Equal(t, "westeurope", tags["naming:location"])
+	assert.Len(t, tags["naming:naming-schema-hash"], tagsHashLength)
+
+	// The configuration hash follows the configuration, the schema hash only the schemas.
+	model.Configuration.Environment = types.StringValue("tst")
+	changed, err := namingTags(ctx, model, map[string]string{"location": ""})
+	assert.NoError(t, err)
+	assert.Equal(t, tags["naming:naming-schema-hash"], changed["naming-schema-hash"])
+	assert.NotEqual(t, tags["naming:naming-config-hash"], changed["naming-config-hash"])
+	assert.Equal(t, "tst", changed["environment"])
+	assert.NotContains(t, changed, "location")
+}
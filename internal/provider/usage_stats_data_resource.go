@@ -0,0 +1,146 @@
+// Copyright glueckkanja AG 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"maps"
+	"sync"
+)
+
+// usageStats counts the names built per resource type by the name resources of
+// a provider configuration. Counting is off until the configuration enables it
+// with usage_stats. Provider functions run without the provider configuration,
+// so names built by them are not counted. The counts never leave the process.
+type usageStats struct {
+	mu      sync.Mutex
+	enabled bool
+	counts  map[string]int64
+}
+
+func (u *usageStats) enable() {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	u.enabled = true
+}
+
+// record counts a built name of the resource type if counting is enabled.
+func (u *usageStats) record(nameType string) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	if !u.enabled {
+		return
+	}
+	if u.counts == nil {
+		u.counts = map[string]int64{}
+	}
+	u.counts[nameType]++
+}
+
+// snapshot returns whether counting is enabled and a copy of the counts.
+func (u *usageStats) snapshot() (bool, map[string]int64) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	return u.enabled, maps.Clone(u.counts)
+}
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &UsageStatsDataSource{}
+
+type usageStatsDataSourceModel struct {
+	Enabled types.Bool  `tfsdk:"enabled"`
+	Names   types.Map   `tfsdk:"names"`
+	Total   types.Int64 `tfsdk:"total"`
+}
+
+func NewUsageStatsDataSource() datasource.DataSource {
+	return &UsageStatsDataSource{}
+}
+
+// UsageStatsDataSource defines the data source implementation.
+type UsageStatsDataSource struct {
+	providerConfig *ProviderConfig
+}
+
+func (d *UsageStatsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_usage_stats"
+}
+
+func (d *UsageStatsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Data source to read how many names the standesamt_name and standesamt_unique_name resources built per resource type. Requires usage_stats = true in the provider configuration.",
+		MarkdownDescription: "Data source to read how many names the `standesamt_name` and `standesamt_unique_name` resources built per resource type. Requires `usage_stats = true` in the provider configuration. Provider functions run without the provider configuration, so names built by `name` and the other functions are not counted. The counts are kept in the provider process only and are not sent anywhere. They cover the names planned before the data source is read, so let the data source depend on the name resources.",
+		Attributes: map[string]schema.Attribute{
+			"enabled": schema.BoolAttribute{
+				Computed:            true,
+				Description:         "True if usage_stats is enabled in the provider configuration.",
+				MarkdownDescription: "True if `usage_stats` is enabled in the provider configuration.",
+			},
+			"names": schema.MapAttribute{
+				Computed:            true,
+				ElementType:         types.Int64Type,
+				Description:         "The number of names built per resource type.",
+				MarkdownDescription: "The number of names built per resource type.",
+			},
+			"total": schema.Int64Attribute{
+				Computed:            true,
+				Description:         "The number of names built for all resource types.",
+				MarkdownDescription: "The number of names built for all resource types.",
+			},
+		},
+	}
+}
+
+func (d *UsageStatsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*ProviderConfig)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *ProviderConfig, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.providerConfig = data
+}
+
+func (d *UsageStatsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var model usageStatsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	enabled, counts := d.providerConfig.usageStats.snapshot()
+
+	var total int64
+	elements := make(map[string]attr.Value, len(counts))
+	for k, v := range counts {
+		elements[k] = types.Int64Value(v)
+		total += v
+	}
+
+	names, diags := types.MapValue(types.Int64Type, elements)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	model.Enabled = types.BoolValue(enabled)
+	model.Names = names
+	model.Total = types.Int64Value(total)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
@@ -0,0 +1,32 @@
+// Copyright glueckkanja AG 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUsageStats(t *testing.T) {
+	stats := &usageStats{}
+
+	stats.record("azurerm_resource_group")
+	enabled, counts := stats.snapshot()
+	assert.False(t, enabled)
+	assert.Empty(t, counts)
+
+	stats.enable()
+	stats.record("azurerm_resource_group")
+	stats.record("azurerm_resource_group")
+	stats.record("azurerm_storage_account")
+	enabled, counts = stats.snapshot()
+	assert.True(t, enabled)
+	assert.Equal(t, map[string]int64{"azurerm_resource_group": 2, "azurerm_storage_account": 1}, counts)
+
+	// The snapshot is a copy.
+	counts["azurerm_key_vault"] = 1
+	_, counts = stats.snapshot()
+	assert.NotContains(t, counts, "azurerm_key_vault")
+}
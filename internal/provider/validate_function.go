@@ -5,7 +5,8 @@ package provider
 
 import (
 	"context"
-	s "terraform-provider-standesamt/internal/schema"
+	"fmt"
+	"slices"
 	"terraform-provider-standesamt/internal/tools"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
@@ -13,6 +14,13 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
+// Modes of the validate function. In built mode the name is built like in the
+// name function before it is validated, in raw mode it is validated as is.
+const (
+	validateModeBuilt = "built"
+	validateModeRaw   = "raw"
+)
+
 var _ function.Function = &ValidateFunction{}
 
 type ValidateFunction struct{}
@@ -27,57 +35,13 @@ func (f *ValidateFunction) Metadata(_ context.Context, _ function.MetadataReques
 
 func (f *ValidateFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
 	resp.Definition = function.Definition{
-		Summary:             "Validate a resource name and return detailed validation results",
-		Description:         "Build a resource name based on the provided configuration and name type, then return detailed validation results as a map.",
-		MarkdownDescription: "Build a resource name based on the provided configuration and name type, then return detailed validation results as a map containing regex validation, length validation, and resource type information.",
-		Parameters: []function.Parameter{
-			function.ObjectParameter{
-				Name:                "configurations",
-				MarkdownDescription: "A configuration object that contains the variables and formats to use for the name.",
-				AttributeTypes: map[string]attr.Type{
-					"configuration": types.ObjectType{
-						AttrTypes: configurationTypeAttributes(),
-					},
-					"locations": types.MapType{
-						ElemType: types.StringType,
-					},
-					"schema": types.MapType{
-						ElemType: types.ObjectType{
-							AttrTypes: s.SchemaTypeAttributes(),
-						},
-					},
-				},
-				Description: "Configuration for the naming object",
-			},
-			function.StringParameter{
-				Name:        "name_type",
-				Description: "The resource type to use for the name.",
-			},
-			function.DynamicParameter{
-				Name: "settings",
-				MarkdownDescription: "An optional map of per-call overrides. All keys are optional and take " +
-					"precedence over the provider-level configuration.\n\n" +
-					"Supported keys:\n\n" +
-					"| Key | Type | Description |\n" +
-					"|---|---|---|\n" +
-					"| `convention` | `string` | Naming convention (`default` or `passthrough`). |\n" +
-					"| `environment` | `string` | Environment abbreviation (e.g. `prd`, `tst`). |\n" +
-					"| `location` | `string` | Azure location key resolved via the `locations` map. |\n" +
-					"| `separator` | `string` | Separator between name parts — overrides the schema default on a per-call basis. |\n" +
-					"| `prefixes` | `list(string)` | Prefix segments to prepend. |\n" +
-					"| `suffixes` | `list(string)` | Suffix segments to append. |\n" +
-					"| `name_precedence` | `list(string)` | Order of name segments. |\n" +
-					"| `hash_length` | `number` | Length of the random hash segment (0 = disabled). |\n" +
-					"| `random_seed` | `number` | Seed for the hash generator (for reproducible names). |\n" +
-					"| `lowercase` | `bool` | Convert the final name to lowercase. |\n" +
-					"| `uppercase` | `bool` | Convert the final name to uppercase. |\n\n" +
-					"Pass `{}` or `null` to use provider defaults for all settings.",
-			},
-			function.StringParameter{
-				Name:        "name",
-				Description: "Name to parse",
-			},
-		},
+		Summary:     "Validate a resource name and return detailed validation results",
+		Description: "Build a resource name based on the provided configuration and name type, then return detailed validation results as a map.",
+		MarkdownDescription: "Build a resource name based on the provided configuration and name type, then return detailed validation results as a map containing regex validation, length validation, and resource type information. `scope` is the uniqueness scope of the resource type (`global`, `resourceGroup`, `parent`) or empty if the schema library does not define it, e.g. to decide whether a hash is required. " +
+			"`unique_suffix` reports how many characters of the hash the name keeps (`is`) against the `min_unique_suffix` setting (`min`); in raw mode the name is checked against the hash it would be built with. " +
+			"Pass `\"raw\"` as the optional `mode` argument to validate the name as is without building it, e.g. the name of an existing resource to import.",
+		Parameters:        validateParameters(),
+		VariadicParameter: validateModeParameter(),
 		Return: function.ObjectReturn{
 			AttributeTypes: map[string]attr.Type{
 				"regex": types.ObjectType{
@@ -94,33 +58,57 @@ func (f *ValidateFunction) Definition(_ context.Context, _ function.DefinitionRe
 						"min":   types.Int64Type,
 					},
 				},
-				"type":                  types.StringType,
-				"name":                  types.StringType,
-				"double_hyphens_denied": types.BoolType,
-				"double_hyphens_found":  types.BoolType,
+				"type":                      types.StringType,
+				"scope":                     types.StringType,
+				"name":                      types.StringType,
+				"double_hyphens_denied":     types.BoolType,
+				"double_hyphens_found":      types.BoolType,
+				"repeated_separator_denied": types.BoolType,
+				"repeated_separator_found":  types.BoolType,
+				"leading": types.ObjectType{
+					AttrTypes: map[string]attr.Type{
+						"valid": types.BoolType,
+						"deny":  types.StringType,
+					},
+				},
+				"trailing": types.ObjectType{
+					AttrTypes: map[string]attr.Type{
+						"valid": types.BoolType,
+						"deny":  types.StringType,
+					},
+				},
+				"unique_suffix": types.ObjectType{
+					AttrTypes: map[string]attr.Type{
+						"valid": types.BoolType,
+						"is":    types.Int64Type,
+						"min":   types.Int64Type,
+					},
+				},
+				"denied_patterns":      types.ListType{ElemType: types.StringType},
+				"reserved_words_found": types.ListType{ElemType: types.StringType},
 			},
 		},
 	}
 }
 
 func (f *ValidateFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
-	// Parse and validate input arguments
-	model, nameType, buildNameSettings, name, typeSchema, err := parseArguments(ctx, req, resp)
-	if err != nil || resp.Error != nil {
+	validation, nameType, scope, ok := runValidation(ctx, req, resp)
+	if !ok {
 		return
 	}
 
-	// Build the resource name using the nameBuilder
-	builder := newNameBuilder(ctx, model, typeSchema, buildNameSettings)
-	resultName := builder.buildName(name, resp)
-	if resp.Error != nil {
+	deniedPatterns, diags := types.ListValueFrom(ctx, types.StringType, validation.DeniedPatterns)
+
+	if diags.HasError() {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.FuncErrorFromDiags(ctx, diags))
 		return
 	}
 
-	resultNameStr := tools.GetBaseString(resultName)
-
-	// Perform validation and collect results
-	validation := validateName(resultNameStr, typeSchema)
+	reservedWords, diags := types.ListValueFrom(ctx, types.StringType, validation.ReservedWords)
+	if diags.HasError() {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.FuncErrorFromDiags(ctx, diags))
+		return
+	}
 
 	// Build the validation result map
 	regexObj, diags := types.ObjectValue(
@@ -129,7 +117,7 @@ func (f *ValidateFunction) Run(ctx context.Context, req function.RunRequest, res
 			"match": types.StringType,
 		},
 		map[string]attr.Value{
-			"valid": types.BoolValue(validation.RegexValid),
+			"valid": types.BoolValue(validation.RegexValid && validation.SettingsRegexValid),
 			"match": types.StringValue(validation.ValidationRegex),
 		},
 	)
@@ -157,6 +145,53 @@ func (f *ValidateFunction) Run(ctx context.Context, req function.RunRequest, res
 		return
 	}
 
+	leadingObj, diags := types.ObjectValue(
+		map[string]attr.Type{
+			"valid": types.BoolType,
+			"deny":  types.StringType,
+		},
+		map[string]attr.Value{
+			"valid": types.BoolValue(validation.LeadingValid),
+			"deny":  types.StringValue(validation.DenyLeading),
+		},
+	)
+	if diags.HasError() {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.FuncErrorFromDiags(ctx, diags))
+		return
+	}
+
+	trailingObj, diags := types.ObjectValue(
+		map[string]attr.Type{
+			"valid": types.BoolType,
+			"deny":  types.StringType,
+		},
+		map[string]attr.Value{
+			"valid": types.BoolValue(validation.TrailingValid),
+			"deny":  types.StringValue(validation.DenyTrailing),
+		},
+	)
+	if diags.HasError() {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.FuncErrorFromDiags(ctx, diags))
+		return
+	}
+
+	uniqueSuffixObj, diags := types.ObjectValue(
+		map[string]attr.Type{
+			"valid": types.BoolType,
+			"is":    types.Int64Type,
+			"min":   types.Int64Type,
+		},
+		map[string]attr.Value{
+			"valid": types.BoolValue(validation.UniqueSuffixValid),
+			"is":    types.Int64Value(validation.UniqueSuffixLength),
+			"min":   types.Int64Value(validation.MinUniqueSuffix),
+		},
+	)
+	if diags.HasError() {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.FuncErrorFromDiags(ctx, diags))
+		return
+	}
+
 	validationResult, diags := types.ObjectValue(
 		map[string]attr.Type{
 			"regex": types.ObjectType{
@@ -173,18 +208,50 @@ func (f *ValidateFunction) Run(ctx context.Context, req function.RunRequest, res
 					"min":   types.Int64Type,
 				},
 			},
-			"type":                  types.StringType,
-			"name":                  types.StringType,
-			"double_hyphens_denied": types.BoolType,
-			"double_hyphens_found":  types.BoolType,
+			"type":                      types.StringType,
+			"scope":                     types.StringType,
+			"name":                      types.StringType,
+			"double_hyphens_denied":     types.BoolType,
+			"double_hyphens_found":      types.BoolType,
+			"repeated_separator_denied": types.BoolType,
+			"repeated_separator_found":  types.BoolType,
+			"leading": types.ObjectType{
+				AttrTypes: map[string]attr.Type{
+					"valid": types.BoolType,
+					"deny":  types.StringType,
+				},
+			},
+			"trailing": types.ObjectType{
+				AttrTypes: map[string]attr.Type{
+					"valid": types.BoolType,
+					"deny":  types.StringType,
+				},
+			},
+			"unique_suffix": types.ObjectType{
+				AttrTypes: map[string]attr.Type{
+					"valid": types.BoolType,
+					"is":    types.Int64Type,
+					"min":   types.Int64Type,
+				},
+			},
+			"denied_patterns":      types.ListType{ElemType: types.StringType},
+			"reserved_words_found": types.ListType{ElemType: types.StringType},
 		},
 		map[string]attr.Value{
-			"regex":                 regexObj,
-			"length":                lengthObj,
-			"type":                  types.StringValue(nameType),
-			"name":                  types.StringValue(validation.Name),
-			"double_hyphens_denied": types.BoolValue(validation.DenyDoubleHyphens),
-			"double_hyphens_found":  types.BoolValue(validation.DoubleHyphensFound),
+			"regex":                     regexObj,
+			"length":                    lengthObj,
+			"type":                      types.StringValue(nameType),
+			"scope":                     types.StringValue(scope),
+			"name":                      types.StringValue(validation.Name),
+			"double_hyphens_denied":     types.BoolValue(validation.DenyDoubleHyphens),
+			"double_hyphens_found":      types.BoolValue(validation.DoubleHyphensFound),
+			"repeated_separator_denied": types.BoolValue(validation.DenyRepeatedSeparator),
+			"repeated_separator_found":  types.BoolValue(validation.RepeatedSeparatorFound),
+			"leading":                   leadingObj,
+			"trailing":                  trailingObj,
+			"unique_suffix":             uniqueSuffixObj,
+			"denied_patterns":           deniedPatterns,
+			"reserved_words_found":      reservedWords,
 		},
 	)
 	if diags.HasError() {
@@ -194,3 +261,120 @@ func (f *ValidateFunction) Run(ctx context.Context, req function.RunRequest, res
 
 	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, validationResult))
 }
+
+// validateParameters returns the parameters of the validate functions.
+func validateParameters() []function.Parameter {
+	return []function.Parameter{
+		configurationsParameter(),
+		function.StringParameter{
+			Name:        "name_type",
+			Description: "The resource type to use for the name.",
+		},
+		settingsParameter(),
+		function.StringParameter{
+			Name:               "name",
+			Description:        "Name to parse",
+			AllowUnknownValues: true,
+		},
+	}
+}
+
+// validateModeParameter returns the optional mode parameter of the validate
+// functions.
+func validateModeParameter() function.StringParameter {
+	return function.StringParameter{
+		Name:                "mode",
+		Description:         "Optional validation mode: 'built' (default) builds the name before validating it, 'raw' validates the name as is.",
+		MarkdownDescription: "Optional validation mode: `built` (default) builds the name before validating it, `raw` validates the name as is.",
+	}
+}
+
+// runValidation builds or takes the name of the arguments of a validate
+// function and validates it. It returns the validation result, the resource
+// type and its scope, false if the result is left unknown or resp holds an
+// error.
+func runValidation(ctx context.Context, req function.RunRequest, resp *function.RunResponse) (*validationResult, string, string, bool) {
+	// Parse and validate input arguments
+	model, nameType, buildNameSettings, name, typeSchema, err := parseArguments(ctx, req, resp)
+	if err != nil || resp.Error != nil {
+		return nil, "", "", false
+	}
+
+	mode, err := validateMode(ctx, req)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(4, err.Error()))
+		return nil, "", "", false
+	}
+
+	builder := newNameBuilder(ctx, model, typeSchema, buildNameSettings)
+	validation := builder.validate(name, nameType, mode, resp)
+	if resp.Error != nil {
+		return nil, "", "", false
+	}
+
+	return validation, nameType, typeSchema.Scope.ValueString(), true
+}
+
+// validate builds the name in the built mode or takes it as is in the raw mode
+// and validates it against the naming schema and the settings of nb. Errors are
+// reported in resp.
+func (nb *nameBuilder) validate(name types.String, nameType, mode string, resp *function.RunResponse) *validationResult {
+	settings := nb.buildNameSettings
+	resultNameStr := tools.GetBaseString(name)
+	if mode == validateModeBuilt {
+		resultName := nb.buildName(name, resp)
+		if resp.Error != nil {
+			return nil
+		}
+
+		resultNameStr = tools.GetBaseString(resultName)
+	} else {
+		// A raw name is checked against the separator it would be built with.
+		nb.resolveSeparator()
+	}
+
+	// Perform validation and collect results
+	validation, err := validateName(resultNameStr, nb.typeSchema, extractStringSlice(nb.model.Configuration.DenyPatterns), nb.result.Separator.ValueString())
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return nil
+	}
+	if settings.ReservedWordsCheck {
+		validation.checkReservedWords(nameType)
+	}
+	if err := validation.checkSettingsRegex(settings.ValidationRegex); err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(2, err.Error()))
+		return nil
+	}
+
+	// A raw name is checked against the hash it would be built with.
+	hash := nb.hashSegment()
+	if mode == validateModeRaw {
+		hash, err = nb.expectedHash()
+		if err != nil && settings.MinUniqueSuffix > 0 {
+			resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(2, err.Error()))
+			return nil
+		}
+	}
+	validation.checkUniqueSuffix(hash, settings.MinUniqueSuffix)
+
+	return validation
+}
+
+// validateMode returns the optional mode argument of the validate function.
+func validateMode(ctx context.Context, req function.RunRequest) (string, error) {
+	var modes []string
+	if err := req.Arguments.GetArgument(ctx, 4, &modes); err != nil {
+		return "", fmt.Errorf("failed to get mode: %s", err.Error())
+	}
+
+	switch {
+	case len(modes) == 0:
+		return validateModeBuilt, nil
+	case len(modes) > 1:
+		return "", fmt.Errorf("expected at most one mode, got %d", len(modes))
+	case !slices.Contains([]string{validateModeBuilt, validateModeRaw}, modes[0]):
+		return "", fmt.Errorf("invalid mode '%s', expected one of: %s, %s", modes[0], validateModeBuilt, validateModeRaw)
+	}
+	return modes[0], nil
+}
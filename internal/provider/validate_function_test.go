@@ -51,8 +51,9 @@ func TestValidateFunction_ValidName(t *testing.T) {
 				}`),
 				ConfigStateChecks: []statecheck.StateCheck{
 					statecheck.ExpectKnownOutputValue("test", knownvalue.ObjectExact(map[string]knownvalue.Check{
-						"name": knownvalue.StringExact("rg-test-we"),
-						"type": knownvalue.StringExact("azurerm_resource_group"),
+						"name":  knownvalue.StringExact("rg-test-we"),
+						"type":  knownvalue.StringExact("azurerm_resource_group"),
+						"scope": knownvalue.StringExact(""),
 						"regex": knownvalue.ObjectExact(map[string]knownvalue.Check{
 							"valid": knownvalue.Bool(true),
 							"match": knownvalue.StringExact("^[a-zA-Z0-9-._()]{0,89}[a-zA-Z0-9-_()]$"),
@@ -63,8 +64,25 @@ func TestValidateFunction_ValidName(t *testing.T) {
 							"max":   knownvalue.Int64Exact(20),
 							"min":   knownvalue.Int64Exact(8),
 						}),
-						"double_hyphens_denied": knownvalue.Bool(true),
-						"double_hyphens_found":  knownvalue.Bool(false),
+						"double_hyphens_denied":     knownvalue.Bool(true),
+						"double_hyphens_found":      knownvalue.Bool(false),
+						"repeated_separator_denied": knownvalue.Bool(false),
+						"repeated_separator_found":  knownvalue.Bool(false),
+						"leading": knownvalue.ObjectExact(map[string]knownvalue.Check{
+							"valid": knownvalue.Bool(true),
+							"deny":  knownvalue.StringExact(""),
+						}),
+						"trailing": knownvalue.ObjectExact(map[string]knownvalue.Check{
+							"valid": knownvalue.Bool(true),
+							"deny":  knownvalue.StringExact(""),
+						}),
+						"unique_suffix": knownvalue.ObjectExact(map[string]knownvalue.Check{
+							"valid": knownvalue.Bool(true),
+							"is":    knownvalue.Int64Exact(0),
+							"min":   knownvalue.Int64Exact(0),
+						}),
+						"denied_patterns":      knownvalue.ListExact([]knownvalue.Check{}),
+						"reserved_words_found": knownvalue.ListExact([]knownvalue.Check{}),
 					})),
 				},
 			},
@@ -82,8 +100,9 @@ func TestValidateFunction_MaxLength(t *testing.T) {
 				}`),
 				ConfigStateChecks: []statecheck.StateCheck{
 					statecheck.ExpectKnownOutputValue("test", knownvalue.ObjectExact(map[string]knownvalue.Check{
-						"name": knownvalue.StringExact("rg-12345678901234567890-we"),
-						"type": knownvalue.StringExact("azurerm_resource_group"),
+						"name":  knownvalue.StringExact("rg-12345678901234567890-we"),
+						"type":  knownvalue.StringExact("azurerm_resource_group"),
+						"scope": knownvalue.StringExact(""),
 						"regex": knownvalue.ObjectExact(map[string]knownvalue.Check{
 							"valid": knownvalue.Bool(true),
 							"match": knownvalue.StringExact("^[a-zA-Z0-9-._()]{0,89}[a-zA-Z0-9-_()]$"),
@@ -94,8 +113,25 @@ func TestValidateFunction_MaxLength(t *testing.T) {
 							"max":   knownvalue.Int64Exact(20),
 							"min":   knownvalue.Int64Exact(8),
 						}),
-						"double_hyphens_denied": knownvalue.Bool(true),
-						"double_hyphens_found":  knownvalue.Bool(false),
+						"double_hyphens_denied":     knownvalue.Bool(true),
+						"double_hyphens_found":      knownvalue.Bool(false),
+						"repeated_separator_denied": knownvalue.Bool(false),
+						"repeated_separator_found":  knownvalue.Bool(false),
+						"leading": knownvalue.ObjectExact(map[string]knownvalue.Check{
+							"valid": knownvalue.Bool(true),
+							"deny":  knownvalue.StringExact(""),
+						}),
+						"trailing": knownvalue.ObjectExact(map[string]knownvalue.Check{
+							"valid": knownvalue.Bool(true),
+							"deny":  knownvalue.StringExact(""),
+						}),
+						"unique_suffix": knownvalue.ObjectExact(map[string]knownvalue.Check{
+							"valid": knownvalue.Bool(true),
+							"is":    knownvalue.Int64Exact(0),
+							"min":   knownvalue.Int64Exact(0),
+						}),
+						"denied_patterns":      knownvalue.ListExact([]knownvalue.Check{}),
+						"reserved_words_found": knownvalue.ListExact([]knownvalue.Check{}),
 					})),
 				},
 			},
@@ -113,8 +149,9 @@ func TestValidateFunction_MinLength(t *testing.T) {
 				}`),
 				ConfigStateChecks: []statecheck.StateCheck{
 					statecheck.ExpectKnownOutputValue("test", knownvalue.ObjectExact(map[string]knownvalue.Check{
-						"name": knownvalue.StringExact("rg-t-we"),
-						"type": knownvalue.StringExact("azurerm_resource_group"),
+						"name":  knownvalue.StringExact("rg-t-we"),
+						"type":  knownvalue.StringExact("azurerm_resource_group"),
+						"scope": knownvalue.StringExact(""),
 						"regex": knownvalue.ObjectExact(map[string]knownvalue.Check{
 							"valid": knownvalue.Bool(true),
 							"match": knownvalue.StringExact("^[a-zA-Z0-9-._()]{0,89}[a-zA-Z0-9-_()]$"),
@@ -125,8 +162,25 @@ func TestValidateFunction_MinLength(t *testing.T) {
 							"max":   knownvalue.Int64Exact(20),
 							"min":   knownvalue.Int64Exact(8),
 						}),
-						"double_hyphens_denied": knownvalue.Bool(true),
-						"double_hyphens_found":  knownvalue.Bool(false),
+						"double_hyphens_denied":     knownvalue.Bool(true),
+						"double_hyphens_found":      knownvalue.Bool(false),
+						"repeated_separator_denied": knownvalue.Bool(false),
+						"repeated_separator_found":  knownvalue.Bool(false),
+						"leading": knownvalue.ObjectExact(map[string]knownvalue.Check{
+							"valid": knownvalue.Bool(true),
+							"deny":  knownvalue.StringExact(""),
+						}),
+						"trailing": knownvalue.ObjectExact(map[string]knownvalue.Check{
+							"valid": knownvalue.Bool(true),
+							"deny":  knownvalue.StringExact(""),
+						}),
+						"unique_suffix": knownvalue.ObjectExact(map[string]knownvalue.Check{
+							"valid": knownvalue.Bool(true),
+							"is":    knownvalue.Int64Exact(0),
+							"min":   knownvalue.Int64Exact(0),
+						}),
+						"denied_patterns":      knownvalue.ListExact([]knownvalue.Check{}),
+						"reserved_words_found": knownvalue.ListExact([]knownvalue.Check{}),
 					})),
 				},
 			},
@@ -144,8 +198,9 @@ func TestValidateFunction_RegEx(t *testing.T) {
 				}`),
 				ConfigStateChecks: []statecheck.StateCheck{
 					statecheck.ExpectKnownOutputValue("test", knownvalue.ObjectExact(map[string]knownvalue.Check{
-						"name": knownvalue.StringExact("rg-test#-we"),
-						"type": knownvalue.StringExact("azurerm_resource_group"),
+						"name":  knownvalue.StringExact("rg-test#-we"),
+						"type":  knownvalue.StringExact("azurerm_resource_group"),
+						"scope": knownvalue.StringExact(""),
 						"regex": knownvalue.ObjectExact(map[string]knownvalue.Check{
 							"valid": knownvalue.Bool(false),
 							"match": knownvalue.StringExact("^[a-zA-Z0-9-._()]{0,89}[a-zA-Z0-9-_()]$"),
@@ -156,8 +211,25 @@ func TestValidateFunction_RegEx(t *testing.T) {
 							"max":   knownvalue.Int64Exact(20),
 							"min":   knownvalue.Int64Exact(8),
 						}),
-						"double_hyphens_denied": knownvalue.Bool(true),
-						"double_hyphens_found":  knownvalue.Bool(false),
+						"double_hyphens_denied":     knownvalue.Bool(true),
+						"double_hyphens_found":      knownvalue.Bool(false),
+						"repeated_separator_denied": knownvalue.Bool(false),
+						"repeated_separator_found":  knownvalue.Bool(false),
+						"leading": knownvalue.ObjectExact(map[string]knownvalue.Check{
+							"valid": knownvalue.Bool(true),
+							"deny":  knownvalue.StringExact(""),
+						}),
+						"trailing": knownvalue.ObjectExact(map[string]knownvalue.Check{
+							"valid": knownvalue.Bool(true),
+							"deny":  knownvalue.StringExact(""),
+						}),
+						"unique_suffix": knownvalue.ObjectExact(map[string]knownvalue.Check{
+							"valid": knownvalue.Bool(true),
+							"is":    knownvalue.Int64Exact(0),
+							"min":   knownvalue.Int64Exact(0),
+						}),
+						"denied_patterns":      knownvalue.ListExact([]knownvalue.Check{}),
+						"reserved_words_found": knownvalue.ListExact([]knownvalue.Check{}),
 					})),
 				},
 			},
@@ -175,8 +247,9 @@ func TestValidateFunction_DoubleHyphensFound(t *testing.T) {
 				}`),
 				ConfigStateChecks: []statecheck.StateCheck{
 					statecheck.ExpectKnownOutputValue("test", knownvalue.ObjectExact(map[string]knownvalue.Check{
-						"name": knownvalue.StringExact("rg-12345--67890-we"),
-						"type": knownvalue.StringExact("azurerm_resource_group"),
+						"name":  knownvalue.StringExact("rg-12345--67890-we"),
+						"type":  knownvalue.StringExact("azurerm_resource_group"),
+						"scope": knownvalue.StringExact(""),
 						"regex": knownvalue.ObjectExact(map[string]knownvalue.Check{
 							"valid": knownvalue.Bool(true),
 							"match": knownvalue.StringExact("^[a-zA-Z0-9-._()]{0,89}[a-zA-Z0-9-_()]$"),
@@ -187,8 +260,25 @@ func TestValidateFunction_DoubleHyphensFound(t *testing.T) {
 							"max":   knownvalue.Int64Exact(20),
 							"min":   knownvalue.Int64Exact(8),
 						}),
-						"double_hyphens_denied": knownvalue.Bool(true),
-						"double_hyphens_found":  knownvalue.Bool(true),
+						"double_hyphens_denied":     knownvalue.Bool(true),
+						"double_hyphens_found":      knownvalue.Bool(true),
+						"repeated_separator_denied": knownvalue.Bool(false),
+						"repeated_separator_found":  knownvalue.Bool(true),
+						"leading": knownvalue.ObjectExact(map[string]knownvalue.Check{
+							"valid": knownvalue.Bool(true),
+							"deny":  knownvalue.StringExact(""),
+						}),
+						"trailing": knownvalue.ObjectExact(map[string]knownvalue.Check{
+							"valid": knownvalue.Bool(true),
+							"deny":  knownvalue.StringExact(""),
+						}),
+						"unique_suffix": knownvalue.ObjectExact(map[string]knownvalue.Check{
+							"valid": knownvalue.Bool(true),
+							"is":    knownvalue.Int64Exact(0),
+							"min":   knownvalue.Int64Exact(0),
+						}),
+						"denied_patterns":      knownvalue.ListExact([]knownvalue.Check{}),
+						"reserved_words_found": knownvalue.ListExact([]knownvalue.Check{}),
 					})),
 				},
 			},
@@ -206,8 +296,9 @@ func TestValidateFunction_DoubleHyphensNotDenied(t *testing.T) {
 				}`),
 				ConfigStateChecks: []statecheck.StateCheck{
 					statecheck.ExpectKnownOutputValue("test", knownvalue.ObjectExact(map[string]knownvalue.Check{
-						"name": knownvalue.StringExact("rg-te--st"),
-						"type": knownvalue.StringExact("azurerm_resource_group"),
+						"name":  knownvalue.StringExact("rg-te--st"),
+						"type":  knownvalue.StringExact("azurerm_resource_group"),
+						"scope": knownvalue.StringExact(""),
 						"regex": knownvalue.ObjectExact(map[string]knownvalue.Check{
 							"valid": knownvalue.Bool(true),
 							"match": knownvalue.StringExact("^[a-zA-Z0-9-._()]{0,89}[a-zA-Z0-9-_()]$"),
@@ -218,8 +309,25 @@ func TestValidateFunction_DoubleHyphensNotDenied(t *testing.T) {
 							"max":   knownvalue.Int64Exact(90),
 							"min":   knownvalue.Int64Exact(1),
 						}),
-						"double_hyphens_denied": knownvalue.Bool(false),
-						"double_hyphens_found":  knownvalue.Bool(true),
+						"double_hyphens_denied":     knownvalue.Bool(false),
+						"double_hyphens_found":      knownvalue.Bool(true),
+						"repeated_separator_denied": knownvalue.Bool(false),
+						"repeated_separator_found":  knownvalue.Bool(true),
+						"leading": knownvalue.ObjectExact(map[string]knownvalue.Check{
+							"valid": knownvalue.Bool(true),
+							"deny":  knownvalue.StringExact(""),
+						}),
+						"trailing": knownvalue.ObjectExact(map[string]knownvalue.Check{
+							"valid": knownvalue.Bool(true),
+							"deny":  knownvalue.StringExact(""),
+						}),
+						"unique_suffix": knownvalue.ObjectExact(map[string]knownvalue.Check{
+							"valid": knownvalue.Bool(true),
+							"is":    knownvalue.Int64Exact(0),
+							"min":   knownvalue.Int64Exact(0),
+						}),
+						"denied_patterns":      knownvalue.ListExact([]knownvalue.Check{}),
+						"reserved_words_found": knownvalue.ListExact([]knownvalue.Check{}),
 					})),
 				},
 			},
@@ -237,8 +345,9 @@ func TestValidateFunction_MultipleValidationFailures(t *testing.T) {
 				}`),
 				ConfigStateChecks: []statecheck.StateCheck{
 					statecheck.ExpectKnownOutputValue("test", knownvalue.ObjectExact(map[string]knownvalue.Check{
-						"name": knownvalue.StringExact("rg-test#12345678901234567890-we"),
-						"type": knownvalue.StringExact("azurerm_resource_group"),
+						"name":  knownvalue.StringExact("rg-test#12345678901234567890-we"),
+						"type":  knownvalue.StringExact("azurerm_resource_group"),
+						"scope": knownvalue.StringExact(""),
 						"regex": knownvalue.ObjectExact(map[string]knownvalue.Check{
 							"valid": knownvalue.Bool(false),
 							"match": knownvalue.StringExact("^[a-zA-Z0-9-._()]{0,89}[a-zA-Z0-9-_()]$"),
@@ -249,8 +358,25 @@ func TestValidateFunction_MultipleValidationFailures(t *testing.T) {
 							"max":   knownvalue.Int64Exact(20),
 							"min":   knownvalue.Int64Exact(8),
 						}),
-						"double_hyphens_denied": knownvalue.Bool(true),
-						"double_hyphens_found":  knownvalue.Bool(false),
+						"double_hyphens_denied":     knownvalue.Bool(true),
+						"double_hyphens_found":      knownvalue.Bool(false),
+						"repeated_separator_denied": knownvalue.Bool(false),
+						"repeated_separator_found":  knownvalue.Bool(false),
+						"leading": knownvalue.ObjectExact(map[string]knownvalue.Check{
+							"valid": knownvalue.Bool(true),
+							"deny":  knownvalue.StringExact(""),
+						}),
+						"trailing": knownvalue.ObjectExact(map[string]knownvalue.Check{
+							"valid": knownvalue.Bool(true),
+							"deny":  knownvalue.StringExact(""),
+						}),
+						"unique_suffix": knownvalue.ObjectExact(map[string]knownvalue.Check{
+							"valid": knownvalue.Bool(true),
+							"is":    knownvalue.Int64Exact(0),
+							"min":   knownvalue.Int64Exact(0),
+						}),
+						"denied_patterns":      knownvalue.ListExact([]knownvalue.Check{}),
+						"reserved_words_found": knownvalue.ListExact([]knownvalue.Check{}),
 					})),
 				},
 			},
@@ -268,8 +394,9 @@ func TestValidateFunction_LowerCase(t *testing.T) {
 				}`),
 				ConfigStateChecks: []statecheck.StateCheck{
 					statecheck.ExpectKnownOutputValue("test", knownvalue.ObjectExact(map[string]knownvalue.Check{
-						"name": knownvalue.StringExact("rg-uppercase-we"),
-						"type": knownvalue.StringExact("azurerm_resource_group"),
+						"name":  knownvalue.StringExact("rg-uppercase-we"),
+						"type":  knownvalue.StringExact("azurerm_resource_group"),
+						"scope": knownvalue.StringExact(""),
 						"regex": knownvalue.ObjectExact(map[string]knownvalue.Check{
 							"valid": knownvalue.Bool(true),
 							"match": knownvalue.StringExact("^[a-zA-Z0-9-._()]{0,89}[a-zA-Z0-9-_()]$"),
@@ -280,8 +407,25 @@ func TestValidateFunction_LowerCase(t *testing.T) {
 							"max":   knownvalue.Int64Exact(20),
 							"min":   knownvalue.Int64Exact(8),
 						}),
-						"double_hyphens_denied": knownvalue.Bool(true),
-						"double_hyphens_found":  knownvalue.Bool(false),
+						"double_hyphens_denied":     knownvalue.Bool(true),
+						"double_hyphens_found":      knownvalue.Bool(false),
+						"repeated_separator_denied": knownvalue.Bool(false),
+						"repeated_separator_found":  knownvalue.Bool(false),
+						"leading": knownvalue.ObjectExact(map[string]knownvalue.Check{
+							"valid": knownvalue.Bool(true),
+							"deny":  knownvalue.StringExact(""),
+						}),
+						"trailing": knownvalue.ObjectExact(map[string]knownvalue.Check{
+							"valid": knownvalue.Bool(true),
+							"deny":  knownvalue.StringExact(""),
+						}),
+						"unique_suffix": knownvalue.ObjectExact(map[string]knownvalue.Check{
+							"valid": knownvalue.Bool(true),
+							"is":    knownvalue.Int64Exact(0),
+							"min":   knownvalue.Int64Exact(0),
+						}),
+						"denied_patterns":      knownvalue.ListExact([]knownvalue.Check{}),
+						"reserved_words_found": knownvalue.ListExact([]knownvalue.Check{}),
 					})),
 				},
 			},
@@ -299,8 +443,9 @@ func TestValidateFunction_AzureCaf(t *testing.T) {
 				}`),
 				ConfigStateChecks: []statecheck.StateCheck{
 					statecheck.ExpectKnownOutputValue("test", knownvalue.ObjectExact(map[string]knownvalue.Check{
-						"name": knownvalue.StringExact("rg-test"),
-						"type": knownvalue.StringExact("azurerm_resource_group"),
+						"name":  knownvalue.StringExact("rg-test"),
+						"type":  knownvalue.StringExact("azurerm_resource_group"),
+						"scope": knownvalue.StringExact(""),
 						"regex": knownvalue.ObjectExact(map[string]knownvalue.Check{
 							"valid": knownvalue.Bool(true),
 							"match": knownvalue.StringExact("^[a-zA-Z0-9-._()]{0,89}[a-zA-Z0-9-_()]$"),
@@ -311,8 +456,25 @@ func TestValidateFunction_AzureCaf(t *testing.T) {
 							"max":   knownvalue.Int64Exact(90),
 							"min":   knownvalue.Int64Exact(1),
 						}),
-						"double_hyphens_denied": knownvalue.Bool(false),
-						"double_hyphens_found":  knownvalue.Bool(false),
+						"double_hyphens_denied":     knownvalue.Bool(false),
+						"double_hyphens_found":      knownvalue.Bool(false),
+						"repeated_separator_denied": knownvalue.Bool(false),
+						"repeated_separator_found":  knownvalue.Bool(false),
+						"leading": knownvalue.ObjectExact(map[string]knownvalue.Check{
+							"valid": knownvalue.Bool(true),
+							"deny":  knownvalue.StringExact(""),
+						}),
+						"trailing": knownvalue.ObjectExact(map[string]knownvalue.Check{
+							"valid": knownvalue.Bool(true),
+							"deny":  knownvalue.StringExact(""),
+						}),
+						"unique_suffix": knownvalue.ObjectExact(map[string]knownvalue.Check{
+							"valid": knownvalue.Bool(true),
+							"is":    knownvalue.Int64Exact(0),
+							"min":   knownvalue.Int64Exact(0),
+						}),
+						"denied_patterns":      knownvalue.ListExact([]knownvalue.Check{}),
+						"reserved_words_found": knownvalue.ListExact([]knownvalue.Check{}),
 					})),
 				},
 			},
@@ -330,8 +492,9 @@ func TestValidateFunction_AzureCaf_Full(t *testing.T) {
 				}`),
 				ConfigStateChecks: []statecheck.StateCheck{
 					statecheck.ExpectKnownOutputValue("test", knownvalue.ObjectExact(map[string]knownvalue.Check{
-						"name": knownvalue.StringExact("rg_pre1_pre2_test_we_tst_qffc_suf1_suf2"),
-						"type": knownvalue.StringExact("azurerm_resource_group"),
+						"name":  knownvalue.StringExact("rg_pre1_pre2_test_we_tst_qffc_suf1_suf2"),
+						"type":  knownvalue.StringExact("azurerm_resource_group"),
+						"scope": knownvalue.StringExact(""),
 						"regex": knownvalue.ObjectExact(map[string]knownvalue.Check{
 							"valid": knownvalue.Bool(true),
 							"match": knownvalue.StringExact("^[a-zA-Z0-9-._()]{0,89}[a-zA-Z0-9-_()]$"),
@@ -342,8 +505,25 @@ func TestValidateFunction_AzureCaf_Full(t *testing.T) {
 							"max":   knownvalue.Int64Exact(90),
 							"min":   knownvalue.Int64Exact(1),
 						}),
-						"double_hyphens_denied": knownvalue.Bool(false),
-						"double_hyphens_found":  knownvalue.Bool(false),
+						"double_hyphens_denied":     knownvalue.Bool(false),
+						"double_hyphens_found":      knownvalue.Bool(false),
+						"repeated_separator_denied": knownvalue.Bool(false),
+						"repeated_separator_found":  knownvalue.Bool(false),
+						"leading": knownvalue.ObjectExact(map[string]knownvalue.Check{
+							"valid": knownvalue.Bool(true),
+							"deny":  knownvalue.StringExact(""),
+						}),
+						"trailing": knownvalue.ObjectExact(map[string]knownvalue.Check{
+							"valid": knownvalue.Bool(true),
+							"deny":  knownvalue.StringExact(""),
+						}),
+						"unique_suffix": knownvalue.ObjectExact(map[string]knownvalue.Check{
+							"valid": knownvalue.Bool(true),
+							"is":    knownvalue.Int64Exact(4),
+							"min":   knownvalue.Int64Exact(0),
+						}),
+						"denied_patterns":      knownvalue.ListExact([]knownvalue.Check{}),
+						"reserved_words_found": knownvalue.ListExact([]knownvalue.Check{}),
 					})),
 				},
 			},
@@ -361,8 +541,9 @@ func TestValidateFunction_AzureCaf_AllNullValues(t *testing.T) {
 				}`),
 				ConfigStateChecks: []statecheck.StateCheck{
 					statecheck.ExpectKnownOutputValue("test", knownvalue.ObjectExact(map[string]knownvalue.Check{
-						"name": knownvalue.StringExact("rg-test"),
-						"type": knownvalue.StringExact("azurerm_resource_group"),
+						"name":  knownvalue.StringExact("rg-test"),
+						"type":  knownvalue.StringExact("azurerm_resource_group"),
+						"scope": knownvalue.StringExact(""),
 						"regex": knownvalue.ObjectExact(map[string]knownvalue.Check{
 							"valid": knownvalue.Bool(true),
 							"match": knownvalue.StringExact("^[a-zA-Z0-9-._()]{0,89}[a-zA-Z0-9-_()]$"),
@@ -373,8 +554,25 @@ func TestValidateFunction_AzureCaf_AllNullValues(t *testing.T) {
 							"max":   knownvalue.Int64Exact(90),
 							"min":   knownvalue.Int64Exact(1),
 						}),
-						"double_hyphens_denied": knownvalue.Bool(false),
-						"double_hyphens_found":  knownvalue.Bool(false),
+						"double_hyphens_denied":     knownvalue.Bool(false),
+						"double_hyphens_found":      knownvalue.Bool(false),
+						"repeated_separator_denied": knownvalue.Bool(false),
+						"repeated_separator_found":  knownvalue.Bool(false),
+						"leading": knownvalue.ObjectExact(map[string]knownvalue.Check{
+							"valid": knownvalue.Bool(true),
+							"deny":  knownvalue.StringExact(""),
+						}),
+						"trailing": knownvalue.ObjectExact(map[string]knownvalue.Check{
+							"valid": knownvalue.Bool(true),
+							"deny":  knownvalue.StringExact(""),
+						}),
+						"unique_suffix": knownvalue.ObjectExact(map[string]knownvalue.Check{
+							"valid": knownvalue.Bool(true),
+							"is":    knownvalue.Int64Exact(0),
+							"min":   knownvalue.Int64Exact(0),
+						}),
+						"denied_patterns":      knownvalue.ListExact([]knownvalue.Check{}),
+						"reserved_words_found": knownvalue.ListExact([]knownvalue.Check{}),
 					})),
 				},
 			},
@@ -392,8 +590,9 @@ func TestValidateFunction_AzureCaf_PartialNullValues(t *testing.T) {
 				}`),
 				ConfigStateChecks: []statecheck.StateCheck{
 					statecheck.ExpectKnownOutputValue("test", knownvalue.ObjectExact(map[string]knownvalue.Check{
-						"name": knownvalue.StringExact("rg_test_we_tst_qffc"),
-						"type": knownvalue.StringExact("azurerm_resource_group"),
+						"name":  knownvalue.StringExact("rg_test_we_tst_qffc"),
+						"type":  knownvalue.StringExact("azurerm_resource_group"),
+						"scope": knownvalue.StringExact(""),
 						"regex": knownvalue.ObjectExact(map[string]knownvalue.Check{
 							"valid": knownvalue.Bool(true),
 							"match": knownvalue.StringExact("^[a-zA-Z0-9-._()]{0,89}[a-zA-Z0-9-_()]$"),
@@ -404,8 +603,65 @@ func TestValidateFunction_AzureCaf_PartialNullValues(t *testing.T) {
 							"max":   knownvalue.Int64Exact(90),
 							"min":   knownvalue.Int64Exact(1),
 						}),
-						"double_hyphens_denied": knownvalue.Bool(false),
-						"double_hyphens_found":  knownvalue.Bool(false),
+						"double_hyphens_denied":     knownvalue.Bool(false),
+						"double_hyphens_found":      knownvalue.Bool(false),
+						"repeated_separator_denied": knownvalue.Bool(false),
+						"repeated_separator_found":  knownvalue.Bool(false),
+						"leading": knownvalue.ObjectExact(map[string]knownvalue.Check{
+							"valid": knownvalue.Bool(true),
+							"deny":  knownvalue.StringExact(""),
+						}),
+						"trailing": knownvalue.ObjectExact(map[string]knownvalue.Check{
+							"valid": knownvalue.Bool(true),
+							"deny":  knownvalue.StringExact(""),
+						}),
+						"unique_suffix": knownvalue.ObjectExact(map[string]knownvalue.Check{
+							"valid": knownvalue.Bool(true),
+							"is":    knownvalue.Int64Exact(4),
+							"min":   knownvalue.Int64Exact(0),
+						}),
+						"denied_patterns":      knownvalue.ListExact([]knownvalue.Check{}),
+						"reserved_words_found": knownvalue.ListExact([]knownvalue.Check{}),
+					})),
+				},
+			},
+		},
+	})
+}
+
+func TestValidateFunction_ReservedWordsFound(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactoriesUnique(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf("%s %s", fmt.Sprintf(default_config, `reserved_words_check = true`, `"abbreviation", "name", "location"`), `output "test" {
+					value = provider::standesamt::validate(local.config, "azurerm_resource_group", local.settings, "login")
+				}`),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("test", knownvalue.ObjectPartial(map[string]knownvalue.Check{
+						"name": knownvalue.StringExact("rg-login-we"),
+						"reserved_words_found": knownvalue.ListExact([]knownvalue.Check{
+							knownvalue.StringExact("LOGIN"),
+						}),
+					})),
+				},
+			},
+		},
+	})
+}
+
+func TestValidateFunction_RawMode(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactoriesUnique(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf("%s %s", fmt.Sprintf(default_config, ``, `"abbreviation", "name", "location"`), `output "test" {
+					value = provider::standesamt::validate(local.config, "azurerm_resource_group", local.settings, "legacy--rg", "raw")
+				}`),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("test", knownvalue.ObjectPartial(map[string]knownvalue.Check{
+						"name":                 knownvalue.StringExact("legacy--rg"),
+						"double_hyphens_found": knownvalue.Bool(true),
 					})),
 				},
 			},
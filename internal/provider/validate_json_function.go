@@ -0,0 +1,139 @@
+// Copyright glueckkanja AG 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = &ValidateJSONFunction{}
+
+type ValidateJSONFunction struct{}
+
+func NewValidateJSONFunction() function.Function {
+	return &ValidateJSONFunction{}
+}
+
+func (f *ValidateJSONFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "validate_json"
+}
+
+func (f *ValidateJSONFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Validate a resource name and return the validation results as JSON",
+		Description: "Build and validate a resource name like the validate function and return the validation results as a JSON string.",
+		MarkdownDescription: "Build and validate a resource name like the `validate` function and return the validation results as a JSON string " +
+			"with the same attributes, e.g. to pass them to a policy engine like OPA or Conftest through an external data source. " +
+			"`denied_patterns` and `reserved_words_found` are always arrays, empty if nothing was found.",
+		Parameters:        validateParameters(),
+		VariadicParameter: validateModeParameter(),
+		Return:            function.StringReturn{},
+	}
+}
+
+func (f *ValidateJSONFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	validation, nameType, scope, ok := runValidation(ctx, req, resp)
+	if !ok {
+		return
+	}
+
+	rendered, err := json.Marshal(newValidationDocument(validation, nameType, scope))
+	if err != nil {
+		resp.Error = function.NewFuncError(fmt.Sprintf("failed to render validation result: %s", err.Error()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, string(rendered)))
+}
+
+// validationDocument is the JSON form of the result of the validate function.
+type validationDocument struct {
+	Regex                   validationRegexDocument  `json:"regex"`
+	Length                  validationLengthDocument `json:"length"`
+	Type                    string                   `json:"type"`
+	Scope                   string                   `json:"scope"`
+	Name                    string                   `json:"name"`
+	DoubleHyphensDenied     bool                     `json:"double_hyphens_denied"`
+	DoubleHyphensFound      bool                     `json:"double_hyphens_found"`
+	RepeatedSeparatorDenied bool                     `json:"repeated_separator_denied"`
+	RepeatedSeparatorFound  bool                     `json:"repeated_separator_found"`
+	Leading                 validationDenyDocument   `json:"leading"`
+	Trailing                validationDenyDocument   `json:"trailing"`
+	UniqueSuffix            validationSuffixDocument `json:"unique_suffix"`
+	DeniedPatterns          []string                 `json:"denied_patterns"`
+	ReservedWordsFound      []string                 `json:"reserved_words_found"`
+}
+
+type validationRegexDocument struct {
+	Valid bool   `json:"valid"`
+	Match string `json:"match"`
+}
+
+type validationLengthDocument struct {
+	Valid bool  `json:"valid"`
+	Is    int64 `json:"is"`
+	Max   int64 `json:"max"`
+	Min   int64 `json:"min"`
+}
+
+type validationDenyDocument struct {
+	Valid bool   `json:"valid"`
+	Deny  string `json:"deny"`
+}
+
+type validationSuffixDocument struct {
+	Valid bool  `json:"valid"`
+	Is    int64 `json:"is"`
+	Min   int64 `json:"min"`
+}
+
+// newValidationDocument converts a validation result into its JSON document.
+func newValidationDocument(validation *validationResult, nameType, scope string) validationDocument {
+	document := validationDocument{
+		Regex: validationRegexDocument{
+			Valid: validation.RegexValid && validation.SettingsRegexValid,
+			Match: validation.ValidationRegex,
+		},
+		Length: validationLengthDocument{
+			Valid: validation.LengthValid,
+			Is:    validation.NameLength,
+			Max:   validation.MaxLength,
+			Min:   validation.MinLength,
+		},
+		Type:                    nameType,
+		Scope:                   scope,
+		Name:                    validation.Name,
+		DoubleHyphensDenied:     validation.DenyDoubleHyphens,
+		DoubleHyphensFound:      validation.DoubleHyphensFound,
+		RepeatedSeparatorDenied: validation.DenyRepeatedSeparator,
+		RepeatedSeparatorFound:  validation.RepeatedSeparatorFound,
+		Leading: validationDenyDocument{
+			Valid: validation.LeadingValid,
+			Deny:  validation.DenyLeading,
+		},
+		Trailing: validationDenyDocument{
+			Valid: validation.TrailingValid,
+			Deny:  validation.DenyTrailing,
+		},
+		UniqueSuffix: validationSuffixDocument{
+			Valid: validation.UniqueSuffixValid,
+			Is:    validation.UniqueSuffixLength,
+			Min:   validation.MinUniqueSuffix,
+		},
+		DeniedPatterns:     validation.DeniedPatterns,
+		ReservedWordsFound: validation.ReservedWords,
+	}
+	// Policy engines expect arrays, not null.
+	if document.DeniedPatterns == nil {
+		document.DeniedPatterns = []string{}
+	}
+	if document.ReservedWordsFound == nil {
+		document.ReservedWordsFound = []string{}
+	}
+	return document
+}
@@ -0,0 +1,69 @@
+// Copyright glueckkanja AG 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewValidationDocument(t *testing.T) {
+	validation := &validationResult{
+		Name:               "rg-my--app",
+		NameLength:         10,
+		RegexValid:         true,
+		SettingsRegexValid: true,
+		ValidationRegex:    "^[a-z-]+$",
+		LengthValid:        true,
+		MaxLength:          20,
+		MinLength:          8,
+		DenyDoubleHyphens:  true,
+		DoubleHyphensFound: true,
+		LeadingValid:       true,
+		TrailingValid:      true,
+		UniqueSuffixValid:  true,
+		DeniedPatterns:     []string{"^rg-test"},
+	}
+
+	rendered, err := json.Marshal(newValidationDocument(validation, "azurerm_resource_group", "resourceGroup"))
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{
+		"regex": {"valid": true, "match": "^[a-z-]+$"},
+		"length": {"valid": true, "is": 10, "max": 20, "min": 8},
+		"type": "azurerm_resource_group",
+		"scope": "resourceGroup",
+		"name": "rg-my--app",
+		"double_hyphens_denied": true,
+		"double_hyphens_found": true,
+		"repeated_separator_denied": false,
+		"repeated_separator_found": false,
+		"leading": {"valid": true, "deny": ""},
+		"trailing": {"valid": true, "deny": ""},
+		"unique_suffix": {"valid": true, "is": 0, "min": 0},
+		"denied_patterns": ["^rg-test"],
+		"reserved_words_found": []
+	}`, string(rendered))
+}
+
+func TestValidateJSONFunction_ValidName(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactoriesUnique(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf("%s %s", default_config_with_no_settings_default_precedence, `output "test" {
+					value = jsondecode(provider::standesamt::validate_json(local.config, "azurerm_resource_group", local.settings, "test")).length.valid
+				}`),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("test", knownvalue.Bool(true)),
+				},
+			},
+		},
+	})
+}
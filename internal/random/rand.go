@@ -4,22 +4,75 @@
 package random
 
 import (
+	crand "crypto/rand"
+	"fmt"
+	"math/big"
 	"math/rand"
+	randv2 "math/rand/v2"
 )
 
-const charset = "abcdefghijklmnopqrstuvwxyz"
+// Charsets that can be used for generated strings.
+const (
+	Lowercase             = "abcdefghijklmnopqrstuvwxyz"
+	Uppercase             = "ABCDEFGHIJKLMNOPQRSTUVWXYZ"
+	Digits                = "0123456789"
+	LowercaseAlphanumeric = Lowercase + Digits
+)
 
-var seededRand *rand.Rand
+const charset = Lowercase
 
+// Hash returns a deterministic string of lowercase letters derived from seed.
+// The same length and seed always produce the same result. It is safe for
+// concurrent use.
+func Hash(length int, seed int64) string {
+	return HashWithCharset(length, seed, charset)
+}
+
+// HashWithCharset returns a deterministic string of the given length using only
+// characters from charset. An empty charset falls back to lowercase letters.
+// It is safe for concurrent use.
+func HashWithCharset(length int, seed int64, charset string) string {
+	if charset == "" {
+		charset = Lowercase
+	}
+	// Every call uses its own source, so concurrent function calls never share
+	// generator state.
+	r := rand.New(rand.NewSource(seed))
+	b := make([]byte, length)
+	for i := range b {
+		b[i] = charset[r.Intn(len(charset))]
+	}
+	return string(b)
+}
+
+// StringWithCharset returns a non-deterministic string of the given length using
+// only characters from charset. It is safe for concurrent use.
 func StringWithCharset(length int, charset string) string {
+	if charset == "" {
+		charset = Lowercase
+	}
 	b := make([]byte, length)
 	for i := range b {
-		b[i] = charset[seededRand.Intn(len(charset))]
+		b[i] = charset[randv2.IntN(len(charset))]
 	}
 	return string(b)
 }
 
-func Hash(length int, seed int64) string {
-	seededRand = rand.New(rand.NewSource(seed))
-	return StringWithCharset(length, charset)
+// CryptoString returns a string of the given length using only characters from
+// charset, read from the operating system's cryptographically secure random
+// number generator.
+func CryptoString(length int, charset string) (string, error) {
+	if charset == "" {
+		charset = Lowercase
+	}
+	max := big.NewInt(int64(len(charset)))
+	b := make([]byte, length)
+	for i := range b {
+		n, err := crand.Int(crand.Reader, max)
+		if err != nil {
+			return "", fmt.Errorf("CryptoString: failed to read random data: %w", err)
+		}
+		b[i] = charset[n.Int64()]
+	}
+	return string(b), nil
 }
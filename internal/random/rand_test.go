@@ -4,6 +4,8 @@
 package random
 
 import (
+	"strings"
+	"sync"
 	"testing"
 )
 
@@ -40,6 +42,63 @@ func TestStringWithCharset(t *testing.T) {
 	}
 }
 
+func TestHashKnownValue(t *testing.T) {
+	// Generated names must stay stable across provider releases.
+	if got := Hash(4, 1234); got != "qffc" {
+		t.Errorf("Expected hash %s, got %s", "qffc", got)
+	}
+}
+
+func TestHashConcurrent(t *testing.T) {
+	want := Hash(16, 42)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 32; i++ {
+		wg.Add(1)
+		go func(seed int64) {
+			defer wg.Done()
+			_ = Hash(16, seed)
+			if got := Hash(16, 42); got != want {
+				t.Errorf("Expected hash %s, got %s", want, got)
+			}
+		}(int64(i))
+	}
+	wg.Wait()
+}
+
+func TestHashWithCharset(t *testing.T) {
+	result := HashWithCharset(32, 42, Digits)
+
+	if len(result) != 32 {
+		t.Errorf("Expected hash length %d, got %d", 32, len(result))
+	}
+
+	for _, char := range result {
+		if !contains(Digits, char) {
+			t.Errorf("Unexpected character %c in result", char)
+		}
+	}
+
+	if HashWithCharset(8, 42, "") != Hash(8, 42) {
+		t.Errorf("Expected empty charset to fall back to lowercase")
+	}
+}
+
+func TestCryptoString(t *testing.T) {
+	result, err := CryptoString(24, LowercaseAlphanumeric)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	if len(result) != 24 {
+		t.Errorf("Expected string length %d, got %d", 24, len(result))
+	}
+
+	if strings.Trim(result, LowercaseAlphanumeric) != "" {
+		t.Errorf("Unexpected characters in result %s", result)
+	}
+}
+
 func contains(charset string, char rune) bool {
 	for _, c := range charset {
 		if c == char {
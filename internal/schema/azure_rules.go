@@ -0,0 +1,110 @@
+// Copyright glueckkanja AG 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package schema
+
+import (
+	"fmt"
+	"sort"
+)
+
+// AzureRule is the length restriction Azure Resource Manager enforces for the
+// name of a resource type.
+type AzureRule struct {
+	AzureType string
+	MinLength int
+	MaxLength int
+}
+
+// AzureRules are the naming rules of Azure resource types keyed by Terraform
+// resource type. The dataset is bundled with the provider and follows the
+// "Naming rules and restrictions for Azure resources" documentation; update it
+// together with the provider release when Azure changes a limit.
+var AzureRules = map[string]AzureRule{
+	"azurerm_api_management":             {AzureType: "Microsoft.ApiManagement/service", MinLength: 1, MaxLength: 50},
+	"azurerm_app_service_plan":           {AzureType: "Microsoft.Web/serverfarms", MinLength: 1, MaxLength: 60},
+	"azurerm_application_insights":       {AzureType: "Microsoft.Insights/components", MinLength: 1, MaxLength: 260},
+	"azurerm_container_registry":         {AzureType: "Microsoft.ContainerRegistry/registries", MinLength: 5, MaxLength: 50},
+	"azurerm_cosmosdb_account":           {AzureType: "Microsoft.DocumentDB/databaseAccounts", MinLength: 3, MaxLength: 44},
+	"azurerm_data_factory":               {AzureType: "Microsoft.DataFactory/factories", MinLength: 3, MaxLength: 63},
+	"azurerm_eventhub_namespace":         {AzureType: "Microsoft.EventHub/namespaces", MinLength: 6, MaxLength: 50},
+	"azurerm_firewall":                   {AzureType: "Microsoft.Network/azureFirewalls", MinLength: 1, MaxLength: 80},
+	"azurerm_key_vault":                  {AzureType: "Microsoft.KeyVault/vaults", MinLength: 3, MaxLength: 24},
+	"azurerm_kubernetes_cluster":         {AzureType: "Microsoft.ContainerService/managedClusters", MinLength: 1, MaxLength: 63},
+	"azurerm_linux_virtual_machine":      {AzureType: "Microsoft.Compute/virtualMachines", MinLength: 1, MaxLength: 64},
+	"azurerm_linux_web_app":              {AzureType: "Microsoft.Web/sites", MinLength: 2, MaxLength: 60},
+	"azurerm_log_analytics_workspace":    {AzureType: "Microsoft.OperationalInsights/workspaces", MinLength: 4, MaxLength: 63},
+	"azurerm_mssql_server":               {AzureType: "Microsoft.Sql/servers", MinLength: 1, MaxLength: 63},
+	"azurerm_network_security_group":     {AzureType: "Microsoft.Network/networkSecurityGroups", MinLength: 1, MaxLength: 80},
+	"azurerm_postgresql_flexible_server": {AzureType: "Microsoft.DBforPostgreSQL/flexibleServers", MinLength: 3, MaxLength: 63},
+	"azurerm_private_endpoint":           {AzureType: "Microsoft.Network/privateEndpoints", MinLength: 2, MaxLength: 64},
+	"azurerm_public_ip":                  {AzureType: "Microsoft.Network/publicIPAddresses", MinLength: 1, MaxLength: 80},
+	"azurerm_recovery_services_vault":    {AzureType: "Microsoft.RecoveryServices/vaults", MinLength: 2, MaxLength: 50},
+	"azurerm_resource_group":             {AzureType: "Microsoft.Resources/resourceGroups", MinLength: 1, MaxLength: 90},
+	"azurerm_route_table":                {AzureType: "Microsoft.Network/routeTables", MinLength: 1, MaxLength: 80},
+	"azurerm_service_plan":               {AzureType: "Microsoft.Web/serverfarms", MinLength: 1, MaxLength: 60},
+	"azurerm_servicebus_namespace":       {AzureType: "Microsoft.ServiceBus/namespaces", MinLength: 6, MaxLength: 50},
+	"azurerm_storage_account":            {AzureType: "Microsoft.Storage/storageAccounts", MinLength: 3, MaxLength: 24},
+	"azurerm_subnet":                     {AzureType: "Microsoft.Network/virtualNetworks/subnets", MinLength: 1, MaxLength: 80},
+	"azurerm_user_assigned_identity":     {AzureType: "Microsoft.ManagedIdentity/userAssignedIdentities", MinLength: 3, MaxLength: 128},
+	"azurerm_virtual_network":            {AzureType: "Microsoft.Network/virtualNetworks", MinLength: 2, MaxLength: 64},
+	"azurerm_virtual_network_gateway":    {AzureType: "Microsoft.Network/virtualNetworkGateways", MinLength: 1, MaxLength: 80},
+	"azurerm_windows_virtual_machine":    {AzureType: "Microsoft.Compute/virtualMachines", MinLength: 1, MaxLength: 15},
+	"azurerm_windows_web_app":            {AzureType: "Microsoft.Web/sites", MinLength: 2, MaxLength: 60},
+}
+
+// AzureRuleMismatch is a length limit of the schema library that allows names
+// Azure Resource Manager rejects.
+type AzureRuleMismatch struct {
+	ResourceType string
+	AzureType    string
+	Field        string
+	SchemaValue  int
+	AzureValue   int
+	Message      string
+}
+
+// CompareAzureRules compares the length limits of the naming schemas with the
+// Azure rules. Limits that are stricter than the Azure rules are fine, e.g. an
+// internal maximum length; only looser limits are reported. Resource types
+// without an Azure rule are skipped. Mismatches are sorted by resource type
+// and field.
+func CompareAzureRules(schemas []JsonNamingSchema, rules map[string]AzureRule) []AzureRuleMismatch {
+	mismatches := make([]AzureRuleMismatch, 0)
+	for _, schema := range schemas {
+		rule, ok := rules[schema.ResourceType]
+		if !ok {
+			continue
+		}
+
+		if schema.MinLength < rule.MinLength {
+			mismatches = append(mismatches, AzureRuleMismatch{
+				ResourceType: schema.ResourceType,
+				AzureType:    rule.AzureType,
+				Field:        "minLength",
+				SchemaValue:  schema.MinLength,
+				AzureValue:   rule.MinLength,
+				Message:      fmt.Sprintf("minLength %d is below the Azure minimum length %d of %s", schema.MinLength, rule.MinLength, rule.AzureType),
+			})
+		}
+		if schema.MaxLength > rule.MaxLength {
+			mismatches = append(mismatches, AzureRuleMismatch{
+				ResourceType: schema.ResourceType,
+				AzureType:    rule.AzureType,
+				Field:        "maxLength",
+				SchemaValue:  schema.MaxLength,
+				AzureValue:   rule.MaxLength,
+				Message:      fmt.Sprintf("maxLength %d exceeds the Azure maximum length %d of %s", schema.MaxLength, rule.MaxLength, rule.AzureType),
+			})
+		}
+	}
+
+	sort.SliceStable(mismatches, func(i, j int) bool {
+		if mismatches[i].ResourceType != mismatches[j].ResourceType {
+			return mismatches[i].ResourceType < mismatches[j].ResourceType
+		}
+		return mismatches[i].Field < mismatches[j].Field
+	})
+
+	return mismatches
+}
@@ -0,0 +1,36 @@
+// Copyright glueckkanja AG 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package schema
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCompareAzureRules(t *testing.T) {
+	rules := map[string]AzureRule{
+		"azurerm_storage_account": {AzureType: "Microsoft.Storage/storageAccounts", MinLength: 3, MaxLength: 24},
+		"azurerm_key_vault":       {AzureType: "Microsoft.KeyVault/vaults", MinLength: 3, MaxLength: 24},
+	}
+	schemas := []JsonNamingSchema{
+		{ResourceType: "azurerm_storage_account", MinLength: 1, MaxLength: 30},
+		{ResourceType: "azurerm_key_vault", MinLength: 3, MaxLength: 20},
+		{ResourceType: "azapi_container_app", MinLength: 1, MaxLength: 90},
+	}
+
+	mismatches := CompareAzureRules(schemas, rules)
+
+	assert.Len(t, mismatches, 2)
+	assert.Equal(t, AzureRuleMismatch{
+		ResourceType: "azurerm_storage_account",
+		AzureType:    "Microsoft.Storage/storageAccounts",
+		Field:        "maxLength",
+		SchemaValue:  30,
+		AzureValue:   24,
+		Message:      "maxLength 30 exceeds the Azure maximum length 24 of Microsoft.Storage/storageAccounts",
+	}, mismatches[0])
+	assert.Equal(t, "minLength", mismatches[1].Field)
+	assert.Equal(t, "minLength 1 is below the Azure minimum length 3 of Microsoft.Storage/storageAccounts", mismatches[1].Message)
+}
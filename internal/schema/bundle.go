@@ -0,0 +1,132 @@
+// Copyright glueckkanja AG 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package schema
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"github.com/hashicorp/go-getter/v2"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+)
+
+// bundleManifestFile is the manifest at the root of a schema library bundle.
+const bundleManifestFile = "manifest.json"
+
+// BundleManifest describes a packaged schema library. Checksums maps the path
+// of every schema file in the bundle to its hex encoded SHA256 checksum.
+type BundleManifest struct {
+	Name      string            `json:"name"`
+	Version   string            `json:"version"`
+	Checksums map[string]string `json:"checksums"`
+}
+
+// OpenBundle validates a schema library bundle, a zip archive holding a
+// manifest.json and the schema files, and returns its files. Every file must be
+// listed in the manifest checksums and match its checksum.
+func OpenBundle(data []byte) (fs.FS, *BundleManifest, error) {
+	zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid bundle: %w", err)
+	}
+
+	manifestData, err := fs.ReadFile(zr, bundleManifestFile)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid bundle: missing %s: %w", bundleManifestFile, err)
+	}
+	manifest := &BundleManifest{}
+	if err := json.Unmarshal(manifestData, manifest); err != nil {
+		return nil, nil, fmt.Errorf("invalid bundle: failed to parse %s: %w", bundleManifestFile, err)
+	}
+	if manifest.Name == "" || manifest.Version == "" {
+		return nil, nil, fmt.Errorf("invalid bundle: %s must set name and version", bundleManifestFile)
+	}
+
+	listed := make(map[string]bool, len(manifest.Checksums))
+	for _, f := range zr.File {
+		if f.FileInfo().IsDir() || f.Name == bundleManifestFile {
+			continue
+		}
+		if _, ok := manifest.Checksums[f.Name]; !ok {
+			return nil, nil, fmt.Errorf("invalid bundle %s@%s: file '%s' is not listed in the checksums of %s", manifest.Name, manifest.Version, f.Name, bundleManifestFile)
+		}
+		listed[f.Name] = true
+	}
+
+	names := make([]string, 0, len(manifest.Checksums))
+	for name := range manifest.Checksums {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if !listed[name] {
+			return nil, nil, fmt.Errorf("invalid bundle %s@%s: file '%s' of %s is missing", manifest.Name, manifest.Version, name, bundleManifestFile)
+		}
+		content, err := fs.ReadFile(zr, name)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid bundle %s@%s: %w", manifest.Name, manifest.Version, err)
+		}
+		sum := sha256.Sum256(content)
+		if hex.EncodeToString(sum[:]) != manifest.Checksums[name] {
+			return nil, nil, fmt.Errorf("invalid bundle %s@%s: checksum mismatch for file '%s'", manifest.Name, manifest.Version, name)
+		}
+	}
+
+	return zr, manifest, nil
+}
+
+// DownloadBundle downloads the bundle at src, validates it with OpenBundle and
+// returns its files. The bundle is read into memory, dstDir below the cache
+// directory only holds the archive while it is downloaded.
+func DownloadBundle(ctx context.Context, src, dstDir string) (fs.FS, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, downloadContextError(src, err)
+	}
+
+	wd, err := os.Getwd()
+	if err != nil {
+		return nil, fmt.Errorf("error getting working directory: %w", err)
+	}
+	dst := cachePath(dstDir)
+	if err := os.RemoveAll(dst); err != nil {
+		return nil, fmt.Errorf("error cleaning destination directory %s: %w", dst, err)
+	}
+	defer func() { _ = os.RemoveAll(dst) }()
+
+	// The archive is validated before it is extracted, so go-getter must not
+	// decompress it.
+	client := getter.Client{
+		DisableSymlinks: true,
+		Decompressors:   map[string]getter.Decompressor{},
+	}
+	archive := filepath.Join(dst, "bundle.zip")
+	req := &getter.Request{
+		Src:     src,
+		Dst:     archive,
+		Pwd:     wd,
+		GetMode: getter.ModeFile,
+	}
+
+	_, err = client.Get(ctx, req)
+	if ctxErr := ctx.Err(); ctxErr != nil {
+		return nil, downloadContextError(src, ctxErr)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error downloading schema bundle. source `%s`, destination `%s`, wd `%s`: %w", src, archive, wd, err)
+	}
+
+	data, err := os.ReadFile(archive)
+	if err != nil {
+		return nil, fmt.Errorf("error reading schema bundle: %w", err)
+	}
+	f, _, err := OpenBundle(data)
+	return f, err
+}
@@ -0,0 +1,110 @@
+// Copyright glueckkanja AG 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package schema
+
+import (
+	"archive/zip"
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const bundleNamingSchema = `[{"resourceType":"azurerm_resource_group","abbreviation":"rg"}]`
+
+// testBundle returns a zip bundle of files with a manifest. checksums replaces
+// the computed checksums of the manifest if it is not nil.
+func testBundle(t *testing.T, files map[string]string, checksums map[string]string) []byte {
+	t.Helper()
+
+	if checksums == nil {
+		checksums = map[string]string{}
+		for name, content := range files {
+			sum := sha256.Sum256([]byte(content))
+			checksums[name] = hex.EncodeToString(sum[:])
+		}
+	}
+	manifest, err := json.Marshal(BundleManifest{Name: "acme", Version: "1.0.0", Checksums: checksums})
+	assert.NoError(t, err)
+
+	buf := &bytes.Buffer{}
+	zw := zip.NewWriter(buf)
+	for name, content := range map[string]string{bundleManifestFile: string(manifest)} {
+		w, err := zw.Create(name)
+		assert.NoError(t, err)
+		_, err = w.Write([]byte(content))
+		assert.NoError(t, err)
+	}
+	for name, content := range files {
+		w, err := zw.Create(name)
+		assert.NoError(t, err)
+		_, err = w.Write([]byte(content))
+		assert.NoError(t, err)
+	}
+	assert.NoError(t, zw.Close())
+	return buf.Bytes()
+}
+
+func TestOpenBundle(t *testing.T) {
+	f, manifest, err := OpenBundle(testBundle(t, map[string]string{"schema.naming.json": bundleNamingSchema}, nil))
+	assert.NoError(t, err)
+	assert.Equal(t, "acme", manifest.Name)
+	assert.Equal(t, "1.0.0", manifest.Version)
+
+	res := Result{}
+	assert.NoError(t, NewProcessorClient(f).Process(&res))
+	assert.Len(t, res.NamingSchemas, 1)
+	assert.Equal(t, "rg", res.NamingSchemas[0].Abbreviation)
+}
+
+func TestOpenBundle_Invalid(t *testing.T) {
+	files := map[string]string{"schema.naming.json": bundleNamingSchema}
+
+	_, _, err := OpenBundle([]byte("not a zip"))
+	assert.ErrorContains(t, err, "invalid bundle")
+
+	_, _, err = OpenBundle(testBundle(t, files, map[string]string{"schema.naming.json": "00"}))
+	assert.ErrorContains(t, err, "checksum mismatch for file 'schema.naming.json'")
+
+	_, _, err = OpenBundle(testBundle(t, files, map[string]string{}))
+	assert.ErrorContains(t, err, "file 'schema.naming.json' is not listed")
+
+	_, _, err = OpenBundle(testBundle(t, map[string]string{}, map[string]string{"schema.naming.json": "00"}))
+	assert.ErrorContains(t, err, "file 'schema.naming.json' of manifest.json is missing")
+
+	buf := &bytes.Buffer{}
+	zw := zip.NewWriter(buf)
+	_, err = zw.Create("schema.naming.json")
+	assert.NoError(t, err)
+	assert.NoError(t, zw.Close())
+	_, _, err = OpenBundle(buf.Bytes())
+	assert.ErrorContains(t, err, "missing manifest.json")
+}
+
+func TestBundleSource(t *testing.T) {
+	cacheDir := t.TempDir()
+	t.Setenv("SA_NAMING_DIR", cacheDir)
+
+	archive := filepath.Join(t.TempDir(), "library.zip")
+	assert.NoError(t, os.WriteFile(archive, testBundle(t, map[string]string{"schema.naming.json": bundleNamingSchema}, nil), 0o644))
+
+	source := NewBundleSource(archive)
+	f, err := source.Download(t.Context(), "bundle")
+	assert.NoError(t, err)
+	assert.Equal(t, f, source.Dst())
+	assert.Equal(t, "bundle::"+archive, source.String())
+
+	res := Result{}
+	assert.NoError(t, NewProcessorClient(f).Process(&res))
+	assert.Len(t, res.NamingSchemas, 1)
+
+	// The archive is not kept in the cache directory.
+	_, err = os.Stat(filepath.Join(cacheDir, "bundle"))
+	assert.True(t, os.IsNotExist(err))
+}
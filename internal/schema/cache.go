@@ -0,0 +1,81 @@
+// Copyright glueckkanja AG 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package schema
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"time"
+)
+
+// cacheEntryName matches the directories the provider downloads schema
+// libraries to, named after the SHA224 hash of their source. Other files and
+// directories of the cache directory are never removed.
+var cacheEntryName = regexp.MustCompile(`^[0-9a-f]{56}$`)
+
+// PruneCache removes the cache entries below root that were not used for
+// longer than maxAge and returns their names. The entries keep are in use,
+// e.g. the schema library and the compatibility library of the provider
+// configuration; their modification time is updated so they are kept as long
+// as they are used. A maxAge of zero only updates keep.
+func PruneCache(root string, keep []string, maxAge time.Duration) ([]string, error) {
+	now := time.Now()
+	for _, name := range keep {
+		if err := touchCacheEntry(filepath.Join(root, name), now); err != nil {
+			return nil, err
+		}
+	}
+	if maxAge <= 0 {
+		return nil, nil
+	}
+
+	entries, err := os.ReadDir(root)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error reading cache directory %s: %w", root, err)
+	}
+
+	var removed []string
+	var errs []error
+	for _, entry := range entries {
+		if !entry.IsDir() || slices.Contains(keep, entry.Name()) || !cacheEntryName.MatchString(entry.Name()) {
+			continue
+		}
+		info, err := entry.Info()
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if now.Sub(info.ModTime()) <= maxAge {
+			continue
+		}
+		if err := os.RemoveAll(filepath.Join(root, entry.Name())); err != nil {
+			errs = append(errs, fmt.Errorf("error removing cache entry %s: %w", entry.Name(), err))
+			continue
+		}
+		removed = append(removed, entry.Name())
+	}
+	return removed, errors.Join(errs...)
+}
+
+// TouchCacheEntry marks the cache entry dstDir, resolved like the download
+// destination, as used now, so PruneCache keeps libraries that are downloaded
+// after the cleanup, e.g. the references of standesamt_schema_diff.
+func TouchCacheEntry(dstDir string) error {
+	return touchCacheEntry(cachePath(dstDir), time.Now())
+}
+
+func touchCacheEntry(dir string, now time.Time) error {
+	if err := os.Chtimes(dir, now, now); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("error updating cache entry %s: %w", filepath.Base(dir), err)
+	}
+	return nil
+}
@@ -0,0 +1,86 @@
+// Copyright glueckkanja AG 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package schema
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPruneCache(t *testing.T) {
+	root := t.TempDir()
+	old := time.Now().Add(-40 * 24 * time.Hour)
+
+	entry := func(name string, modTime time.Time) {
+		dir := filepath.Join(root, name)
+		assert.NoError(t, os.MkdirAll(dir, 0o755))
+		assert.NoError(t, os.Chtimes(dir, modTime, modTime))
+	}
+	stale := strings.Repeat("a", 56)
+	fresh := strings.Repeat("b", 56)
+	inUse := strings.Repeat("c", 56)
+	compatibility := strings.Repeat("d", 56)
+	entry(stale, old)
+	entry(fresh, time.Now())
+	entry(inUse, old)
+	entry(compatibility, old)
+	entry("unrelated", old)
+
+	removed, err := PruneCache(root, []string{inUse, compatibility}, 30*24*time.Hour)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{stale}, removed)
+	assert.DirExists(t, filepath.Join(root, compatibility))
+
+	assert.NoDirExists(t, filepath.Join(root, stale))
+	assert.DirExists(t, filepath.Join(root, fresh))
+	assert.DirExists(t, filepath.Join(root, "unrelated"))
+
+	// The entry in use is kept and marked as used now.
+	info, err := os.Stat(filepath.Join(root, inUse))
+	assert.NoError(t, err)
+	assert.WithinDuration(t, time.Now(), info.ModTime(), time.Minute)
+
+	// A zero max age disables the cleanup, a missing root is not an error.
+	entry(stale, old)
+	removed, err = PruneCache(root, nil, 0)
+	assert.NoError(t, err)
+	assert.Empty(t, removed)
+	assert.DirExists(t, filepath.Join(root, stale))
+
+	removed, err = PruneCache(filepath.Join(root, "missing"), []string{inUse}, time.Hour)
+	assert.NoError(t, err)
+	assert.Empty(t, removed)
+}
+
+func TestTouchCacheEntry(t *testing.T) {
+	cacheDir := t.TempDir()
+	t.Setenv("SA_NAMING_DIR", cacheDir)
+
+	old := time.Now().Add(-40 * 24 * time.Hour)
+	dir := filepath.Join(cacheDir, "entry")
+	assert.NoError(t, os.MkdirAll(dir, 0o755))
+	assert.NoError(t, os.Chtimes(dir, old, old))
+
+	assert.NoError(t, TouchCacheEntry("entry"))
+	info, err := os.Stat(dir)
+	assert.NoError(t, err)
+	assert.WithinDuration(t, time.Now(), info.ModTime(), time.Minute)
+
+	// A missing entry, e.g. of a local source, is not an error.
+	assert.NoError(t, TouchCacheEntry("missing"))
+}
+
+func TestCachePath(t *testing.T) {
+	cacheDir := t.TempDir()
+	t.Setenv("SA_NAMING_DIR", cacheDir)
+
+	assert.Equal(t, filepath.Join(cacheDir, "entry"), cachePath("entry"))
+	abs := filepath.Join(t.TempDir(), "entry")
+	assert.Equal(t, abs, cachePath(abs))
+}
@@ -0,0 +1,125 @@
+// Copyright glueckkanja AG 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package schema
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+)
+
+// SchemaChange is a changed field of a resource type between two libraries.
+// A change is breaking if names built with the old library change or become
+// invalid with the new library.
+type SchemaChange struct {
+	ResourceType string
+	Field        string
+	From         string
+	To           string
+	Breaking     bool
+}
+
+// SchemaDiff is the difference between two schema libraries.
+type SchemaDiff struct {
+	Added   []string
+	Removed []string
+	Changed []SchemaChange
+}
+
+// HasBreakingChanges reports whether resource types were removed or changed in
+// a breaking way.
+func (d SchemaDiff) HasBreakingChanges() bool {
+	return len(d.Removed) > 0 || slices.ContainsFunc(d.Changed, func(c SchemaChange) bool { return c.Breaking })
+}
+
+// DiffNamingSchemas compares the naming schemas of two libraries. Added and
+// removed resource types are sorted, changes are sorted by resource type and
+// field. If a resource type is defined more than once, the last definition wins
+// like in NewNamingSchemaMap.
+func DiffNamingSchemas(from, to []JsonNamingSchema) SchemaDiff {
+	fromMap := make(JsonNamingSchemaMap, len(from))
+	for _, s := range from {
+		fromMap[s.ResourceType] = s
+	}
+	toMap := make(JsonNamingSchemaMap, len(to))
+	for _, s := range to {
+		toMap[s.ResourceType] = s
+	}
+
+	diff := SchemaDiff{
+		Added:   make([]string, 0),
+		Removed: make([]string, 0),
+		Changed: make([]SchemaChange, 0),
+	}
+
+	for rt := range toMap {
+		if _, ok := fromMap[rt]; !ok {
+			diff.Added = append(diff.Added, rt)
+		}
+	}
+
+	for rt, old := range fromMap {
+		current, ok := toMap[rt]
+		if !ok {
+			diff.Removed = append(diff.Removed, rt)
+			continue
+		}
+		diff.Changed = append(diff.Changed, diffNamingSchema(rt, old, current)...)
+	}
+
+	sort.Strings(diff.Added)
+	sort.Strings(diff.Removed)
+	sort.SliceStable(diff.Changed, func(i, j int) bool {
+		if diff.Changed[i].ResourceType != diff.Changed[j].ResourceType {
+			return diff.Changed[i].ResourceType < diff.Changed[j].ResourceType
+		}
+		return diff.Changed[i].Field < diff.Changed[j].Field
+	})
+
+	return diff
+}
+
+func diffNamingSchema(rt string, from, to JsonNamingSchema) []SchemaChange {
+	changes := make([]SchemaChange, 0)
+	add := func(field string, fromValue, toValue any, breaking bool) {
+		f, t := fmt.Sprint(fromValue), fmt.Sprint(toValue)
+		if f == t {
+			return
+		}
+		changes = append(changes, SchemaChange{ResourceType: rt, Field: field, From: f, To: t, Breaking: breaking})
+	}
+
+	fc, tc := from.Configuration, to.Configuration
+
+	// Fields that change the built name are always breaking.
+	add("abbreviation", from.Abbreviation, to.Abbreviation, true)
+	add("configuration.namePrecedence", strings.Join(fc.NamePrecedence, ","), strings.Join(tc.NamePrecedence, ","), true)
+	add("configuration.useEnvironment", fc.UseEnvironment, tc.UseEnvironment, true)
+	add("configuration.useLowerCase", fc.UseLowerCase, tc.UseLowerCase, true)
+	add("configuration.useUpperCase", fc.UseUpperCase, tc.UseUpperCase, true)
+	add("configuration.useSeparator", fc.UseSeparator, tc.UseSeparator, true)
+	add("configuration.separator", fc.Separator, tc.Separator, true)
+	add("configuration.hashLength", fc.HashLength, tc.HashLength, true)
+	add("configuration.defaultPrefixes", strings.Join(fc.DefaultPrefixes, ","), strings.Join(tc.DefaultPrefixes, ","), true)
+	add("configuration.defaultSuffixes", strings.Join(fc.DefaultSuffixes, ","), strings.Join(tc.DefaultSuffixes, ","), true)
+
+	// Fields that may reject names that were valid before.
+	add("minLength", from.MinLength, to.MinLength, to.MinLength > from.MinLength)
+	add("maxLength", from.MaxLength, to.MaxLength, to.MaxLength < from.MaxLength)
+	add("validationRegex", from.ValidationRegex, to.ValidationRegex, true)
+	add("configuration.denyDoubleHyphens", fc.DenyDoubleHyphens, tc.DenyDoubleHyphens, tc.DenyDoubleHyphens)
+	add("configuration.denyRepeatedSeparator", fc.DenyRepeatedSeparator, tc.DenyRepeatedSeparator, tc.DenyRepeatedSeparator)
+	add("configuration.denyLeading", fc.DenyLeading, tc.DenyLeading, tc.DenyLeading != "")
+	add("configuration.denyTrailing", fc.DenyTrailing, tc.DenyTrailing, tc.DenyTrailing != "")
+	add("configuration.denyPatterns", strings.Join(fc.DenyPatterns, ","), strings.Join(tc.DenyPatterns, ","), len(tc.DenyPatterns) > 0)
+	add("configuration.requiredSegments", strings.Join(fc.RequiredSegments, ","), strings.Join(tc.RequiredSegments, ","), len(tc.RequiredSegments) > 0)
+
+	// Informational fields.
+	add("deprecated", from.Deprecated, to.Deprecated, false)
+	add("replacedBy", from.Replacement(), to.Replacement(), false)
+	add("scope", from.Scope, to.Scope, false)
+
+	return changes
+}
@@ -0,0 +1,37 @@
+// Copyright glueckkanja AG 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package schema
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiffNamingSchemas(t *testing.T) {
+	from := []JsonNamingSchema{
+		{ResourceType: "azurerm_storage_account", Abbreviation: "st", MaxLength: 24, ValidationRegex: "^[a-z0-9]{3,24}$"},
+		{ResourceType: "azurerm_resource_group", Abbreviation: "rg", MaxLength: 90},
+		{ResourceType: "azurerm_key_vault", Abbreviation: "kv", MaxLength: 24},
+	}
+	to := []JsonNamingSchema{
+		{ResourceType: "azurerm_storage_account", Abbreviation: "st", MaxLength: 20, ValidationRegex: "^[a-z0-9]{3,20}$"},
+		{ResourceType: "azurerm_resource_group", Abbreviation: "rg", MaxLength: 100, Deprecated: true},
+		{ResourceType: "azurerm_virtual_network", Abbreviation: "vnet", MaxLength: 64},
+	}
+
+	diff := DiffNamingSchemas(from, to)
+
+	assert.Equal(t, []string{"azurerm_virtual_network"}, diff.Added)
+	assert.Equal(t, []string{"azurerm_key_vault"}, diff.Removed)
+	assert.Equal(t, []SchemaChange{
+		{ResourceType: "azurerm_resource_group", Field: "deprecated", From: "false", To: "true"},
+		{ResourceType: "azurerm_resource_group", Field: "maxLength", From: "90", To: "100"},
+		{ResourceType: "azurerm_storage_account", Field: "maxLength", From: "24", To: "20", Breaking: true},
+		{ResourceType: "azurerm_storage_account", Field: "validationRegex", From: "^[a-z0-9]{3,24}$", To: "^[a-z0-9]{3,20}$", Breaking: true},
+	}, diff.Changed)
+	assert.True(t, diff.HasBreakingChanges())
+
+	assert.False(t, DiffNamingSchemas(to[1:2], to[1:2]).HasBreakingChanges())
+}
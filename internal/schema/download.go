@@ -5,28 +5,188 @@ package schema
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
 	"fmt"
 	"github.com/hashicorp/go-getter/v2"
 	"io/fs"
 	"net/url"
 	"os"
+	"os/exec"
 	"path/filepath"
+	"regexp"
+	"strings"
 	"terraform-provider-standesamt/internal/tools"
 )
 
+// cacheHashFile holds the content hash of a downloaded schema library. It is
+// written after a download of an immutable ref of the default library and
+// checked before the cached download is reused.
+const cacheHashFile = ".standesamt-cache-hash"
+
+// DownloadFromDefaultSource downloads path at ref of the default schema library.
+// A ref that is an immutable release tag is not downloaded again if dstDir
+// holds an intact download of it, mutable refs like main are always refreshed.
 func DownloadFromDefaultSource(ctx context.Context, path, ref, dstDir string) (fs.FS, error) {
-	q := url.Values{}
-	q.Add("ref", ref)
+	immutable, err := IsImmutableRef(ref)
+	if err != nil {
+		return nil, err
+	}
+	if immutable {
+		if f, ok := cachedDownload(cachePath(dstDir)); ok {
+			return f, nil
+		}
+	}
 
 	gitUrl := tools.NamingSchemaGitUrl()
 
+	// The shallow sparse clone only fetches the requested path at ref. Refs it
+	// cannot check out, e.g. a commit, fall back to a full clone by go-getter.
+	dst := cachePath(dstDir)
+	if err := shallowClone(ctx, gitCloneUrl(gitUrl), ref, path, dst); err == nil {
+		if immutable {
+			writeCacheHash(dst)
+		}
+		return os.DirFS(dst), nil
+	} else if ctxErr := ctx.Err(); ctxErr != nil {
+		return nil, downloadContextError(gitUrl, ctxErr)
+	}
+
+	q := url.Values{}
+	q.Add("ref", ref)
+
 	u := fmt.Sprintf("git::%s//%s?%s", gitUrl, path, q.Encode())
-	return DownloadFromCustomSource(ctx, u, dstDir)
+	f, err := DownloadFromCustomSource(ctx, u, dstDir)
+	if err == nil && immutable {
+		writeCacheHash(dst)
+	}
+	return f, err
+}
+
+// gitCloneUrl returns the URL git clones the library from. Host paths like the
+// default github.com/glueckkanja/standesamt-schema-library are cloned via https.
+func gitCloneUrl(gitUrl string) string {
+	if strings.Contains(gitUrl, "://") || strings.HasPrefix(gitUrl, "git@") {
+		return gitUrl
+	}
+	return "https://" + gitUrl
+}
+
+// shallowClone clones ref of the repository at gitUrl with depth 1 and a sparse
+// checkout of path, and copies path to dst. Only the files below path are
+// downloaded, instead of the full history of all libraries in the repository.
+func shallowClone(ctx context.Context, gitUrl, ref, path, dst string) error {
+	tmp, err := os.MkdirTemp("", "standesamt-git")
+	if err != nil {
+		return err
+	}
+	defer func() { _ = os.RemoveAll(tmp) }()
+
+	for _, args := range [][]string{
+		{"clone", "--quiet", "--depth", "1", "--branch", ref, "--filter=blob:none", "--sparse", "--", gitUrl, tmp},
+		{"-C", tmp, "sparse-checkout", "set", "--", path},
+	} {
+		cmd := exec.CommandContext(ctx, "git", args...)
+		cmd.Env = append(os.Environ(), "GIT_TERMINAL_PROMPT=0")
+		if out, err := cmd.CombinedOutput(); err != nil {
+			return fmt.Errorf("git %s: %w: %s", args[0], err, strings.TrimSpace(string(out)))
+		}
+	}
+
+	src := filepath.Join(tmp, filepath.FromSlash(path))
+	if info, err := os.Stat(src); err != nil || !info.IsDir() {
+		return fmt.Errorf("path `%s` not found at ref `%s`", path, ref)
+	}
+
+	if err := os.RemoveAll(dst); err != nil {
+		return fmt.Errorf("error cleaning destination directory %s: %w", dst, err)
+	}
+	return os.CopyFS(dst, os.DirFS(src))
 }
 
+// IsImmutableRef reports whether ref matches the immutable ref pattern, i.e.
+// is a release tag whose content does not change.
+func IsImmutableRef(ref string) (bool, error) {
+	pattern := tools.NamingSchemaImmutableRefPattern()
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return false, fmt.Errorf("invalid immutable ref pattern `%s`: %w", pattern, err)
+	}
+	return re.MatchString(ref), nil
+}
+
+// cachedDownload returns the download in dst if its content still matches the
+// hash written after the download, so partial or modified downloads are
+// downloaded again.
+func cachedDownload(dst string) (fs.FS, bool) {
+	want, err := os.ReadFile(filepath.Join(dst, cacheHashFile))
+	if err != nil {
+		return nil, false
+	}
+	got, err := contentHash(os.DirFS(dst))
+	if err != nil || got != string(want) {
+		return nil, false
+	}
+	return os.DirFS(dst), true
+}
+
+// writeCacheHash writes the content hash of the download in dst. A missing hash
+// only disables the reuse of the download.
+func writeCacheHash(dst string) {
+	if sum, err := contentHash(os.DirFS(dst)); err == nil {
+		_ = os.WriteFile(filepath.Join(dst, cacheHashFile), []byte(sum), 0o644)
+	}
+}
+
+// contentHash returns a hash over the paths and contents of all files in fsys,
+// except the git metadata and the cache hash file itself.
+func contentHash(fsys fs.FS) (string, error) {
+	h := sha256.New()
+	err := fs.WalkDir(fsys, ".", func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			if d.Name() == ".git" {
+				return fs.SkipDir
+			}
+			return nil
+		}
+		if p == cacheHashFile {
+			return nil
+		}
+		data, err := fs.ReadFile(fsys, p)
+		if err != nil {
+			return err
+		}
+		_, _ = fmt.Fprintf(h, "%s\x00%d\x00", p, len(data))
+		_, _ = h.Write(data)
+		return nil
+	})
+	if err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// cachePath returns the path of dstDir below the cache directory. An absolute
+// dstDir, e.g. below the cache_dir of the provider, is used as is.
+func cachePath(dstDir string) string {
+	if filepath.IsAbs(dstDir) {
+		return dstDir
+	}
+	return filepath.Join(tools.NamingSchemaCacheDir(), dstDir)
+}
+
+// DownloadFromCustomSource downloads src into dstDir below the cache directory.
+// The download is stopped when ctx is cancelled or its deadline is exceeded.
 func DownloadFromCustomSource(ctx context.Context, src, dstDir string) (fs.FS, error) {
-	rootDir := tools.NamingSchemaCacheDir()
-	dst := filepath.Join(rootDir, dstDir)
+	if err := ctx.Err(); err != nil {
+		return nil, downloadContextError(src, err)
+	}
+
+	dst := cachePath(dstDir)
 	client := getter.Client{
 		DisableSymlinks: true,
 	}
@@ -46,9 +206,21 @@ func DownloadFromCustomSource(ctx context.Context, src, dstDir string) (fs.FS, e
 	}
 
 	_, err = client.Get(ctx, req)
+	if ctxErr := ctx.Err(); ctxErr != nil {
+		// go-getter does not always surface the context error, e.g. when the
+		// git process was killed, so report the cancellation explicitly.
+		return nil, downloadContextError(src, ctxErr)
+	}
 	if err != nil {
 		return nil, fmt.Errorf("error downloading schema. source `%s`, destination `%s`, wd `%s`: %w", src, dst, wd, err)
 	}
 
 	return os.DirFS(dst), nil
 }
+
+func downloadContextError(src string, err error) error {
+	if errors.Is(err, context.DeadlineExceeded) {
+		return fmt.Errorf("timed out downloading schema from source `%s`, consider increasing `download_timeout`: %w", src, err)
+	}
+	return fmt.Errorf("download of schema from source `%s` was cancelled: %w", src, err)
+}
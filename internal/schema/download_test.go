@@ -0,0 +1,147 @@
+// Copyright glueckkanja AG 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package schema
+
+import (
+	"context"
+	"io/fs"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+	"testing/fstest"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDownloadFromCustomSource_Cancelled(t *testing.T) {
+	t.Setenv("SA_NAMING_DIR", t.TempDir())
+
+	ctx, cancel := context.WithCancel(t.Context())
+	cancel()
+
+	_, err := DownloadFromCustomSource(ctx, "git::https://example.com/library.git", "cancelled")
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.ErrorContains(t, err, "was cancelled")
+}
+
+func TestDownloadFromCustomSource_DeadlineExceeded(t *testing.T) {
+	t.Setenv("SA_NAMING_DIR", t.TempDir())
+
+	ctx, cancel := context.WithDeadline(t.Context(), time.Now().Add(-time.Second))
+	defer cancel()
+
+	_, err := DownloadFromCustomSource(ctx, "git::https://example.com/library.git", "deadline")
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.ErrorContains(t, err, "download_timeout")
+}
+
+func TestDownloadFromDefaultSource_ImmutableRefCached(t *testing.T) {
+	cacheDir := t.TempDir()
+	t.Setenv("SA_NAMING_DIR", cacheDir)
+
+	dst := filepath.Join(cacheDir, "cached")
+	assert.NoError(t, os.MkdirAll(dst, 0o755))
+	assert.NoError(t, os.WriteFile(filepath.Join(dst, "schema.naming.json"), []byte(`[]`), 0o644))
+	sum, err := contentHash(os.DirFS(dst))
+	assert.NoError(t, err)
+	assert.NoError(t, os.WriteFile(filepath.Join(dst, cacheHashFile), []byte(sum), 0o644))
+
+	// A cancelled context fails every download, so a result means the cache was used.
+	ctx, cancel := context.WithCancel(t.Context())
+	cancel()
+
+	f, err := DownloadFromDefaultSource(ctx, "azure/caf", "2025.04", "cached")
+	assert.NoError(t, err)
+	data, err := fs.ReadFile(f, "schema.naming.json")
+	assert.NoError(t, err)
+	assert.Equal(t, "[]", string(data))
+
+	_, err = DownloadFromDefaultSource(ctx, "azure/caf", "main", "cached")
+	assert.ErrorIs(t, err, context.Canceled)
+
+	// A modified download is not reused.
+	assert.NoError(t, os.WriteFile(filepath.Join(dst, "schema.naming.json"), []byte(`[{}]`), 0o644))
+	_, err = DownloadFromDefaultSource(ctx, "azure/caf", "2025.04", "cached")
+	assert.ErrorIs(t, err, context.Canceled)
+}
+
+func TestDownloadFromDefaultSource_ShallowClone(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not installed")
+	}
+
+	repo := t.TempDir()
+	git := func(args ...string) {
+		t.Helper()
+		cmd := exec.Command("git", append([]string{"-C", repo}, args...)...)
+		cmd.Env = append(os.Environ(), "GIT_AUTHOR_NAME=test", "GIT_AUTHOR_EMAIL=test@example.com", "GIT_COMMITTER_NAME=test", "GIT_COMMITTER_EMAIL=test@example.com")
+		out, err := cmd.CombinedOutput()
+		assert.NoError(t, err, string(out))
+	}
+	git("init", "--quiet")
+	for name, content := range map[string]string{"azure/caf/schema.naming.json": `[]`, "other/schema.naming.json": `[{}]`} {
+		assert.NoError(t, os.MkdirAll(filepath.Dir(filepath.Join(repo, name)), 0o755))
+		assert.NoError(t, os.WriteFile(filepath.Join(repo, name), []byte(content), 0o644))
+	}
+	git("add", "-A")
+	git("commit", "--quiet", "-m", "library")
+	git("tag", "2025.04")
+
+	cacheDir := t.TempDir()
+	t.Setenv("SA_NAMING_DIR", cacheDir)
+	t.Setenv("SA_NAMING_GIT_URL", "file://"+filepath.ToSlash(repo))
+
+	f, err := DownloadFromDefaultSource(t.Context(), "azure/caf", "2025.04", "shallow")
+	assert.NoError(t, err)
+	data, err := fs.ReadFile(f, "schema.naming.json")
+	assert.NoError(t, err)
+	assert.Equal(t, "[]", string(data))
+
+	// Only the requested path is checked out, and the download is cached.
+	_, err = os.Stat(filepath.Join(cacheDir, "shallow", "other"))
+	assert.True(t, os.IsNotExist(err))
+	_, ok := cachedDownload(filepath.Join(cacheDir, "shallow"))
+	assert.True(t, ok)
+}
+
+func TestGitCloneUrl(t *testing.T) {
+	assert.Equal(t, "https://github.com/glueckkanja/standesamt-schema-library", gitCloneUrl("github.com/glueckkanja/standesamt-schema-library"))
+	assert.Equal(t, "https://example.com/library.git", gitCloneUrl("https://example.com/library.git"))
+	assert.Equal(t, "git@example.com:library.git", gitCloneUrl("git@example.com:library.git"))
+}
+
+func TestIsImmutableRef(t *testing.T) {
+	for ref, want := range map[string]bool{"2025.04": true, "v1.2.0": true, "main": false, "feature/x": false} {
+		got, err := IsImmutableRef(ref)
+		assert.NoError(t, err)
+		assert.Equal(t, want, got, ref)
+	}
+
+	t.Setenv("SA_NAMING_IMMUTABLE_REF", "[")
+	_, err := IsImmutableRef("2025.04")
+	assert.ErrorContains(t, err, "invalid immutable ref pattern")
+}
+
+func TestFSSource(t *testing.T) {
+	library := fstest.MapFS{
+		"schema.naming.json": {Data: []byte(`[{"resourceType":"azurerm_resource_group","abbreviation":"rg"}]`)},
+	}
+
+	source := NewFSSource("testdata", library)
+	assert.Equal(t, "fs::testdata", source.String())
+
+	f, err := source.Download(t.Context(), "ignored")
+	assert.NoError(t, err)
+
+	var res Result
+	assert.NoError(t, NewProcessorClient(f).Process(&res))
+	assert.Equal(t, "rg", res.NamingSchemas[0].Abbreviation)
+
+	ctx, cancel := context.WithCancel(t.Context())
+	cancel()
+	_, err = source.Download(ctx, "ignored")
+	assert.ErrorIs(t, err, context.Canceled)
+}
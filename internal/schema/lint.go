@@ -0,0 +1,116 @@
+// Copyright glueckkanja AG 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package schema
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"sort"
+)
+
+const (
+	LintSeverityError   = "error"
+	LintSeverityWarning = "warning"
+)
+
+// LintFinding is a single structural problem in a schema library.
+type LintFinding struct {
+	ResourceType string
+	Severity     string
+	Check        string
+	Message      string
+}
+
+// Lint runs structural checks over the naming schemas of a library. It reports
+// problems that otherwise only surface when a name is built, e.g. regexes that
+// do not compile or a minimum length above the maximum length. Findings are
+// sorted by resource type and check.
+func Lint(schemas []JsonNamingSchema) []LintFinding {
+	findings := make([]LintFinding, 0)
+	add := func(resourceType, severity, check, format string, args ...any) {
+		findings = append(findings, LintFinding{
+			ResourceType: resourceType,
+			Severity:     severity,
+			Check:        check,
+			Message:      fmt.Sprintf(format, args...),
+		})
+	}
+
+	seen := make(map[string]int, len(schemas))
+	for _, s := range schemas {
+		seen[s.ResourceType]++
+	}
+
+	for _, s := range schemas {
+		rt := s.ResourceType
+
+		if rt == "" {
+			add(rt, LintSeverityError, "resource_type", "resource type must not be empty")
+		}
+
+		if seen[rt] > 1 {
+			add(rt, LintSeverityError, "duplicate_resource_type", "resource type '%s' is defined %d times", rt, seen[rt])
+			// Report every duplicate only once.
+			seen[rt] = 0
+		}
+
+		if s.ValidationRegex == "" {
+			add(rt, LintSeverityWarning, "validation_regex", "validation regex is empty")
+		} else if _, err := regexp.Compile(s.ValidationRegex); err != nil {
+			add(rt, LintSeverityError, "validation_regex", "invalid validation regex '%s': %s", s.ValidationRegex, err)
+		}
+
+		for _, pattern := range s.Configuration.DenyPatterns {
+			if _, err := regexp.Compile(pattern); err != nil {
+				add(rt, LintSeverityError, "deny_patterns", "invalid deny pattern '%s': %s", pattern, err)
+			}
+		}
+
+		if s.MinLength < 0 {
+			add(rt, LintSeverityError, "length", "minLength %d must not be negative", s.MinLength)
+		}
+		if s.MaxLength <= 0 {
+			add(rt, LintSeverityError, "length", "maxLength %d must be positive", s.MaxLength)
+		} else if s.MinLength > s.MaxLength {
+			add(rt, LintSeverityError, "length", "minLength %d is greater than maxLength %d", s.MinLength, s.MaxLength)
+		}
+
+		if s.Configuration.HashLength < 0 {
+			add(rt, LintSeverityError, "hash_length", "hashLength %d must not be negative", s.Configuration.HashLength)
+		} else if s.MaxLength > 0 && s.Configuration.HashLength > s.MaxLength {
+			add(rt, LintSeverityWarning, "hash_length", "hashLength %d exceeds maxLength %d", s.Configuration.HashLength, s.MaxLength)
+		}
+
+		if s.Scope != "" && !slices.Contains(Scopes[:], s.Scope) {
+			add(rt, LintSeverityError, "scope", "unknown scope '%s', expected one of: %v", s.Scope, Scopes)
+		}
+
+		if s.Configuration.UseLowerCase && s.Configuration.UseUpperCase {
+			add(rt, LintSeverityWarning, "casing", "useLowerCase and useUpperCase are both set, useUpperCase wins")
+		}
+
+		tokens := make([]string, 0, len(s.Configuration.NamePrecedence))
+		for _, token := range s.Configuration.NamePrecedence {
+			if !slices.Contains(NamePrecedenceTokens, token) {
+				add(rt, LintSeverityError, "name_precedence", "unknown name precedence token '%s', expected one of: %v", token, NamePrecedenceTokens)
+			} else if slices.Contains(tokens, token) {
+				add(rt, LintSeverityWarning, "name_precedence", "name precedence token '%s' is listed more than once", token)
+			}
+			tokens = append(tokens, token)
+		}
+		if len(tokens) > 0 && !slices.Contains(tokens, "name") {
+			add(rt, LintSeverityWarning, "name_precedence", "name precedence does not contain 'name'")
+		}
+	}
+
+	sort.SliceStable(findings, func(i, j int) bool {
+		if findings[i].ResourceType != findings[j].ResourceType {
+			return findings[i].ResourceType < findings[j].ResourceType
+		}
+		return findings[i].Check < findings[j].Check
+	})
+
+	return findings
+}
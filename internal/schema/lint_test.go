@@ -0,0 +1,72 @@
+// Copyright glueckkanja AG 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package schema
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func validLintSchema(resourceType string) JsonNamingSchema {
+	return JsonNamingSchema{
+		ResourceType:    resourceType,
+		Abbreviation:    "rg",
+		MinLength:       1,
+		MaxLength:       90,
+		ValidationRegex: "^[a-z-]+$",
+		Configuration: JsonConfigurationSchema{
+			NamePrecedence: []string{"abbreviation", "name", "environment"},
+		},
+	}
+}
+
+func TestLint_Valid(t *testing.T) {
+	findings := Lint([]JsonNamingSchema{validLintSchema("azurerm_resource_group")})
+	assert.NotNil(t, findings)
+	assert.Empty(t, findings)
+}
+
+func TestLint_Findings(t *testing.T) {
+	invalidRegex := validLintSchema("invalid_regex")
+	invalidRegex.ValidationRegex = "^[a-z"
+	invalidRegex.Configuration.DenyPatterns = []string{"(?i)ok", "("}
+
+	length := validLintSchema("length")
+	length.MinLength = 10
+	length.MaxLength = 5
+
+	precedence := validLintSchema("precedence")
+	precedence.Configuration.NamePrecedence = []string{"abbreviation", "region", "abbreviation"}
+
+	scope := validLintSchema("scope")
+	scope.Scope = "subscription"
+
+	findings := Lint([]JsonNamingSchema{
+		validLintSchema("duplicate"),
+		validLintSchema("duplicate"),
+		invalidRegex,
+		length,
+		precedence,
+		scope,
+	})
+
+	type key struct{ resourceType, severity, check string }
+	got := make([]key, 0, len(findings))
+	for _, f := range findings {
+		got = append(got, key{f.ResourceType, f.Severity, f.Check})
+	}
+
+	assert.Equal(t, []key{
+		{"duplicate", LintSeverityError, "duplicate_resource_type"},
+		{"invalid_regex", LintSeverityError, "deny_patterns"},
+		{"invalid_regex", LintSeverityError, "validation_regex"},
+		{"length", LintSeverityError, "length"},
+		{"precedence", LintSeverityError, "name_precedence"},
+		{"precedence", LintSeverityWarning, "name_precedence"},
+		{"precedence", LintSeverityWarning, "name_precedence"},
+		{"scope", LintSeverityError, "scope"},
+	}, got)
+	assert.Equal(t, "minLength 10 is greater than maxLength 5", findings[3].Message)
+}
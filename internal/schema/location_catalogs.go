@@ -0,0 +1,120 @@
+// Copyright glueckkanja AG 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package schema
+
+import (
+	"maps"
+	"slices"
+)
+
+const (
+	// LocationCatalogAWS is the location catalog of the AWS regions.
+	LocationCatalogAWS = "aws"
+	// LocationCatalogGCP is the location catalog of the Google Cloud regions.
+	LocationCatalogGCP = "gcp"
+)
+
+// AWSLocations are the short codes of the AWS regions, built from the area,
+// the direction and the number of the region, e.g. eu-central-1 → euc1.
+var AWSLocations = LocationsMapSchema{
+	"af-south-1":     "afs1",
+	"ap-east-1":      "ape1",
+	"ap-northeast-1": "apne1",
+	"ap-northeast-2": "apne2",
+	"ap-northeast-3": "apne3",
+	"ap-south-1":     "aps1",
+	"ap-south-2":     "aps2",
+	"ap-southeast-1": "apse1",
+	"ap-southeast-2": "apse2",
+	"ap-southeast-3": "apse3",
+	"ap-southeast-4": "apse4",
+	"ca-central-1":   "cac1",
+	"ca-west-1":      "caw1",
+	"eu-central-1":   "euc1",
+	"eu-central-2":   "euc2",
+	"eu-north-1":     "eun1",
+	"eu-south-1":     "eus1",
+	"eu-south-2":     "eus2",
+	"eu-west-1":      "euw1",
+	"eu-west-2":      "euw2",
+	"eu-west-3":      "euw3",
+	"il-central-1":   "ilc1",
+	"me-central-1":   "mec1",
+	"me-south-1":     "mes1",
+	"sa-east-1":      "sae1",
+	"us-east-1":      "use1",
+	"us-east-2":      "use2",
+	"us-gov-east-1":  "usge1",
+	"us-gov-west-1":  "usgw1",
+	"us-west-1":      "usw1",
+	"us-west-2":      "usw2",
+}
+
+// GCPLocations are the short codes of the Google Cloud regions, built from the
+// area, the direction and the number of the region, e.g. europe-west3 → euw3.
+var GCPLocations = LocationsMapSchema{
+	"africa-south1":           "afs1",
+	"asia-east1":              "ase1",
+	"asia-east2":              "ase2",
+	"asia-northeast1":         "asne1",
+	"asia-northeast2":         "asne2",
+	"asia-northeast3":         "asne3",
+	"asia-south1":             "ass1",
+	"asia-south2":             "ass2",
+	"asia-southeast1":         "asse1",
+	"asia-southeast2":         "asse2",
+	"australia-southeast1":    "ause1",
+	"australia-southeast2":    "ause2",
+	"europe-central2":         "euc2",
+	"europe-north1":           "eun1",
+	"europe-southwest1":       "eusw1",
+	"europe-west1":            "euw1",
+	"europe-west10":           "euw10",
+	"europe-west12":           "euw12",
+	"europe-west2":            "euw2",
+	"europe-west3":            "euw3",
+	"europe-west4":            "euw4",
+	"europe-west6":            "euw6",
+	"europe-west8":            "euw8",
+	"europe-west9":            "euw9",
+	"me-central1":             "mec1",
+	"me-central2":             "mec2",
+	"me-west1":                "mew1",
+	"northamerica-northeast1": "nane1",
+	"northamerica-northeast2": "nane2",
+	"southamerica-east1":      "sae1",
+	"southamerica-west1":      "saw1",
+	"us-central1":             "usc1",
+	"us-east1":                "use1",
+	"us-east4":                "use4",
+	"us-east5":                "use5",
+	"us-south1":               "uss1",
+	"us-west1":                "usw1",
+	"us-west2":                "usw2",
+	"us-west3":                "usw3",
+	"us-west4":                "usw4",
+}
+
+// locationCatalogs are the location catalogs bundled with the provider keyed by
+// name. They need no cloud credentials and are updated with provider releases.
+var locationCatalogs = map[string]LocationsMapSchema{
+	LocationCatalogAWS: AWSLocations,
+	LocationCatalogGCP: GCPLocations,
+}
+
+// LocationCatalogNames returns the sorted names of the bundled location
+// catalogs.
+func LocationCatalogNames() []string {
+	return slices.Sorted(maps.Keys(locationCatalogs))
+}
+
+// LocationCatalog returns a copy of the bundled location catalog of name and
+// whether it exists.
+func LocationCatalog(name string) (LocationsMapSchema, bool) {
+	catalog, ok := locationCatalogs[name]
+	if !ok {
+		return nil, false
+	}
+	return maps.Clone(catalog), true
+}
@@ -0,0 +1,41 @@
+// Copyright glueckkanja AG 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package schema
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLocationCatalog(t *testing.T) {
+	assert.Equal(t, []string{LocationCatalogAWS, LocationCatalogGCP}, LocationCatalogNames())
+
+	aws, ok := LocationCatalog(LocationCatalogAWS)
+	assert.True(t, ok)
+	assert.Equal(t, "euc1", aws["eu-central-1"])
+
+	gcp, ok := LocationCatalog(LocationCatalogGCP)
+	assert.True(t, ok)
+	assert.Equal(t, "euw3", gcp["europe-west3"])
+
+	// The catalog is a copy, changes do not leak into the bundled catalog.
+	aws["eu-central-1"] = "fra"
+	assert.Equal(t, "euc1", AWSLocations["eu-central-1"])
+
+	_, ok = LocationCatalog("azure")
+	assert.False(t, ok)
+}
+
+func TestLocationCatalogs_UniqueShortCodes(t *testing.T) {
+	for name, catalog := range locationCatalogs {
+		seen := map[string]string{}
+		for location, short := range catalog {
+			if other, ok := seen[short]; ok {
+				t.Errorf("catalog %s: %s and %s share the short code %s", name, location, other, short)
+			}
+			seen[short] = location
+		}
+	}
+}
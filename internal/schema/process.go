@@ -4,16 +4,23 @@
 package schema
 
 import (
+	"encoding/json"
 	"fmt"
+	"io"
 	"io/fs"
+	"path"
 	"path/filepath"
 	"slices"
 	"strings"
 )
 
 const (
-	schemaNamingFileName   = "schema.naming.json"
-	schemaLocationFileName = "schema.locations.json"
+	schemaNamingFileName       = "schema.naming.json"
+	schemaNamingFileSuffix     = ".naming.json"
+	schemaLocationFileName     = "schema.locations.json"
+	schemaAffixesFileName      = "schema.affixes.json"
+	schemaEnvironmentsFileName = "schema.environments.json"
+	libraryManifestFileName    = "library.json"
 )
 
 var supportedFileTypes = []string{".json"}
@@ -21,28 +28,82 @@ var supportedFileTypes = []string{".json"}
 type Result struct {
 	NamingSchemas []JsonNamingSchema
 	Locations     LocationsMapSchema
+	Affixes       AffixesMapSchema
+	Environments  EnvironmentsSchema
+
+	// LocationDetails are the details of the v2 locations files, nil if no
+	// file has details.
+	LocationDetails LocationDetailsMapSchema
+
+	// Library is the library.json manifest at the root of the library, nil if
+	// the library has none.
+	Library *LibraryManifest
+
+	// namingSources records the naming file of every resource type to report
+	// resource types that are defined in more than one naming file.
+	namingSources map[string]string
 }
 
 type unmarshaler struct {
-	d   []byte
-	ext string
+	d    []byte
+	ext  string
+	path string
 }
 
-func newUnmarshaler(data []byte, ext string) unmarshaler {
+func newUnmarshaler(data []byte, ext, path string) unmarshaler {
 	if !strings.HasPrefix(ext, ".") {
 		ext = "." + ext
 	}
 	return unmarshaler{
-		d:   data,
-		ext: ext,
+		d:    data,
+		ext:  ext,
+		path: path,
 	}
 }
 
 type processFunc func(result *Result, data unmarshaler) error
 
+// FilePatterns are the names of the naming and locations files of a library.
+// A pattern uses the syntax of path.Match and is matched against the base name
+// of a file, or against its path relative to the library root if the pattern
+// contains a slash. All matching files are processed. Empty patterns use the
+// default file names.
+type FilePatterns struct {
+	Naming    string
+	Locations string
+}
+
+// Validate reports malformed patterns.
+func (p FilePatterns) Validate() error {
+	for _, pattern := range []string{p.Naming, p.Locations} {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return fmt.Errorf("invalid file pattern '%s': %w", pattern, err)
+		}
+	}
+	return nil
+}
+
+// matchFilePattern reports whether the file at filePath matches the pattern,
+// falling back to the default file names for an empty pattern.
+func matchFilePattern(pattern string, defaultNames []string, filePath string) bool {
+	if pattern == "" {
+		base := strings.ToLower(path.Base(filePath))
+		return slices.ContainsFunc(defaultNames, func(n string) bool {
+			return base == n || strings.HasPrefix(n, ".") && strings.HasSuffix(base, n)
+		})
+	}
+	name := path.Base(filePath)
+	if strings.Contains(pattern, "/") {
+		name = filePath
+	}
+	ok, _ := path.Match(pattern, name)
+	return ok
+}
+
 // ProcessorClient is the client that is used to process the library files.
 type ProcessorClient struct {
-	fs fs.FS
+	fs       fs.FS
+	patterns FilePatterns
 }
 
 func NewProcessorClient(fs fs.FS) *ProcessorClient {
@@ -51,6 +112,12 @@ func NewProcessorClient(fs fs.FS) *ProcessorClient {
 	}
 }
 
+// WithFilePatterns sets the file name patterns of the naming and locations files.
+func (client *ProcessorClient) WithFilePatterns(patterns FilePatterns) *ProcessorClient {
+	client.patterns = patterns
+	return client
+}
+
 func (client *ProcessorClient) Process(res *Result) error {
 	if err := fs.WalkDir(client.fs, ".", func(path string, d fs.DirEntry, err error) error {
 		if err != nil {
@@ -68,21 +135,28 @@ func (client *ProcessorClient) Process(res *Result) error {
 		if err != nil {
 			return fmt.Errorf("ProcessorClient.Process: error opening file %s: %w", path, err)
 		}
-		return identifyFile(res, file, d.Name())
+		return client.identifyFile(res, file, path)
 	}); err != nil {
 		return err
 	}
 	return nil
 }
 
-func identifyFile(res *Result, file fs.File, name string) error {
+func (client *ProcessorClient) identifyFile(res *Result, file fs.File, filePath string) error {
 	err := error(nil)
 
-	switch n := strings.ToLower(name); {
-	case schemaNamingFileName == n:
-		err = readAndProcessFile(res, file, processNamingSchema)
-	case schemaLocationFileName == n:
-		err = readAndProcessFile(res, file, processLocationsMapSchema)
+	switch n := strings.ToLower(path.Base(filePath)); {
+	// The manifest is only read from the root, so libraries may vendor others.
+	case strings.EqualFold(filePath, libraryManifestFileName):
+		err = readAndProcessFile(res, file, filePath, processLibraryManifest)
+	case matchFilePattern(client.patterns.Naming, []string{schemaNamingFileName, schemaNamingFileSuffix}, filePath):
+		err = readAndProcessFile(res, file, filePath, processNamingSchema)
+	case matchFilePattern(client.patterns.Locations, []string{schemaLocationFileName}, filePath):
+		err = readAndProcessFile(res, file, filePath, processLocationsMapSchema)
+	case schemaAffixesFileName == n:
+		err = readAndProcessFile(res, file, filePath, processAffixesMapSchema)
+	case schemaEnvironmentsFileName == n:
+		err = readAndProcessFile(res, file, filePath, processEnvironmentsSchema)
 	}
 	if err != nil {
 		err = fmt.Errorf("classifyLibFile: error processing file: %w", err)
@@ -96,7 +170,27 @@ func processNamingSchema(res *Result, unmar unmarshaler) error {
 	if err != nil {
 		return fmt.Errorf("processNamingSchema: %w", err)
 	}
-	res.NamingSchemas = schemas
+
+	// Resource types may be split across naming files, e.g. one per provider,
+	// but every resource type must be defined in one file only.
+	if res.namingSources == nil {
+		res.namingSources = make(map[string]string)
+	}
+	for _, schema := range schemas {
+		if err := ValidateNamePrecedence(schema.Configuration.NamePrecedence); err != nil {
+			return fmt.Errorf("processNamingSchema: resource type '%s' in %s: %w", schema.ResourceType, unmar.path, err)
+		}
+		if err := ValidateRequiredSegments(schema.Configuration.RequiredSegments, schema.Configuration.NamePrecedence); err != nil {
+			return fmt.Errorf("processNamingSchema: resource type '%s' in %s: %w", schema.ResourceType, unmar.path, err)
+		}
+		if source, ok := res.namingSources[schema.ResourceType]; ok && source != unmar.path {
+			return fmt.Errorf("processNamingSchema: resource type '%s' in %s is already defined in %s", schema.ResourceType, unmar.path, source)
+		}
+	}
+	for _, schema := range schemas {
+		res.namingSources[schema.ResourceType] = unmar.path
+	}
+	res.NamingSchemas = append(res.NamingSchemas, schemas...)
 	return nil
 }
 
@@ -105,24 +199,69 @@ func processLocationsMapSchema(res *Result, unmar unmarshaler) error {
 	if err != nil {
 		return fmt.Errorf("processLocationsMapSchema: %w", err)
 	}
-	res.Locations = lm
+	if res.Locations == nil {
+		res.Locations = make(LocationsMapSchema, len(lm))
+	}
+	for k, v := range lm {
+		res.Locations[k] = v
+	}
+
+	details, err := loadLocationDetails(unmar.d)
+	if err != nil {
+		return fmt.Errorf("processLocationsMapSchema: %w", err)
+	}
+	if len(details) > 0 && res.LocationDetails == nil {
+		res.LocationDetails = make(LocationDetailsMapSchema, len(details))
+	}
+	for k, v := range details {
+		res.LocationDetails[k] = v
+	}
 	return nil
 }
 
-func readAndProcessFile(res *Result, file fs.File, processFn processFunc) error {
+func processAffixesMapSchema(res *Result, unmar unmarshaler) error {
+	am, err := loadAffixes(unmar.d)
+	if err != nil {
+		return fmt.Errorf("processAffixesMapSchema: %w", err)
+	}
+	res.Affixes = am
+	return nil
+}
+
+func processEnvironmentsSchema(res *Result, unmar unmarshaler) error {
+	es, err := loadEnvironments(unmar.d)
+	if err != nil {
+		return fmt.Errorf("processEnvironmentsSchema: %w", err)
+	}
+	res.Environments = es
+	return nil
+}
+
+func processLibraryManifest(res *Result, unmar unmarshaler) error {
+	var manifest LibraryManifest
+	if err := json.Unmarshal(unmar.d, &manifest); err != nil {
+		return fmt.Errorf("processLibraryManifest: failed to unmarshal %s: %w", unmar.path, err)
+	}
+	res.Library = &manifest
+	return nil
+}
+
+func readAndProcessFile(res *Result, file fs.File, filePath string, processFn processFunc) error {
 	s, err := file.Stat()
 	if err != nil {
 		return err
 	}
-	data := make([]byte, s.Size())
 	defer file.Close() // nolint: errcheck
-	if _, err := file.Read(data); err != nil {
+	// A single Read may return the data together with io.EOF, e.g. for files
+	// of a zip bundle.
+	data, err := io.ReadAll(file)
+	if err != nil {
 		return err
 	}
 
 	ext := filepath.Ext(s.Name())
 	// create a new unmarshaler
-	unmar := newUnmarshaler(data, ext)
+	unmar := newUnmarshaler(data, ext, filePath)
 
 	// pass the  data to the supplied process function
 	if err := processFn(res, unmar); err != nil {
@@ -0,0 +1,95 @@
+// Copyright glueckkanja AG 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package schema
+
+import (
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProcess_FilePatterns(t *testing.T) {
+	library := fstest.MapFS{
+		"schema.naming.json":              {Data: []byte(`[{"resourceType":"azurerm_default"}]`)},
+		"services/network.names.json":     {Data: []byte(`[{"resourceType":"azurerm_virtual_network"}]`)},
+		"services/storage.names.json":     {Data: []byte(`[{"resourceType":"azurerm_storage_account"}]`)},
+		"regions/europe.json":             {Data: []byte(`{"westeurope":"weu"}`)},
+		"regions/america.json":            {Data: []byte(`{"eastus":"eus"}`)},
+		"regions/schema.locations.backup": {Data: []byte(`not json`)},
+	}
+
+	var res Result
+	err := NewProcessorClient(library).WithFilePatterns(FilePatterns{
+		Naming:    "*.names.json",
+		Locations: "regions/*.json",
+	}).Process(&res)
+	require.NoError(t, err)
+
+	types := make([]string, 0, len(res.NamingSchemas))
+	for _, ns := range res.NamingSchemas {
+		types = append(types, ns.ResourceType)
+	}
+	assert.ElementsMatch(t, []string{"azurerm_virtual_network", "azurerm_storage_account"}, types)
+	assert.Equal(t, LocationsMapSchema{"westeurope": "weu", "eastus": "eus"}, res.Locations)
+
+	var defaults Result
+	require.NoError(t, NewProcessorClient(library).Process(&defaults))
+	require.Len(t, defaults.NamingSchemas, 1)
+	assert.Equal(t, "azurerm_default", defaults.NamingSchemas[0].ResourceType)
+
+	assert.Error(t, FilePatterns{Naming: "[a-"}.Validate())
+	assert.NoError(t, FilePatterns{Naming: "*.naming.json"}.Validate())
+}
+
+func TestProcess_MergeNamingFiles(t *testing.T) {
+	library := fstest.MapFS{
+		"azurerm/compute.naming.json": {Data: []byte(`[{"resourceType":"azurerm_linux_virtual_machine"}]`)},
+		"azurerm/network.naming.json": {Data: []byte(`{"version":2,"resources":[{"resourceType":"azurerm_virtual_network"}]}`)},
+		"azapi/schema.naming.json":    {Data: []byte(`[{"resourceType":"azapi_container_app"}]`)},
+	}
+
+	var res Result
+	require.NoError(t, NewProcessorClient(library).Process(&res))
+	assert.Len(t, res.NamingSchemas, 3)
+
+	library["azapi/network.naming.json"] = &fstest.MapFile{Data: []byte(`[{"resourceType":"azurerm_virtual_network"}]`)}
+	err := NewProcessorClient(library).Process(&Result{})
+	assert.ErrorContains(t, err, "resource type 'azurerm_virtual_network' in azurerm/network.naming.json is already defined in azapi/network.naming.json")
+}
+
+func TestProcess_InvalidNamePrecedence(t *testing.T) {
+	library := fstest.MapFS{
+		"schema.naming.json": {Data: []byte(`[{"resourceType":"azurerm_key_vault","configuration":{"namePrecedence":["abreviation","name"]}}]`)},
+	}
+
+	err := NewProcessorClient(library).Process(&Result{})
+	assert.ErrorContains(t, err, "resource type 'azurerm_key_vault' in schema.naming.json: unknown name precedence token 'abreviation'")
+}
+
+func TestProcess_LibraryManifest(t *testing.T) {
+	library := fstest.MapFS{
+		"library.json":        {Data: []byte(`{"name":"acme-naming","version":"1.4.0","authors":["Platform Team"],"changelog":"https://example.com/CHANGELOG.md"}`)},
+		"vendor/library.json": {Data: []byte(`{"name":"vendored","version":"0.1.0"}`)},
+		"schema.naming.json":  {Data: []byte(`[{"resourceType":"azurerm_resource_group"}]`)},
+	}
+
+	var res Result
+	require.NoError(t, NewProcessorClient(library).Process(&res))
+	assert.Equal(t, &LibraryManifest{
+		Name:      "acme-naming",
+		Version:   "1.4.0",
+		Authors:   []string{"Platform Team"},
+		Changelog: "https://example.com/CHANGELOG.md",
+	}, res.Library)
+
+	var none Result
+	delete(library, "library.json")
+	require.NoError(t, NewProcessorClient(library).Process(&none))
+	assert.Nil(t, none.Library)
+
+	library["library.json"] = &fstest.MapFile{Data: []byte(`{"name":`)}
+	assert.ErrorContains(t, NewProcessorClient(library).Process(&Result{}), "processLibraryManifest")
+}
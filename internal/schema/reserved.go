@@ -0,0 +1,71 @@
+// Copyright glueckkanja AG 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package schema
+
+import (
+	"strings"
+	"unicode"
+)
+
+// ReservedWords are reserved or trademarked terms that Azure Resource Manager
+// rejects when used as a resource name or as a word within it (see the
+// ReservedResourceName error). Words are matched case-insensitively.
+var ReservedWords = []string{
+	"ACCESS", "APP_CODE", "APP_THEMES", "APP_DATA", "APP_GLOBALRESOURCES",
+	"APP_LOCALRESOURCES", "APP_WEBREFERENCES", "APP_BROWSERS", "AZURE", "BING",
+	"BIZSPARK", "BIZTALK", "CORTANA", "DIRECTX", "DOTNET", "DYNAMICS", "EXCEL",
+	"EXCHANGE", "FOREFRONT", "GROOVE", "HOLOLENS", "HYPERV", "KINECT", "LYNC",
+	"MSDN", "O365", "OFFICE", "OFFICE365", "ONEDRIVE", "ONENOTE", "OUTLOOK",
+	"POWERPOINT", "SHAREPOINT", "SKYPE", "VISIO", "VISUALSTUDIO",
+}
+
+// ReservedSubstrings are terms that Azure Resource Manager rejects anywhere in
+// a resource name, e.g. "prod-login-app".
+var ReservedSubstrings = []string{"LOGIN", "MICROSOFT", "WINDOWS", "XBOX"}
+
+// AzureResourceTypePrefixes are the prefixes of the resource types of the
+// Terraform providers for Azure. The Azure specific checks only apply to them.
+var AzureResourceTypePrefixes = []string{"azurerm_", "azapi_", "azuread_"}
+
+// IsAzureResourceType reports whether resourceType is a resource type of a
+// Terraform provider for Azure.
+func IsAzureResourceType(resourceType string) bool {
+	for _, prefix := range AzureResourceTypePrefixes {
+		if strings.HasPrefix(resourceType, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// FindReservedWords returns the reserved words and substrings contained in
+// name, in the order they are listed. Reserved words only match whole words,
+// i.e. the full name or a part delimited by characters other than letters,
+// digits and underscores.
+func FindReservedWords(name string) []string {
+	found := make([]string, 0)
+	upper := strings.ToUpper(name)
+
+	words := make(map[string]bool)
+	words[upper] = true
+	for _, word := range strings.FieldsFunc(upper, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '_'
+	}) {
+		words[word] = true
+	}
+
+	for _, reserved := range ReservedWords {
+		if words[reserved] {
+			found = append(found, reserved)
+		}
+	}
+
+	for _, reserved := range ReservedSubstrings {
+		if strings.Contains(upper, reserved) {
+			found = append(found, reserved)
+		}
+	}
+
+	return found
+}
@@ -0,0 +1,40 @@
+// Copyright glueckkanja AG 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package schema
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFindReservedWords(t *testing.T) {
+	tests := []struct {
+		name string
+		want []string
+	}{
+		{name: "rg-app-we", want: []string{}},
+		{name: "prod-login-app", want: []string{"LOGIN"}},
+		{name: "Azure", want: []string{"AZURE"}},
+		{name: "rg-azure-we", want: []string{"AZURE"}},
+		{name: "azurerm", want: []string{}},
+		{name: "mymicrosoftapp", want: []string{"MICROSOFT"}},
+		{name: "office-windows", want: []string{"OFFICE", "WINDOWS"}},
+		{name: "app_data", want: []string{"APP_DATA"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, FindReservedWords(tt.name))
+		})
+	}
+}
+
+func TestIsAzureResourceType(t *testing.T) {
+	assert.True(t, IsAzureResourceType("azurerm_resource_group"))
+	assert.True(t, IsAzureResourceType("azapi_container_app"))
+	assert.True(t, IsAzureResourceType("azuread_group"))
+	assert.False(t, IsAzureResourceType("kubernetes_namespace"))
+	assert.False(t, IsAzureResourceType("github_repository"))
+}
@@ -4,6 +4,11 @@
 package schema
 
 import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
@@ -12,8 +17,75 @@ import (
 // to have downward compatibility with the existing codebase.
 type LocationsMapSchema map[string]string
 
+// LocationDetails are the optional human-readable attributes of a location,
+// keyed by location name in the details of a v2 locations file.
+type LocationDetails struct {
+	DisplayName    string `json:"displayName,omitempty"`
+	Paired         string `json:"paired,omitempty"`
+	GeographyGroup string `json:"geographyGroup,omitempty"`
+}
+
+type LocationDetailsMapSchema map[string]LocationDetails
+
+// LibraryManifest is the optional library.json manifest at the root of a schema
+// library, e.g. to show which library version a plan used.
+type LibraryManifest struct {
+	Name        string   `json:"name"`
+	Version     string   `json:"version"`
+	Description string   `json:"description,omitempty"`
+	Authors     []string `json:"authors,omitempty"`
+	Changelog   string   `json:"changelog,omitempty"`
+}
+
 var DefaultNamePrecedence = [...]string{"abbreviation", "prefixes", "name", "location", "environment", "hash", "suffixes"}
 
+// NamePrecedenceTokens are all segments a name precedence may contain. The
+// workspace, stack and date segments are opt-in and not part of the default.
+var NamePrecedenceTokens = append(DefaultNamePrecedence[:], "workspace", "stack", "date")
+
+// ValidateRequiredSegments checks that the required segments of a resource
+// type are known segments and part of its name precedence, so the schema
+// library cannot require a segment its own names do not render.
+func ValidateRequiredSegments(requiredSegments, namePrecedence []string) error {
+	if len(namePrecedence) == 0 {
+		namePrecedence = DefaultNamePrecedence[:]
+	}
+	for _, segment := range requiredSegments {
+		if !slices.Contains(NamePrecedenceTokens, segment) {
+			return fmt.Errorf("unknown required segment '%s', expected one of: %s", segment, strings.Join(NamePrecedenceTokens, ", "))
+		}
+		if !slices.Contains(namePrecedence, segment) {
+			return fmt.Errorf("required segment '%s' is not part of the name precedence", segment)
+		}
+	}
+	return nil
+}
+
+// ValidateNamePrecedence checks that every token of a name precedence is a
+// known segment and listed once. Unknown tokens would otherwise be skipped
+// silently when the name is built, e.g. a typo like "abreviation".
+func ValidateNamePrecedence(tokens []string) error {
+	for i, token := range tokens {
+		if !slices.Contains(NamePrecedenceTokens, token) {
+			return fmt.Errorf("unknown name precedence token '%s', expected one of: %s", token, strings.Join(NamePrecedenceTokens, ", "))
+		}
+		if slices.Contains(tokens[:i], token) {
+			return fmt.Errorf("name precedence token '%s' is listed more than once", token)
+		}
+	}
+	return nil
+}
+
+// Scopes in which a name of a resource type has to be unique. An empty scope
+// means the schema library does not define it.
+const (
+	ScopeGlobal        = "global"
+	ScopeResourceGroup = "resourceGroup"
+	ScopeParent        = "parent"
+)
+
+var Scopes = [...]string{ScopeGlobal, ScopeResourceGroup, ScopeParent}
+
 type JsonNamingSchema struct {
 	// v1 fields — always present
 	ResourceType    string                  `json:"resourceType"`
@@ -26,38 +98,101 @@ type JsonNamingSchema struct {
 	// v2+ fields — zero value means "not set" (omitempty on serialisation)
 	Deprecated   bool     `json:"deprecated,omitempty"`
 	DeprecatedBy string   `json:"deprecatedBy,omitempty"`
+	ReplacedBy   string   `json:"replacedBy,omitempty"`
 	Tags         []string `json:"tags,omitempty"`
+	Scope        string   `json:"scope,omitempty"`
+}
+
+// Replacement returns the resource type that replaces a deprecated resource
+// type. replacedBy is an alias of deprecatedBy; deprecatedBy wins if both are set.
+func (s JsonNamingSchema) Replacement() string {
+	if s.DeprecatedBy != "" {
+		return s.DeprecatedBy
+	}
+	return s.ReplacedBy
 }
 
 type JsonConfigurationSchema struct {
-	UseEnvironment    bool     `json:"useEnvironment"`
-	UseLowerCase      bool     `json:"useLowerCase"`
-	UseUpperCase      bool     `json:"useUpperCase"`
-	UseSeparator      bool     `json:"useSeparator"`
-	Separator         string   `json:"separator,omitempty"`
-	DenyDoubleHyphens bool     `json:"denyDoubleHyphens"`
-	NamePrecedence    []string `json:"namePrecedence"`
-	HashLength        int      `json:"hashLength"`
+	UseEnvironment        bool     `json:"useEnvironment"`
+	UseLowerCase          bool     `json:"useLowerCase"`
+	UseUpperCase          bool     `json:"useUpperCase"`
+	UseSeparator          bool     `json:"useSeparator"`
+	Separator             string   `json:"separator,omitempty"`
+	DenyDoubleHyphens     bool     `json:"denyDoubleHyphens"`
+	DenyRepeatedSeparator bool     `json:"denyRepeatedSeparator,omitempty"`
+	DenyLeading           string   `json:"denyLeading,omitempty"`
+	DenyTrailing          string   `json:"denyTrailing,omitempty"`
+	NamePrecedence        []string `json:"namePrecedence"`
+	HashLength            int      `json:"hashLength"`
+	DenyPatterns          []string `json:"denyPatterns,omitempty"`
+	RequiredSegments      []string `json:"requiredSegments,omitempty"`
+	DefaultPrefixes       []string `json:"defaultPrefixes,omitempty"`
+	DefaultSuffixes       []string `json:"defaultSuffixes,omitempty"`
 }
 
 type JsonNamingSchemaMap map[string]JsonNamingSchema
 
+// AffixSet is a named, reusable set of prefixes and suffixes of the schema library.
+type AffixSet struct {
+	Prefixes []string `json:"prefixes"`
+	Suffixes []string `json:"suffixes"`
+}
+
+type AffixesMapSchema map[string]AffixSet
+
+// EnvironmentsSchema is the environment catalog of the schema library. It maps
+// long environment names to their short tokens, e.g. production to prd, and
+// optionally restricts the environments that may be used.
+type EnvironmentsSchema struct {
+	Environments map[string]string `json:"environments"`
+	Allowed      []string          `json:"allowed"`
+}
+
 // BuildNameSettingsModel contains optional settings that can override
 // the default naming configuration. All fields use Go zero values
 // to indicate "not set", which allows the calling code to only apply
-// settings that were explicitly provided.
+// settings that were explicitly provided. Strict and UseSeparator are
+// pointers because false is a meaningful override. The JSON keys match the settings keys
+// of the naming functions.
 type BuildNameSettingsModel struct {
-	Convention     string
-	Environment    string
-	Prefixes       []string
-	Suffixes       []string
-	NamePrecedence []string
-	HashLength     int32
-	RandomSeed     int64
-	Separator      string
-	Location       string
-	Lowercase      bool
-	Uppercase      bool
+	Convention           string   `json:"convention"`
+	Environment          string   `json:"environment"`
+	Prefixes             []string `json:"prefixes"`
+	Suffixes             []string `json:"suffixes"`
+	NamePrecedence       []string `json:"name_precedence"`
+	HashLength           int32    `json:"hash_length"`
+	RandomSeed           int64    `json:"random_seed"`
+	SeedKey              string   `json:"seed_key"`
+	CollisionDomain      string   `json:"collision_domain"`
+	Separator            string   `json:"separator"`
+	Location             string   `json:"location"`
+	LocationShort        string   `json:"location_short"`
+	Workspace            string   `json:"workspace"`
+	Stack                string   `json:"stack"`
+	StaticDate           string   `json:"static_date"`
+	DateFormat           string   `json:"date_format"`
+	SeparatorConflict    string   `json:"separator_conflict"`
+	SeparatorReplacement string   `json:"separator_replacement"`
+	Lowercase            bool     `json:"lowercase"`
+	Uppercase            bool     `json:"uppercase"`
+	Strict               *bool    `json:"strict"`
+	ReservedWordsCheck   bool     `json:"reserved_words_check"`
+	Sensitive            bool     `json:"sensitive"`
+	Preset               string   `json:"preset"`
+	UseSeparator         *bool    `json:"use_separator"`
+	HashMode             string   `json:"hash_mode"`
+	HashCharset          string   `json:"hash_charset"`
+	TruncateKeepHash     bool     `json:"truncate_keep_hash"`
+	CollapseSeparators   bool     `json:"collapse_separators"`
+	PostProcess          []string `json:"post_process"`
+	MinLength            int      `json:"min_length"`
+	MaxLength            int      `json:"max_length"`
+	MinUniqueSuffix      int      `json:"min_unique_suffix"`
+	DenyDoubleHyphens    bool     `json:"deny_double_hyphens"`
+	ValidationRegex      string   `json:"validation_regex"`
+	Transliterate        string   `json:"transliterate"`
+	PrefixSet            string   `json:"prefix_set"`
+	SuffixSet            string   `json:"suffix_set"`
 }
 
 type NamingSchemaMap map[string]NamingSchema
@@ -69,17 +204,27 @@ type NamingSchema struct {
 	MaxLength       types.Int64   `tfsdk:"max_length"`
 	ValidationRegex types.String  `tfsdk:"validation_regex"`
 	Configuration   Configuration `tfsdk:"configuration"`
+	Deprecated      types.Bool    `tfsdk:"deprecated"`
+	ReplacedBy      types.String  `tfsdk:"replaced_by"`
+	Scope           types.String  `tfsdk:"scope"`
 }
 
 type Configuration struct {
-	UseEnvironment    types.Bool   `tfsdk:"use_environment"`
-	UseLowerCase      types.Bool   `tfsdk:"use_lower_case"`
-	UseUpperCase      types.Bool   `tfsdk:"use_upper_case"`
-	UseSeparator      types.Bool   `tfsdk:"use_separator"`
-	Separator         types.String `tfsdk:"separator"`
-	DenyDoubleHyphens types.Bool   `tfsdk:"deny_double_hyphens"`
-	NamePrecedence    types.List   `tfsdk:"name_precedence"`
-	HashLength        types.Int32  `tfsdk:"hash_length"`
+	UseEnvironment        types.Bool   `tfsdk:"use_environment"`
+	UseLowerCase          types.Bool   `tfsdk:"use_lower_case"`
+	UseUpperCase          types.Bool   `tfsdk:"use_upper_case"`
+	UseSeparator          types.Bool   `tfsdk:"use_separator"`
+	Separator             types.String `tfsdk:"separator"`
+	DenyDoubleHyphens     types.Bool   `tfsdk:"deny_double_hyphens"`
+	DenyRepeatedSeparator types.Bool   `tfsdk:"deny_repeated_separator"`
+	DenyLeading           types.String `tfsdk:"deny_leading"`
+	DenyTrailing          types.String `tfsdk:"deny_trailing"`
+	NamePrecedence        types.List   `tfsdk:"name_precedence"`
+	HashLength            types.Int32  `tfsdk:"hash_length"`
+	DenyPatterns          types.List   `tfsdk:"deny_patterns"`
+	RequiredSegments      types.List   `tfsdk:"required_segments"`
+	DefaultPrefixes       types.List   `tfsdk:"default_prefixes"`
+	DefaultSuffixes       types.List   `tfsdk:"default_suffixes"`
 }
 
 func NewNamingSchemaMap(schemas []JsonNamingSchema) NamingSchemaMap {
@@ -95,6 +240,16 @@ func NewNamingSchemaMap(schemas []JsonNamingSchema) NamingSchemaMap {
 			precedenceElements = append(precedenceElements, types.StringValue(v))
 		}
 
+		denyPatternElements := make([]attr.Value, 0, len(s.Configuration.DenyPatterns))
+		for _, v := range s.Configuration.DenyPatterns {
+			denyPatternElements = append(denyPatternElements, types.StringValue(v))
+		}
+
+		requiredSegmentElements := make([]attr.Value, 0, len(s.Configuration.RequiredSegments))
+		for _, v := range s.Configuration.RequiredSegments {
+			requiredSegmentElements = append(requiredSegmentElements, types.StringValue(v))
+		}
+
 		m[s.ResourceType] = NamingSchema{
 			ResourceType:    types.StringValue(s.ResourceType),
 			Abbreviation:    types.StringValue(s.Abbreviation),
@@ -102,21 +257,154 @@ func NewNamingSchemaMap(schemas []JsonNamingSchema) NamingSchemaMap {
 			MaxLength:       types.Int64Value(int64(s.MaxLength)),
 			ValidationRegex: types.StringValue(s.ValidationRegex),
 			Configuration: Configuration{
-				UseEnvironment:    types.BoolValue(s.Configuration.UseEnvironment),
-				UseLowerCase:      types.BoolValue(s.Configuration.UseLowerCase),
-				UseUpperCase:      types.BoolValue(s.Configuration.UseUpperCase),
-				UseSeparator:      types.BoolValue(s.Configuration.UseSeparator),
-				Separator:         types.StringValue(s.Configuration.Separator),
-				DenyDoubleHyphens: types.BoolValue(s.Configuration.DenyDoubleHyphens),
-				NamePrecedence:    types.ListValueMust(types.StringType, precedenceElements),
-				HashLength:        types.Int32Value(int32(s.Configuration.HashLength)),
+				UseEnvironment:        types.BoolValue(s.Configuration.UseEnvironment),
+				UseLowerCase:          types.BoolValue(s.Configuration.UseLowerCase),
+				UseUpperCase:          types.BoolValue(s.Configuration.UseUpperCase),
+				UseSeparator:          types.BoolValue(s.Configuration.UseSeparator),
+				Separator:             types.StringValue(s.Configuration.Separator),
+				DenyDoubleHyphens:     types.BoolValue(s.Configuration.DenyDoubleHyphens),
+				DenyRepeatedSeparator: types.BoolValue(s.Configuration.DenyRepeatedSeparator),
+				DenyLeading:           types.StringValue(s.Configuration.DenyLeading),
+				DenyTrailing:          types.StringValue(s.Configuration.DenyTrailing),
+				NamePrecedence:        types.ListValueMust(types.StringType, precedenceElements),
+				HashLength:            types.Int32Value(int32(s.Configuration.HashLength)),
+				DenyPatterns:          types.ListValueMust(types.StringType, denyPatternElements),
+				RequiredSegments:      types.ListValueMust(types.StringType, requiredSegmentElements),
+				DefaultPrefixes:       stringsToList(s.Configuration.DefaultPrefixes),
+				DefaultSuffixes:       stringsToList(s.Configuration.DefaultSuffixes),
 			},
+			Deprecated: types.BoolValue(s.Deprecated),
+			ReplacedBy: types.StringValue(s.Replacement()),
+			Scope:      types.StringValue(s.Scope),
 		}
 	}
 
 	return m
 }
 
+// ToJsonNamingSchema converts the naming schema back into its schema library representation.
+func (n NamingSchema) ToJsonNamingSchema() JsonNamingSchema {
+	return JsonNamingSchema{
+		ResourceType:    n.ResourceType.ValueString(),
+		Abbreviation:    n.Abbreviation.ValueString(),
+		MinLength:       int(n.MinLength.ValueInt64()),
+		MaxLength:       int(n.MaxLength.ValueInt64()),
+		ValidationRegex: n.ValidationRegex.ValueString(),
+		Configuration: JsonConfigurationSchema{
+			UseEnvironment:        n.Configuration.UseEnvironment.ValueBool(),
+			UseLowerCase:          n.Configuration.UseLowerCase.ValueBool(),
+			UseUpperCase:          n.Configuration.UseUpperCase.ValueBool(),
+			UseSeparator:          n.Configuration.UseSeparator.ValueBool(),
+			Separator:             n.Configuration.Separator.ValueString(),
+			DenyDoubleHyphens:     n.Configuration.DenyDoubleHyphens.ValueBool(),
+			DenyRepeatedSeparator: n.Configuration.DenyRepeatedSeparator.ValueBool(),
+			DenyLeading:           n.Configuration.DenyLeading.ValueString(),
+			DenyTrailing:          n.Configuration.DenyTrailing.ValueString(),
+			NamePrecedence:        listToStrings(n.Configuration.NamePrecedence),
+			HashLength:            int(n.Configuration.HashLength.ValueInt32()),
+			DenyPatterns:          listToStrings(n.Configuration.DenyPatterns),
+			RequiredSegments:      listToStrings(n.Configuration.RequiredSegments),
+			DefaultPrefixes:       listToStrings(n.Configuration.DefaultPrefixes),
+			DefaultSuffixes:       listToStrings(n.Configuration.DefaultSuffixes),
+		},
+		Deprecated:   n.Deprecated.ValueBool(),
+		DeprecatedBy: n.ReplacedBy.ValueString(),
+		Scope:        n.Scope.ValueString(),
+	}
+}
+
+func stringsToList(values []string) types.List {
+	elements := make([]attr.Value, 0, len(values))
+	for _, v := range values {
+		elements = append(elements, types.StringValue(v))
+	}
+	return types.ListValueMust(types.StringType, elements)
+}
+
+func listToStrings(l types.List) []string {
+	result := make([]string, 0, len(l.Elements()))
+	for _, elem := range l.Elements() {
+		if str, ok := elem.(types.String); ok && !str.IsNull() && !str.IsUnknown() {
+			result = append(result, str.ValueString())
+		}
+	}
+	return result
+}
+
+// MergeNamingSchemas returns base with every schema of overrides applied. A schema
+// replaces the base schema with the same resource type in place, new resource
+// types are appended in the order of overrides.
+func MergeNamingSchemas(base, overrides []JsonNamingSchema) []JsonNamingSchema {
+	merged := append([]JsonNamingSchema{}, base...)
+	index := make(map[string]int, len(merged))
+	for i, s := range merged {
+		index[s.ResourceType] = i
+	}
+
+	for _, s := range overrides {
+		if i, ok := index[s.ResourceType]; ok {
+			merged[i] = s
+			continue
+		}
+		index[s.ResourceType] = len(merged)
+		merged = append(merged, s)
+	}
+
+	return merged
+}
+
+// LengthOverride tightens the length limits of a resource type, e.g. to enforce
+// an internal limit below the limit of the cloud provider. Zero values are not set.
+type LengthOverride struct {
+	MinLength int
+	MaxLength int
+}
+
+// Apply returns the limits with the override applied. The override is clamped
+// to the given limits, so it can only make them stricter.
+func (o LengthOverride) Apply(minLength, maxLength int) (int, int, error) {
+	if o.MinLength > minLength {
+		minLength = o.MinLength
+	}
+	if o.MaxLength > 0 && o.MaxLength < maxLength {
+		maxLength = o.MaxLength
+	}
+	if minLength > maxLength {
+		return 0, 0, fmt.Errorf("min_length %d is greater than max_length %d", minLength, maxLength)
+	}
+	return minLength, maxLength, nil
+}
+
+// ApplyLengthOverrides returns schemas with the length overrides applied per
+// resource type. Overrides of resource types not in schemas are an error.
+func ApplyLengthOverrides(schemas []JsonNamingSchema, overrides map[string]LengthOverride) ([]JsonNamingSchema, error) {
+	if len(overrides) == 0 {
+		return schemas, nil
+	}
+
+	result := append([]JsonNamingSchema{}, schemas...)
+	found := make(map[string]bool, len(overrides))
+	for i, s := range result {
+		override, ok := overrides[s.ResourceType]
+		if !ok {
+			continue
+		}
+		found[s.ResourceType] = true
+		minLength, maxLength, err := override.Apply(s.MinLength, s.MaxLength)
+		if err != nil {
+			return nil, fmt.Errorf("schema_overrides: resource type '%s': %w", s.ResourceType, err)
+		}
+		result[i].MinLength, result[i].MaxLength = minLength, maxLength
+	}
+
+	for _, k := range slices.Sorted(maps.Keys(overrides)) {
+		if !found[k] {
+			return nil, fmt.Errorf("schema_overrides: resource type '%s' not found in schema library", k)
+		}
+	}
+	return result, nil
+}
+
 func (m JsonNamingSchemaMap) GetByResourceType(resourceType string) (JsonNamingSchema, bool) {
 	s, ok := m[resourceType]
 	return s, ok
@@ -131,15 +419,25 @@ func SchemaTypeAttributes() map[string]attr.Type {
 		"validation_regex": types.StringType,
 		"configuration": types.ObjectType{
 			AttrTypes: map[string]attr.Type{
-				"use_environment":     types.BoolType,
-				"use_lower_case":      types.BoolType,
-				"use_upper_case":      types.BoolType,
-				"use_separator":       types.BoolType,
-				"separator":           types.StringType,
-				"deny_double_hyphens": types.BoolType,
-				"name_precedence":     types.ListType{ElemType: types.StringType},
-				"hash_length":         types.Int32Type,
+				"use_environment":         types.BoolType,
+				"use_lower_case":          types.BoolType,
+				"use_upper_case":          types.BoolType,
+				"use_separator":           types.BoolType,
+				"separator":               types.StringType,
+				"deny_double_hyphens":     types.BoolType,
+				"deny_repeated_separator": types.BoolType,
+				"deny_leading":            types.StringType,
+				"deny_trailing":           types.StringType,
+				"name_precedence":         types.ListType{ElemType: types.StringType},
+				"hash_length":             types.Int32Type,
+				"deny_patterns":           types.ListType{ElemType: types.StringType},
+				"required_segments":       types.ListType{ElemType: types.StringType},
+				"default_prefixes":        types.ListType{ElemType: types.StringType},
+				"default_suffixes":        types.ListType{ElemType: types.StringType},
 			},
 		},
+		"deprecated":  types.BoolType,
+		"replaced_by": types.StringType,
+		"scope":       types.StringType,
 	}
 }
@@ -0,0 +1,70 @@
+// Copyright glueckkanja AG 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package schema
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLengthOverride_Apply(t *testing.T) {
+	tests := []struct {
+		name     string
+		override LengthOverride
+		min, max int
+		err      string
+	}{
+		{name: "unset", override: LengthOverride{}, min: 1, max: 64},
+		{name: "tighter", override: LengthOverride{MinLength: 3, MaxLength: 40}, min: 3, max: 40},
+		{name: "looser is clamped", override: LengthOverride{MinLength: 0, MaxLength: 80}, min: 1, max: 64},
+		{name: "min above max", override: LengthOverride{MinLength: 50, MaxLength: 40}, err: "min_length 50 is greater than max_length 40"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			minLength, maxLength, err := tt.override.Apply(1, 64)
+			if tt.err != "" {
+				assert.EqualError(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.min, minLength)
+			assert.Equal(t, tt.max, maxLength)
+		})
+	}
+}
+
+func TestApplyLengthOverrides(t *testing.T) {
+	schemas := []JsonNamingSchema{
+		{ResourceType: "azurerm_resource_group", MinLength: 1, MaxLength: 90},
+		{ResourceType: "azurerm_storage_account", MinLength: 3, MaxLength: 24},
+	}
+
+	result, err := ApplyLengthOverrides(schemas, map[string]LengthOverride{"azurerm_resource_group": {MaxLength: 40}})
+	require.NoError(t, err)
+	assert.Equal(t, 40, result[0].MaxLength)
+	assert.Equal(t, 24, result[1].MaxLength)
+	assert.Equal(t, 90, schemas[0].MaxLength, "input must not be modified")
+
+	_, err = ApplyLengthOverrides(schemas, map[string]LengthOverride{"azurerm_key_vault": {MaxLength: 20}})
+	assert.EqualError(t, err, "schema_overrides: resource type 'azurerm_key_vault' not found in schema library")
+}
+
+func TestValidateNamePrecedence(t *testing.T) {
+	assert.NoError(t, ValidateNamePrecedence(nil))
+	assert.NoError(t, ValidateNamePrecedence([]string{"abbreviation", "name", "environment"}))
+	assert.EqualError(t, ValidateNamePrecedence([]string{"abreviation", "name"}),
+		"unknown name precedence token 'abreviation', expected one of: abbreviation, prefixes, name, location, environment, hash, suffixes, workspace, stack, date")
+	assert.EqualError(t, ValidateNamePrecedence([]string{"name", "hash", "name"}), "name precedence token 'name' is listed more than once")
+}
+
+func TestValidateRequiredSegments(t *testing.T) {
+	assert.NoError(t, ValidateRequiredSegments(nil, nil))
+	assert.NoError(t, ValidateRequiredSegments([]string{"abbreviation"}, nil))
+	assert.NoError(t, ValidateRequiredSegments([]string{"name"}, []string{"abbreviation", "name"}))
+	assert.ErrorContains(t, ValidateRequiredSegments([]string{"abreviation"}, nil), "unknown required segment 'abreviation'")
+	assert.EqualError(t, ValidateRequiredSegments([]string{"location"}, []string{"abbreviation", "name"}), "required segment 'location' is not part of the name precedence")
+}
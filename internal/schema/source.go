@@ -8,12 +8,54 @@ import (
 	"fmt"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"io/fs"
+	"path/filepath"
+	"slices"
+	"strings"
 )
 
 type SourceValue struct {
-	Path      basetypes.StringValue `tfsdk:"path"`
-	Ref       basetypes.StringValue `tfsdk:"ref"`
-	CustomUrl basetypes.StringValue `tfsdk:"custom_url"`
+	Path          basetypes.StringValue `tfsdk:"path"`
+	Ref           basetypes.StringValue `tfsdk:"ref"`
+	CustomUrl     basetypes.StringValue `tfsdk:"custom_url"`
+	BundleUrl     basetypes.StringValue `tfsdk:"bundle_url"`
+	NamingFile    basetypes.StringValue `tfsdk:"naming_file"`
+	LocationsFile basetypes.StringValue `tfsdk:"locations_file"`
+	BaseDir       basetypes.StringValue `tfsdk:"base_dir"`
+}
+
+// FilePatterns returns the file name patterns of the source value. Unset
+// patterns are empty and fall back to the default file names.
+func (v SourceValue) FilePatterns() FilePatterns {
+	return FilePatterns{
+		Naming:    v.NamingFile.ValueString(),
+		Locations: v.LocationsFile.ValueString(),
+	}
+}
+
+// ResolveRelativeSource resolves a relative local path of a go-getter source,
+// i.e. one starting with ./ or ../ and an optional forced getter like
+// "file::", against baseDir instead of the working directory of the provider
+// process. Other sources, and all sources without baseDir, are returned as is.
+func ResolveRelativeSource(src, baseDir string) string {
+	if baseDir == "" {
+		return src
+	}
+
+	forced, path := "", src
+	if i := strings.Index(src, "::"); i >= 0 {
+		forced, path = src[:i+2], src[i+2:]
+	}
+	if path != "." && path != ".." && !slices.ContainsFunc([]string{"./", "../", `.\`, `..\`}, func(prefix string) bool {
+		return strings.HasPrefix(path, prefix)
+	}) {
+		return src
+	}
+
+	base, err := filepath.Abs(baseDir)
+	if err != nil {
+		return src
+	}
+	return forced + filepath.Join(base, path)
 }
 
 type Source interface {
@@ -91,3 +133,66 @@ func (r *CustomSource) Url() string {
 func (r *CustomSource) Dst() fs.FS {
 	return r.dst
 }
+
+// BundleSource is a schema library packaged as a zip bundle with a manifest,
+// see OpenBundle.
+type BundleSource struct {
+	url string
+	dst fs.FS
+}
+
+func NewBundleSource(url string) *BundleSource {
+	return &BundleSource{
+		url: url,
+	}
+}
+
+func (r *BundleSource) Download(ctx context.Context, destinationDirectory string) (fs.FS, error) {
+	f, err := DownloadBundle(ctx, r.url, destinationDirectory)
+	if err != nil {
+		return nil, err
+	}
+	r.dst = f
+	return f, nil
+}
+
+func (r *BundleSource) String() string {
+	return fmt.Sprintf("bundle::%s", r.url)
+}
+
+func (r *BundleSource) Url() string {
+	return r.url
+}
+
+func (r *BundleSource) Dst() fs.FS {
+	return r.dst
+}
+
+// FSSource is a schema library that is already available as a file system,
+// e.g. an in-memory fstest.MapFS in tests. Download never touches the network.
+type FSSource struct {
+	name string
+	fs   fs.FS
+}
+
+func NewFSSource(name string, fsys fs.FS) *FSSource {
+	return &FSSource{
+		name: name,
+		fs:   fsys,
+	}
+}
+
+func (r *FSSource) Download(ctx context.Context, _ string) (fs.FS, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, downloadContextError(r.String(), err)
+	}
+	return r.fs, nil
+}
+
+func (r *FSSource) String() string {
+	return fmt.Sprintf("fs::%s", r.name)
+}
+
+func (r *FSSource) Dst() fs.FS {
+	return r.fs
+}
@@ -0,0 +1,28 @@
+// Copyright glueckkanja AG 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package schema
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResolveRelativeSource(t *testing.T) {
+	base := t.TempDir()
+
+	assert.Equal(t, filepath.Join(base, "lib"), ResolveRelativeSource("./lib", base))
+	assert.Equal(t, filepath.Join(filepath.Dir(base), "lib"), ResolveRelativeSource("../lib", base))
+	assert.Equal(t, base, ResolveRelativeSource(".", base))
+	assert.Equal(t, "file::"+filepath.Join(base, "lib"), ResolveRelativeSource("file::./lib", base))
+
+	// Remote and absolute sources are not changed.
+	assert.Equal(t, "git::https://example.com/library.git", ResolveRelativeSource("git::https://example.com/library.git", base))
+	assert.Equal(t, "github.com/glueckkanja/standesamt-schema-library", ResolveRelativeSource("github.com/glueckkanja/standesamt-schema-library", base))
+	assert.Equal(t, "/opt/lib", ResolveRelativeSource("/opt/lib", base))
+
+	// Without a base directory go-getter resolves against the working directory.
+	assert.Equal(t, "./lib", ResolveRelativeSource("./lib", ""))
+}
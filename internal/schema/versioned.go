@@ -31,6 +31,24 @@ type locationsEnvelopeV2 struct {
 	Version     int                `json:"version"`
 	GeneratedAt string             `json:"generatedAt"`
 	Locations   LocationsMapSchema `json:"locations"`
+	// Details are optional display names, paired regions and geography groups
+	// of the locations.
+	Details LocationDetailsMapSchema `json:"details"`
+}
+
+// affixesEnvelopeV2 is the versioned wrapper for affix sets.
+type affixesEnvelopeV2 struct {
+	Version     int              `json:"version"`
+	GeneratedAt string           `json:"generatedAt"`
+	Affixes     AffixesMapSchema `json:"affixes"`
+}
+
+// environmentsEnvelopeV2 is the versioned wrapper for the environment catalog.
+type environmentsEnvelopeV2 struct {
+	Version      int               `json:"version"`
+	GeneratedAt  string            `json:"generatedAt"`
+	Environments map[string]string `json:"environments"`
+	Allowed      []string          `json:"allowed"`
 }
 
 // detectVersion peeks at the raw JSON bytes to determine the schema version.
@@ -131,3 +149,87 @@ func loadLocations(data []byte) (LocationsMapSchema, error) {
 		)
 	}
 }
+
+// loadLocationDetails returns the details of a locations file. Only v2 files
+// have details, v1 files return nil.
+func loadLocationDetails(data []byte) (LocationDetailsMapSchema, error) {
+	version, err := detectVersion(data)
+	if err != nil {
+		return nil, fmt.Errorf("loadLocationDetails: %w", err)
+	}
+	if version != 2 {
+		return nil, nil
+	}
+
+	var envelope locationsEnvelopeV2
+	if err := json.Unmarshal(data, &envelope); err != nil {
+		return nil, fmt.Errorf("loadLocationDetails: v2: failed to unmarshal: %w", err)
+	}
+	return envelope.Details, nil
+}
+
+// loadAffixes is the version-dispatching entry point for affix set files.
+//
+// v1 (raw JSON object / flat map) → unmarshalled directly as AffixesMapSchema
+// v2 (versioned object)           → envelope unwrapped, .Affixes returned
+func loadAffixes(data []byte) (AffixesMapSchema, error) {
+	version, err := detectVersion(data)
+	if err != nil {
+		return nil, fmt.Errorf("loadAffixes: %w", err)
+	}
+
+	switch version {
+	case 1:
+		var am AffixesMapSchema
+		if err := json.Unmarshal(data, &am); err != nil {
+			return nil, fmt.Errorf("loadAffixes: v1: failed to unmarshal: %w", err)
+		}
+		return am, nil
+
+	case 2:
+		var envelope affixesEnvelopeV2
+		if err := json.Unmarshal(data, &envelope); err != nil {
+			return nil, fmt.Errorf("loadAffixes: v2: failed to unmarshal: %w", err)
+		}
+		return envelope.Affixes, nil
+
+	default:
+		return nil, fmt.Errorf(
+			"loadAffixes: schema version %d is not supported by this provider (max supported: %d); upgrade the provider",
+			version, maxSupportedSchemaVersion,
+		)
+	}
+}
+
+// loadEnvironments is the version-dispatching entry point for environment catalogs.
+//
+// v1 (raw JSON object / flat map) → unmarshalled as the environment token map
+// v2 (versioned object)           → envelope unwrapped, .Environments and .Allowed returned
+func loadEnvironments(data []byte) (EnvironmentsSchema, error) {
+	version, err := detectVersion(data)
+	if err != nil {
+		return EnvironmentsSchema{}, fmt.Errorf("loadEnvironments: %w", err)
+	}
+
+	switch version {
+	case 1:
+		var environments map[string]string
+		if err := json.Unmarshal(data, &environments); err != nil {
+			return EnvironmentsSchema{}, fmt.Errorf("loadEnvironments: v1: failed to unmarshal: %w", err)
+		}
+		return EnvironmentsSchema{Environments: environments}, nil
+
+	case 2:
+		var envelope environmentsEnvelopeV2
+		if err := json.Unmarshal(data, &envelope); err != nil {
+			return EnvironmentsSchema{}, fmt.Errorf("loadEnvironments: v2: failed to unmarshal: %w", err)
+		}
+		return EnvironmentsSchema{Environments: envelope.Environments, Allowed: envelope.Allowed}, nil
+
+	default:
+		return EnvironmentsSchema{}, fmt.Errorf(
+			"loadEnvironments: schema version %d is not supported by this provider (max supported: %d); upgrade the provider",
+			version, maxSupportedSchemaVersion,
+		)
+	}
+}
@@ -130,7 +130,8 @@ func TestLoadNamingSchemas_V2(t *testing.T) {
 				},
 				"deprecated": true,
 				"deprecatedBy": "azurerm_storage_account_v2",
-				"tags": ["storage"]
+				"tags": ["storage"],
+				"scope": "global"
 			}
 		]
 	}`)
@@ -150,6 +151,8 @@ func TestLoadNamingSchemas_V2(t *testing.T) {
 	assert.True(t, st.Deprecated)
 	assert.Equal(t, "azurerm_storage_account_v2", st.DeprecatedBy)
 	assert.Equal(t, []string{"storage"}, st.Tags)
+	assert.Equal(t, ScopeGlobal, st.Scope)
+	assert.Empty(t, rg.Scope)
 }
 
 func TestLoadNamingSchemas_UnsupportedVersion(t *testing.T) {
@@ -195,9 +198,74 @@ func TestLoadLocations_V2(t *testing.T) {
 	assert.Equal(t, "uks", lm["uksouth"])
 }
 
+func TestLoadLocationDetails(t *testing.T) {
+	details, err := loadLocationDetails([]byte(`{
+		"version": 2,
+		"locations": {"westeurope": "weu", "northeurope": "neu"},
+		"details": {
+			"westeurope": {"displayName": "West Europe", "paired": "northeurope", "geographyGroup": "Europe"},
+			"northeurope": {"displayName": "North Europe"}
+		}
+	}`))
+	require.NoError(t, err)
+	assert.Equal(t, LocationDetailsMapSchema{
+		"westeurope":  {DisplayName: "West Europe", Paired: "northeurope", GeographyGroup: "Europe"},
+		"northeurope": {DisplayName: "North Europe"},
+	}, details)
+
+	v1, err := loadLocationDetails([]byte(`{"westeurope":"weu"}`))
+	require.NoError(t, err)
+	assert.Nil(t, v1)
+}
+
 func TestLoadLocations_UnsupportedVersion(t *testing.T) {
 	data := []byte(`{"version":99,"locations":{}}`)
 	_, err := loadLocations(data)
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "version 99 is not supported")
 }
+
+func TestNewNamingSchemaMap_Deprecation(t *testing.T) {
+	m := NewNamingSchemaMap([]JsonNamingSchema{
+		{ResourceType: "azurerm_app_service", Deprecated: true, ReplacedBy: "azurerm_linux_web_app"},
+		{ResourceType: "azurerm_storage_account", Deprecated: true, DeprecatedBy: "azurerm_storage_account_v2", ReplacedBy: "other"},
+		{ResourceType: "azurerm_resource_group"},
+	})
+
+	assert.True(t, m["azurerm_app_service"].Deprecated.ValueBool())
+	assert.Equal(t, "azurerm_linux_web_app", m["azurerm_app_service"].ReplacedBy.ValueString())
+	assert.Equal(t, "azurerm_storage_account_v2", m["azurerm_storage_account"].ReplacedBy.ValueString())
+	assert.False(t, m["azurerm_resource_group"].Deprecated.ValueBool())
+
+	assert.Equal(t, "azurerm_linux_web_app", m["azurerm_app_service"].ToJsonNamingSchema().DeprecatedBy)
+}
+
+func TestLoadAffixes(t *testing.T) {
+	v1, err := loadAffixes([]byte(`{"platform":{"prefixes":["plt"],"suffixes":[]},"lz-corp":{"prefixes":["lz","corp"]}}`))
+	require.NoError(t, err)
+	assert.Equal(t, AffixesMapSchema{
+		"platform": {Prefixes: []string{"plt"}, Suffixes: []string{}},
+		"lz-corp":  {Prefixes: []string{"lz", "corp"}},
+	}, v1)
+
+	v2, err := loadAffixes([]byte(`{"version":2,"affixes":{"platform":{"prefixes":["plt"],"suffixes":["shared"]}}}`))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"shared"}, v2["platform"].Suffixes)
+
+	_, err = loadAffixes([]byte(`{"version":99,"affixes":{}}`))
+	assert.ErrorContains(t, err, "version 99 is not supported")
+}
+
+func TestLoadEnvironments(t *testing.T) {
+	v1, err := loadEnvironments([]byte(`{"production":"prd","test":"tst"}`))
+	require.NoError(t, err)
+	assert.Equal(t, EnvironmentsSchema{Environments: map[string]string{"production": "prd", "test": "tst"}}, v1)
+
+	v2, err := loadEnvironments([]byte(`{"version":2,"environments":{"production":"prd"},"allowed":["prd","dev"]}`))
+	require.NoError(t, err)
+	assert.Equal(t, "prd", v2.Environments["production"])
+	assert.Equal(t, []string{"prd", "dev"}, v2.Allowed)
+
+	_, err = loadEnvironments([]byte(`{"version":99,"environments":{}}`))
+	assert.ErrorContains(t, err, "version 99 is not supported")
+}
@@ -10,6 +10,8 @@ const (
 	standesamtSchemaDefaultCacheDirEnv = "SA_NAMING_DIR"
 	standesamtSchemaGitUrl             = "github.com/glueckkanja/standesamt-schema-library"
 	standesamtSchemaGitUrlEnv          = "SA_NAMING_GIT_URL"
+	standesamtSchemaImmutableRef       = `^v?[0-9]+(\.[0-9]+)*$`
+	standesamtSchemaImmutableRefEnv    = "SA_NAMING_IMMUTABLE_REF"
 )
 
 func NamingSchemaCacheDir() string {
@@ -27,3 +29,14 @@ func NamingSchemaGitUrl() string {
 	}
 	return url
 }
+
+// NamingSchemaImmutableRefPattern returns the regular expression of schema
+// library refs that are immutable release tags, e.g. 2025.04 or v1.2.0. Cached
+// downloads of these refs are reused instead of downloaded again.
+func NamingSchemaImmutableRefPattern() string {
+	pattern := standesamtSchemaImmutableRef
+	if p := os.Getenv(standesamtSchemaImmutableRefEnv); p != "" {
+		pattern = p
+	}
+	return pattern
+}
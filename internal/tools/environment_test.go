@@ -42,3 +42,14 @@ func TestNamingSchemaGitUrl(t *testing.T) {
 		})
 	}
 }
+
+func TestNamingSchemaImmutableRefPattern(t *testing.T) {
+	if got := NamingSchemaImmutableRefPattern(); got != `^v?[0-9]+(\.[0-9]+)*$` {
+		t.Errorf("NamingSchemaImmutableRefPattern() = %v", got)
+	}
+
+	t.Setenv("SA_NAMING_IMMUTABLE_REF", `^release-.*$`)
+	if got := NamingSchemaImmutableRefPattern(); got != `^release-.*$` {
+		t.Errorf("NamingSchemaImmutableRefPattern() = %v", got)
+	}
+}
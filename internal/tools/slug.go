@@ -0,0 +1,134 @@
+// Copyright glueckkanja AG 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/runes"
+	"golang.org/x/text/transform"
+	"golang.org/x/text/unicode/norm"
+)
+
+// SlugOptions controls how Slugify sanitizes a string.
+type SlugOptions struct {
+	// Separator replaces every run of characters that are not ASCII letters or digits.
+	Separator string
+	// MaxLength truncates the slug to at most MaxLength characters. 0 disables truncation.
+	MaxLength int
+	// Lowercase converts the slug to lower case.
+	Lowercase bool
+	// Transliterate selects the transliteration rules, see Transliterate.
+	Transliterate string
+}
+
+// Transliteration modes of Transliterate.
+const (
+	// TransliterateASCII strips diacritics and replaces letters without
+	// decomposition, e.g. "ß" becomes "ss" and "ø" becomes "o".
+	TransliterateASCII = "ascii"
+	// TransliterateGerman additionally writes umlauts as two letters, e.g.
+	// "Müller" becomes "Mueller" instead of "Muller".
+	TransliterateGerman = "de"
+)
+
+var TransliterationModes = []string{TransliterateASCII, TransliterateGerman}
+
+var germanTransliterations = strings.NewReplacer(
+	"ä", "ae", "ö", "oe", "ü", "ue",
+	"Ä", "Ae", "Ö", "Oe", "Ü", "Ue",
+)
+
+// asciiTransliterations are letters that do not decompose into an ASCII letter
+// and a combining mark, so StripDiacritics alone would keep them.
+var asciiTransliterations = strings.NewReplacer(
+	"ß", "ss", "ẞ", "SS",
+	"æ", "ae", "Æ", "AE",
+	"œ", "oe", "Œ", "OE",
+	"ø", "o", "Ø", "O",
+	"ł", "l", "Ł", "L",
+	"đ", "d", "Đ", "D",
+	"ð", "d", "Ð", "D",
+	"þ", "th", "Þ", "TH",
+)
+
+// Transliterate converts the letters of s to ASCII according to mode, so labels
+// in German or French validate against ASCII-only naming regexes. The input is
+// normalized to NFC first, so decomposed umlauts are handled like composed ones.
+// An empty mode is TransliterateASCII. Characters without an ASCII counterpart
+// are kept.
+func Transliterate(s string, mode string) string {
+	s = norm.NFC.String(s)
+	if mode == TransliterateGerman {
+		s = germanTransliterations.Replace(s)
+	}
+	return StripDiacritics(asciiTransliterations.Replace(s))
+}
+
+// StripDiacritics removes combining marks from s, e.g. "Müller" becomes "Muller".
+func StripDiacritics(s string) string {
+	t := transform.Chain(norm.NFD, runes.Remove(runes.In(unicode.Mn)), norm.NFC)
+	result, _, err := transform.String(t, s)
+	if err != nil {
+		return s
+	}
+	return result
+}
+
+// Slugify turns an arbitrary string into a naming-safe slug that only contains
+// ASCII letters, digits and the separator. Letters are transliterated, runs of
+// other characters are collapsed into a single separator and leading or
+// trailing separators are removed.
+func Slugify(input string, opts SlugOptions) string {
+	input = Transliterate(input, opts.Transliterate)
+	if opts.Lowercase {
+		input = strings.ToLower(input)
+	}
+
+	parts := strings.FieldsFunc(input, func(r rune) bool {
+		return (r < 'a' || r > 'z') && (r < 'A' || r > 'Z') && (r < '0' || r > '9')
+	})
+	slug := strings.Join(parts, opts.Separator)
+
+	if opts.MaxLength > 0 && len(slug) > opts.MaxLength {
+		slug = slug[:opts.MaxLength]
+		if opts.Separator != "" {
+			slug = strings.TrimRight(slug, opts.Separator)
+		}
+	}
+
+	return slug
+}
+
+// DNSLabelMaxLength is the maximum length of a DNS label according to RFC 1035.
+const DNSLabelMaxLength = 63
+
+// DNSLabel turns a name into a DNS label according to RFC 1035: lower case
+// ASCII letters, digits and hyphens, starting with a letter and ending with a
+// letter or digit. Runs of other characters become a single hyphen like in
+// Slugify, and leading characters that are not letters are removed. The label
+// is truncated to maxLength, 0 is DNSLabelMaxLength.
+func DNSLabel(name string, maxLength int) (string, error) {
+	if maxLength < 0 || maxLength > DNSLabelMaxLength {
+		return "", fmt.Errorf("max_length must be between 0 and %d, got %d", DNSLabelMaxLength, maxLength)
+	}
+	if maxLength == 0 {
+		maxLength = DNSLabelMaxLength
+	}
+
+	label := Slugify(name, SlugOptions{Separator: "-", Lowercase: true})
+	label = strings.TrimLeftFunc(label, func(r rune) bool {
+		return r < 'a' || r > 'z'
+	})
+	if len(label) > maxLength {
+		label = strings.TrimRight(label[:maxLength], "-")
+	}
+
+	if label == "" {
+		return "", fmt.Errorf("'%s' contains no letter to start a DNS label with", name)
+	}
+	return label, nil
+}
@@ -0,0 +1,129 @@
+// Copyright glueckkanja AG 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestSlugify(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		opts  SlugOptions
+		want  string
+	}{
+		{
+			name:  "lowercase with separator",
+			input: "My Great Project",
+			opts:  SlugOptions{Separator: "-", Lowercase: true},
+			want:  "my-great-project",
+		},
+		{
+			name:  "diacritics",
+			input: "Café Münchén",
+			opts:  SlugOptions{Separator: "-", Lowercase: true},
+			want:  "cafe-munchen",
+		},
+		{
+			name:  "ligatures",
+			input: "Straße Ørsted",
+			opts:  SlugOptions{Separator: "-", Lowercase: true},
+			want:  "strasse-orsted",
+		},
+		{
+			name:  "german umlauts",
+			input: "Müller Öl",
+			opts:  SlugOptions{Separator: "-", Lowercase: true, Transliterate: TransliterateGerman},
+			want:  "mueller-oel",
+		},
+		{
+			name:  "collapse and trim separators",
+			input: "  --Hello___World!!  ",
+			opts:  SlugOptions{Separator: "-"},
+			want:  "Hello-World",
+		},
+		{
+			name:  "empty separator",
+			input: "data lake 01",
+			opts:  SlugOptions{Lowercase: true},
+			want:  "datalake01",
+		},
+		{
+			name:  "max length trims trailing separator",
+			input: "platform team core",
+			opts:  SlugOptions{Separator: "-", MaxLength: 9, Lowercase: true},
+			want:  "platform",
+		},
+		{
+			name:  "only invalid characters",
+			input: "#!?",
+			opts:  SlugOptions{Separator: "-"},
+			want:  "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Slugify(tt.input, tt.opts); got != tt.want {
+				t.Errorf("Slugify() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTransliterate(t *testing.T) {
+	// "Mu" followed by a combining diaeresis, i.e. the NFD form of "Mü".
+	decomposed := "Mu\u0308ller"
+
+	tests := []struct {
+		input string
+		mode  string
+		want  string
+	}{
+		{input: "Café Crème", mode: TransliterateASCII, want: "Cafe Creme"},
+		{input: "Müller", mode: "", want: "Muller"},
+		{input: "Müller", mode: TransliterateGerman, want: "Mueller"},
+		{input: decomposed, mode: TransliterateGerman, want: "Mueller"},
+		{input: "ÄÖÜ straße", mode: TransliterateGerman, want: "AeOeUe strasse"},
+		{input: "Łódź", mode: TransliterateASCII, want: "Lodz"},
+	}
+	for _, tt := range tests {
+		if got := Transliterate(tt.input, tt.mode); got != tt.want {
+			t.Errorf("Transliterate(%q, %q) = %q, want %q", tt.input, tt.mode, got, tt.want)
+		}
+	}
+}
+
+func TestDNSLabel(t *testing.T) {
+	tests := []struct {
+		name      string
+		maxLength int
+		want      string
+		wantErr   bool
+	}{
+		{name: "rg-app-we-prd", want: "rg-app-we-prd"},
+		{name: "ST_App.Prd", want: "st-app-prd"},
+		{name: "01-kv-app-", want: "kv-app"},
+		{name: "app-service-we-prd", maxLength: 12, want: "app-service"},
+		{name: strings.Repeat("a", 70), want: strings.Repeat("a", 63)},
+		{name: "0123", wantErr: true},
+		{name: "app", maxLength: 64, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := DNSLabel(tt.name, tt.maxLength)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("DNSLabel(%q, %d) = %q, want error", tt.name, tt.maxLength, got)
+				}
+				return
+			}
+			if err != nil || got != tt.want {
+				t.Errorf("DNSLabel(%q, %d) = %q, %v, want %q", tt.name, tt.maxLength, got, err, tt.want)
+			}
+		})
+	}
+}
@@ -6,6 +6,7 @@ package tools
 import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"strings"
+	"unicode"
 )
 
 func GetBaseString(s types.String) string {
@@ -17,3 +18,15 @@ func GetBaseString(s types.String) string {
 	}
 	return strings.Trim(s.ValueString(), "\"")
 }
+
+// NormalizeLocation returns the canonical form of a location name: lower case
+// without white space, so display names copied from a portal like "West Europe"
+// match the location name westeurope.
+func NormalizeLocation(location string) string {
+	return strings.ToLower(strings.Map(func(r rune) rune {
+		if unicode.IsSpace(r) {
+			return -1
+		}
+		return r
+	}, location))
+}
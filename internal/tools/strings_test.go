@@ -40,3 +40,18 @@ func TestGetBaseString(t *testing.T) {
 		})
 	}
 }
+
+func TestNormalizeLocation(t *testing.T) {
+	tests := map[string]string{
+		"westeurope":            "westeurope",
+		"West Europe":           "westeurope",
+		" Germany West Central": "germanywestcentral",
+		"EU-Central-1":          "eu-central-1",
+		"":                      "",
+	}
+	for location, want := range tests {
+		if got := NormalizeLocation(location); got != want {
+			t.Errorf("NormalizeLocation(%q) = %q, want %q", location, got, want)
+		}
+	}
+}